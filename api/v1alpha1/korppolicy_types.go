@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KorpPolicySpec defines exemption and protection rules that apply to every matching KorpScan
+type KorpPolicySpec struct {
+	// ScanSelector selects the KorpScans this policy applies to by their labels.
+	// If not set, the policy applies to every KorpScan.
+	// +optional
+	ScanSelector *metav1.LabelSelector `json:"scanSelector,omitempty"`
+
+	// ProtectedNamespaces are namespaces (glob patterns) that are never scanned, reported or cleaned
+	// +optional
+	ProtectedNamespaces []string `json:"protectedNamespaces,omitempty"`
+
+	// ProtectedLabels selects resources that are never reported or cleaned.
+	// If not set, no resource is protected by its labels.
+	// +optional
+	ProtectedLabels *metav1.LabelSelector `json:"protectedLabels,omitempty"`
+
+	// ProtectedAnnotations protects resources carrying any of these annotations from being reported or cleaned.
+	// An empty value matches any value of the annotation.
+	// +optional
+	ProtectedAnnotations map[string]string `json:"protectedAnnotations,omitempty"`
+
+	// AllowedResourceTypes limits scans to these resource types (same names as KorpScan resourceTypes).
+	// If empty, all resource types are allowed.
+	// +optional
+	AllowedResourceTypes []string `json:"allowedResourceTypes,omitempty"`
+
+	// NeverDelete are rules for resources that are still reported but never remediated by cleanup
+	// +optional
+	NeverDelete []NeverDeleteRule `json:"neverDelete,omitempty"`
+}
+
+// NeverDeleteRule matches resources cleanup must never remediate.
+// A resource matches when it matches every field that is set.
+type NeverDeleteRule struct {
+	// ResourceTypes are the resource types the rule covers (same names as KorpScan resourceTypes).
+	// If empty, the rule covers all resource types.
+	// +optional
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+
+	// Namespaces are namespaces (glob patterns) the rule covers.
+	// If empty, the rule covers all namespaces and cluster-scoped resources.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Names are resource names (glob patterns) the rule covers.
+	// If empty, the rule covers all names.
+	// +optional
+	Names []string `json:"names,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// KorpPolicy is the Schema for the korppolicies API
+type KorpPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KorpPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KorpPolicyList contains a list of KorpPolicy
+type KorpPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KorpPolicy `json:"items"`
+}
+
+func init() {
+	register(&KorpPolicy{}, &KorpPolicyList{})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReportScanLabel names the KorpScan whose scan a KorpReport records, for listing a KorpScan's reports
+const ReportScanLabel = "korp.io/korpscan"
+
+// KorpReportSpec is the record of one completed scan
+type KorpReportSpec struct {
+	// ScanName is the KorpScan, in the same namespace, that ran the scan
+	ScanName string `json:"scanName"`
+
+	// ScanTime is when the scan completed
+	ScanTime metav1.Time `json:"scanTime"`
+
+	// Duration is the human-readable duration of the scan (e.g. "2.5s")
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// Summary contains the scan's summary statistics
+	// +optional
+	Summary ScanSummary `json:"summary,omitempty"`
+
+	// TotalFindings is the number of findings of the scan. Findings holds fewer when the full
+	// list exceeds the API server's object size limit.
+	TotalFindings int `json:"totalFindings"`
+
+	// Findings contains detailed orphan resource information
+	// +optional
+	Findings []Finding `json:"findings,omitempty"`
+
+	// Detections records when each finding left out of Findings was first detected, so the next
+	// scan keeps tracking findings a report too large for all of them could not store
+	// +optional
+	Detections []FindingDetection `json:"detections,omitempty"`
+}
+
+// FindingDetection identifies a finding and records when it was first detected
+type FindingDetection struct {
+	// ResourceType is the kind of resource (ConfigMap, Secret, Service, etc.)
+	ResourceType string `json:"resourceType"`
+
+	// Namespace where the resource is located
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// DetectedAt is when the finding was first detected
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// ConsecutiveScans is how many successive scans, this one included, reported the resource
+	// +optional
+	ConsecutiveScans int `json:"consecutiveScans,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Scan",type=string,JSONPath=`.spec.scanName`
+// +kubebuilder:printcolumn:name="Findings",type=integer,JSONPath=`.spec.totalFindings`
+// +kubebuilder:printcolumn:name="ScanTime",type=date,JSONPath=`.spec.scanTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// KorpReport holds the findings of one scan of a KorpScan. Reports are owned by their KorpScan,
+// labeled korp.io/korpscan with its name, and pruned to the newest spec.reporting.historyLimit.
+type KorpReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KorpReportSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KorpReportList contains a list of KorpReport
+type KorpReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KorpReport `json:"items"`
+}
+
+func init() {
+	register(&KorpReport{}, &KorpReportList{})
+}
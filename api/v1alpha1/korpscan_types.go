@@ -7,7 +7,91 @@ Licensed under the MIT License.
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TestNotificationAnnotation on a KorpScan makes the controller send a synthetic payload
+// through the configured notification channels and then remove the annotation
+const TestNotificationAnnotation = "korp.io/test-notification"
+
+// ResumeCleanupAnnotation on a KorpScan lifts a cleanup halted by an anomaly; the controller records
+// the resumption in status.lastCleanupAnomaly.resumedAt and then removes the annotation
+const ResumeCleanupAnnotation = "korp.io/resume-cleanup"
+
+// ScanOptInAnnotation set to ScanOptInEnabled on a Namespace opts it in to KorpScans with NamespaceOptIn
+const (
+	ScanOptInAnnotation = "korp.io/scan"
+	ScanOptInEnabled    = "enabled"
+)
+
+// Remediation actions cleanup can apply to a finding
+const (
+	// ActionDelete deletes the resource
+	ActionDelete = "delete"
+
+	// ActionScaleToZero scales a workload to zero replicas and keeps it
+	ActionScaleToZero = "scaleToZero"
+
+	// ActionPatch removes the dangling reference the finding was reported for
+	ActionPatch = "patch"
+
+	// ActionLabel only labels the resource as orphaned
+	ActionLabel = "label"
+
+	// ActionNone never remediates; a cleanup type policy sets it to exclude a resource type
+	ActionNone = "none"
+)
+
+// How scans treat findings of resources managed by Helm
+const (
+	// HelmManagedReport reports them like any other finding, with their release
+	HelmManagedReport = "report"
+
+	// HelmManagedExclude drops them
+	HelmManagedExclude = "exclude"
+
+	// HelmManagedAbandonedReleases keeps only those whose release has no deployed revision
+	HelmManagedAbandonedReleases = "abandonedReleases"
+)
+
+// How scans treat findings of resources deployed by a GitOps controller
+const (
+	// GitOpsManagedReport reports them with reason GitOpsManagedButUnused
+	GitOpsManagedReport = "report"
+
+	// GitOpsManagedExclude drops them
+	GitOpsManagedExclude = "exclude"
+)
+
+// Severities of a finding, from least to most pressing
+const (
+	SeverityLow    = "Low"
+	SeverityMedium = "Medium"
+	SeverityHigh   = "High"
+)
+
+// Confidences of a finding
+const (
+	// ConfidenceHeuristic findings are inferred from the current state of the cluster, which may yet change
+	ConfidenceHeuristic = "heuristic"
+
+	// ConfidenceDefinite findings point at something that no longer exists or is provably unused
+	ConfidenceDefinite = "definite"
+)
+
+// Approval states of a cleanup candidate when cleanup requires approval
+const (
+	// ApprovalPending waits for a human decision
+	ApprovalPending = "pending"
+
+	// ApprovalApproved lets cleanup remediate the resource
+	ApprovalApproved = "approved"
+
+	// ApprovalRejected keeps the resource for as long as the decision stands
+	ApprovalRejected = "rejected"
 )
 
 // KorpScanSpec defines the desired state of KorpScan
@@ -16,22 +100,74 @@ type KorpScanSpec struct {
 	// +kubebuilder:validation:Required
 	TargetNamespace string `json:"targetNamespace"`
 
-	// IntervalMinutes is the scan interval in minutes
+	// NamespaceOptIn scans only namespaces annotated with korp.io/scan=enabled,
+	// so korp can be rolled out tenant by tenant
+	// +optional
+	NamespaceOptIn bool `json:"namespaceOptIn,omitempty"`
+
+	// NamespaceSelector scans only namespaces whose labels match it when TargetNamespace is "*",
+	// e.g. all namespaces labeled team=payments
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// IncludeNamespaces scans only these namespaces when TargetNamespace is "*". With NamespaceSelector,
+	// namespaces that are listed or match the selector are scanned. Exclusions and opt-in still apply.
+	// +optional
+	IncludeNamespaces []string `json:"includeNamespaces,omitempty"`
+
+	// IntervalMinutes is the scan interval in minutes. Ignored when Interval is set.
 	// +kubebuilder:default=60
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	IntervalMinutes int `json:"intervalMinutes,omitempty"`
 
+	// Interval is the scan interval as a duration (e.g. "30s", "6h"). Takes precedence over IntervalMinutes.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// ScanTimeout bounds how long a single scan may run (e.g. "10m"). A scan cut short by the timeout
+	// is recorded in history as partial. Unset means no limit.
+	// +optional
+	ScanTimeout *metav1.Duration `json:"scanTimeout,omitempty"`
+
+	// APIRateLimit throttles the API requests of this KorpScan's scans. Scans use a client of their own,
+	// so a throttled scan does not slow the reconciliation of other KorpScans. Unset uses the operator's
+	// scan client limits (--scan-qps, --scan-burst).
+	// +optional
+	APIRateLimit *APIRateLimitSpec `json:"apiRateLimit,omitempty"`
+
+	// Watch keeps informer caches of the resources detectors list and rescans the namespaces whose
+	// resources changed between full scans, which still run every interval
+	// +optional
+	Watch *WatchSpec `json:"watch,omitempty"`
+
+	// Mode selects what the scan looks for. "orphans" (default) detects orphaned resources;
+	// "uninstall-audit" reports resources left behind by uninstalled Helm releases and OLM operators.
+	// +kubebuilder:validation:Enum=orphans;uninstall-audit
+	// +kubebuilder:default="orphans"
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
 	// ResourceTypes to scan. Defaults to all if empty.
 	// +kubebuilder:validation:Optional
 	// +optional
 	ResourceTypes []string `json:"resourceTypes,omitempty"`
 
-	// Filters for excluding resources
+	// Filters for including and excluding resources
 	// +kubebuilder:validation:Optional
 	// +optional
 	Filters FilterSpec `json:"filters,omitempty"`
 
+	// FilterPresets are named sets of known-safe exclusions merged into Filters.
+	// Available presets: helm, argocd, istio, cert-manager.
+	// +optional
+	FilterPresets []string `json:"filterPresets,omitempty"`
+
+	// Detection tunes how orphaned resources are identified
+	// +kubebuilder:validation:Optional
+	// +optional
+	Detection DetectionSpec `json:"detection,omitempty"`
+
 	// Reporting configuration
 	// +kubebuilder:validation:Optional
 	// +optional
@@ -43,9 +179,46 @@ type KorpScanSpec struct {
 	Cleanup *CleanupSpec `json:"cleanup,omitempty"`
 }
 
-// FilterSpec defines filtering rules for excluding resources
+// APIRateLimitSpec is the client-side rate limit of the API requests of a scan
+type APIRateLimitSpec struct {
+	// QPS is the sustained number of requests per second
+	// +kubebuilder:validation:Minimum=1
+	QPS int32 `json:"qps"`
+
+	// Burst is the number of requests allowed above QPS for short periods. Defaults to QPS.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+}
+
+// WatchSpec configures watch mode
+type WatchSpec struct {
+	// Enabled turns on watch mode
+	Enabled bool `json:"enabled"`
+
+	// DebounceSeconds is how long changes are collected after the first one before the namespaces
+	// they touched are rescanned, and the least time between two scans
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	DebounceSeconds int `json:"debounceSeconds,omitempty"`
+}
+
+// FilterSpec defines filtering rules for including and excluding resources
 type FilterSpec struct {
-	// ExcludeLabels are label selectors to exclude
+	// IncludeNamePatterns are regex patterns of which a resource's name must match one to be reported.
+	// Empty includes every name.
+	// +optional
+	IncludeNamePatterns []string `json:"includeNamePatterns,omitempty"`
+
+	// IncludeLabels are labels a resource must all carry to be reported
+	// (e.g. app.kubernetes.io/part-of: legacy). Empty includes every resource.
+	// +optional
+	IncludeLabels map[string]string `json:"includeLabels,omitempty"`
+
+	// ExcludeLabels are labels that keep a resource out of the report when it carries any of them.
+	// An empty value matches every value of the key.
 	// +optional
 	ExcludeLabels map[string]string `json:"excludeLabels,omitempty"`
 
@@ -56,6 +229,121 @@ type FilterSpec struct {
 	// ExcludeNamespaces are namespaces to completely exclude from scanning
 	// +optional
 	ExcludeNamespaces []string `json:"excludeNamespaces,omitempty"`
+
+	// MinResourceAgeMinutes skips resources created less than this many minutes ago,
+	// so objects that are still being wired up are never reported
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinResourceAgeMinutes int `json:"minResourceAgeMinutes,omitempty"`
+
+	// GraceWindowMinutes overrides the per-type windows during which new resources are not flagged,
+	// keyed by resource type (e.g. "replicasets": 30). 0 disables a type's default window.
+	// Defaults: each Deployment's progressDeadlineSeconds; replicasets and statefulsets 10; pvcs 5;
+	// services and endpoints 2.
+	// +optional
+	GraceWindowMinutes map[string]int `json:"graceWindowMinutes,omitempty"`
+
+	// HelmManaged chooses what happens to findings of resources managed by Helm (meta.helm.sh annotations,
+	// or the app.kubernetes.io/managed-by=Helm label): "report" them with their release (default),
+	// "exclude" them, or report only "abandonedReleases", whose release has no deployed revision.
+	// Findings dropped here are never cleaned up.
+	// +kubebuilder:validation:Enum=report;exclude;abandonedReleases
+	// +optional
+	HelmManaged string `json:"helmManaged,omitempty"`
+
+	// GitOpsManaged chooses what happens to findings of resources deployed by Argo CD (argocd.argoproj.io/tracking-id
+	// annotation or argocd.argoproj.io/instance label) or Flux (kustomize.toolkit.fluxcd.io and
+	// helm.toolkit.fluxcd.io labels): "report" them with reason GitOpsManagedButUnused (default), which cleanup
+	// only labels, or "exclude" them
+	// +kubebuilder:validation:Enum=report;exclude
+	// +optional
+	GitOpsManaged string `json:"gitOpsManaged,omitempty"`
+
+	// MinSeverity drops findings of a lower severity
+	// +kubebuilder:validation:Enum=Low;Medium;High
+	// +optional
+	MinSeverity string `json:"minSeverity,omitempty"`
+
+	// MinConfidence set to "definite" drops heuristic findings
+	// +kubebuilder:validation:Enum=heuristic;definite
+	// +optional
+	MinConfidence string `json:"minConfidence,omitempty"`
+}
+
+// DetectionSpec tunes orphan detection heuristics
+type DetectionSpec struct {
+	// ReferenceAnnotations are annotation keys whose values name ConfigMaps or Secrets
+	// (comma-separated, optionally as "namespace/name"). Objects referenced this way are treated as in use.
+	// Built-in keys for common controllers (ingress-nginx, Reloader) are always included.
+	// +optional
+	ReferenceAnnotations []string `json:"referenceAnnotations,omitempty"`
+
+	// KnownPrincipals is the list of valid users and groups from the identity provider.
+	// When set, RoleBindings and ClusterRoleBindings whose only subjects are unknown users/groups are reported.
+	// +optional
+	KnownPrincipals *PrincipalSource `json:"knownPrincipals,omitempty"`
+
+	// CustomResourceReferences treats ConfigMaps and Secrets named in the spec of custom resources
+	// (Prometheus rules, Grafana dashboards, Crossplane configs, ...) as in use
+	// +optional
+	CustomResourceReferences *CustomResourceReferenceSpec `json:"customResourceReferences,omitempty"`
+
+	// AvailablePVDays is how many days a PersistentVolume must have been Available, unclaimed, to be
+	// reported. Defaults to 7.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	AvailablePVDays int `json:"availablePVDays,omitempty"`
+
+	// MaxObjectSizeBytes reports ConfigMaps and Secrets larger than this many bytes with reason
+	// OversizedObject, whether or not they are in use, since very large objects weigh on etcd.
+	// Oversized objects are labeled rather than deleted by cleanup. 0 disables the check.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxObjectSizeBytes int64 `json:"maxObjectSizeBytes,omitempty"`
+}
+
+// CustomResourceReferenceSpec configures the reverse index of ConfigMaps and Secrets referenced by custom resources
+type CustomResourceReferenceSpec struct {
+	// Enabled builds the index once per scan from korp's built-in list of popular custom resources
+	// (Prometheus Operator, Grafana Operator, cert-manager, KEDA) plus Resources.
+	// Custom resources whose CRD is not installed are skipped.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Resources are additional custom resources to index, e.g. a Crossplane provider's ProviderConfigs.
+	// The operator needs list permission on them.
+	// +optional
+	Resources []GroupVersionResource `json:"resources,omitempty"`
+}
+
+// GroupVersionResource identifies an API resource
+type GroupVersionResource struct {
+	// Group is the API group, e.g. "aws.upbound.io"
+	Group string `json:"group"`
+
+	// Version is the API version, e.g. "v1beta1"
+	Version string `json:"version"`
+
+	// Resource is the plural resource name, e.g. "providerconfigs"
+	Resource string `json:"resource"`
+}
+
+// PrincipalSource defines where the list of valid users and groups is read from.
+// Lists from all configured sources are merged.
+type PrincipalSource struct {
+	// ConfigMapName is a ConfigMap in the KorpScan namespace with "users" and "groups" keys,
+	// each holding one principal per line
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// SecretName is a Secret in the KorpScan namespace with "users" and "groups" keys,
+	// each holding one principal per line
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// URL is an HTTP(S) endpoint returning JSON {"users": [...], "groups": [...]}
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 // ReportingSpec defines how scan results are reported
@@ -71,16 +359,195 @@ type ReportingSpec struct {
 	// +optional
 	EventSeverity string `json:"eventSeverity,omitempty"`
 
-	// HistoryLimit is the number of scan results to retain
+	// SeverityOverrides sets the event severity (Normal or Warning) per finding reason,
+	// e.g. {"ScaledToZero": "Normal"}. Reasons not listed use EventSeverity.
+	// +optional
+	SeverityOverrides map[string]string `json:"severityOverrides,omitempty"`
+
+	// HistoryLimit is the number of scan results to retain, in status history and as KorpReports
 	// +kubebuilder:default=5
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=50
 	// +optional
 	HistoryLimit int `json:"historyLimit,omitempty"`
 
+	// MaxEventsPerNamespace caps per-finding events created in a single namespace per scan.
+	// Findings beyond the cap are summarized in one aggregate event. 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxEventsPerNamespace int `json:"maxEventsPerNamespace,omitempty"`
+
+	// MaxEventsPerScan caps per-finding events created per scan across all namespaces.
+	// Findings beyond the cap are summarized in one aggregate event per namespace. 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxEventsPerScan int `json:"maxEventsPerScan,omitempty"`
+
+	// AnnotateResources writes korp.io/orphaned-since and korp.io/reason on flagged resources,
+	// so the detection date survives operator restarts and is visible on the object itself.
+	// Cleanup age gating then counts from the annotated date.
+	// +optional
+	AnnotateResources bool `json:"annotateResources,omitempty"`
+
+	// AnnotateNamespaces writes korp.io/orphan-count, korp.io/last-scan and korp.io/scanned-by
+	// on every scanned Namespace, so namespace owners see their status with kubectl describe ns.
+	// +optional
+	AnnotateNamespaces bool `json:"annotateNamespaces,omitempty"`
+
+	// NotifyOnlyNew sends the Webhook, Slack and Notifiers notifications only after scans that found orphans missing
+	// from the previous scan, and lists only those new findings. PagerDuty alerts are evaluated after every scan.
+	// +optional
+	NotifyOnlyNew bool `json:"notifyOnlyNew,omitempty"`
+
 	// Webhook configuration for sending scan results to external systems
 	// +optional
 	Webhook *WebhookConfig `json:"webhook,omitempty"`
+
+	// Slack configuration for posting scan summaries and top findings to Slack channels
+	// +optional
+	Slack *SlackConfig `json:"slack,omitempty"`
+
+	// Notifiers are further named notification channels, sent the same payload as Webhook and Slack.
+	// Each is delivered to independently and tracked in status.notifierStatuses.
+	// +optional
+	Notifiers []NotifierSpec `json:"notifiers,omitempty"`
+
+	// PagerDuty opens a PagerDuty alert while scans cross a threshold and resolves it once they no longer do.
+	// It is evaluated after every scan, regardless of NotifyOnlyNew.
+	// +optional
+	PagerDuty *PagerDutyConfig `json:"pagerDuty,omitempty"`
+
+	// SARIF writes the findings of each scan as a SARIF log to a ConfigMap, for upload to
+	// GitHub code scanning and other SARIF consumers
+	// +optional
+	SARIF *SARIFReportConfig `json:"sarif,omitempty"`
+}
+
+// SARIFReportConfig defines where the SARIF log of each scan is written
+type SARIFReportConfig struct {
+	// ConfigMapName is the ConfigMap in the KorpScan namespace that holds the log under the
+	// "korp.sarif" key (default: "<korpscan name>-sarif"). The ConfigMap is owned by the KorpScan.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
+// SlackConfig defines Slack notification settings
+type SlackConfig struct {
+	// SecretName is a Secret in the KorpScan namespace holding either a "webhookURL" key
+	// (an incoming webhook) or a "token" key (a bot token used with chat.postMessage).
+	// The token takes precedence when both are set.
+	// +kubebuilder:validation:Required
+	SecretName string `json:"secretName"`
+
+	// Channel receives the scan summary and every finding not matched by a route,
+	// e.g. "#platform-alerts". Required with a bot token; an incoming webhook posts
+	// to its own channel when empty.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// MessageTemplate is a Go template for the message header. It is executed with
+	// .KorpScan, .Summary, .Channel, .FindingCount and .ScanDuration.
+	// +optional
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+
+	// MaxFindings is the number of findings listed in each message (default: 10)
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=50
+	// +optional
+	MaxFindings int `json:"maxFindings,omitempty"`
+
+	// Routes send findings in matching namespaces to other channels.
+	// The first matching route wins; cluster-scoped findings always go to Channel.
+	// Routes require a bot token: an incoming webhook can only post to its own channel.
+	// +optional
+	Routes []SlackRoute `json:"routes,omitempty"`
+
+	// TimeoutSeconds is the request timeout in seconds (default: 30)
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+// PagerDutyConfig defines PagerDuty Events API v2 alerting. Each KorpScan has one alert, identified by
+// a dedup key, which repeated scans update instead of opening new incidents.
+type PagerDutyConfig struct {
+	// RoutingKeySecretRef selects the integration key of an Events API v2 integration
+	// +kubebuilder:validation:Required
+	RoutingKeySecretRef SecretKeyRef `json:"routingKeySecretRef"`
+
+	// OrphanThreshold triggers the alert when a scan reports more findings than this (default: 0, any finding)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OrphanThreshold int `json:"orphanThreshold,omitempty"`
+
+	// MinSeverity counts only findings of at least this severity toward OrphanThreshold,
+	// e.g. High to page on any High-severity finding
+	// +kubebuilder:validation:Enum=Low;Medium;High
+	// +optional
+	MinSeverity string `json:"minSeverity,omitempty"`
+
+	// Severity is the PagerDuty severity of the alert (default: warning)
+	// +kubebuilder:default=warning
+	// +kubebuilder:validation:Enum=critical;error;warning;info
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// TimeoutSeconds is the request timeout in seconds (default: 30)
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+// SlackRoute sends the findings of some namespaces to a dedicated channel
+type SlackRoute struct {
+	// Namespaces are namespace names or glob patterns, e.g. "team-a-*"
+	// +kubebuilder:validation:MinItems=1
+	Namespaces []string `json:"namespaces"`
+
+	// Channel receives the findings in the matching namespaces
+	// +kubebuilder:validation:Required
+	Channel string `json:"channel"`
+}
+
+const (
+	// NotifierTypeWebhook sends the payload to an HTTP endpoint
+	NotifierTypeWebhook = "webhook"
+
+	// NotifierTypeSlack posts the scan summary and top findings to Slack
+	NotifierTypeSlack = "slack"
+
+	// NotifierTypePagerDuty triggers and resolves a PagerDuty alert through the Events API v2
+	NotifierTypePagerDuty = "pagerduty"
+)
+
+// NotifierSpec configures one named notification channel. The field named after its type holds its configuration.
+type NotifierSpec struct {
+	// Name identifies the notifier in status and events; unique within the KorpScan
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Type selects the notifier implementation
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=webhook;slack;pagerduty
+	Type string `json:"type"`
+
+	// Webhook configures a notifier of type webhook
+	// +optional
+	Webhook *WebhookConfig `json:"webhook,omitempty"`
+
+	// Slack configures a notifier of type slack
+	// +optional
+	Slack *SlackConfig `json:"slack,omitempty"`
+
+	// PagerDuty configures a notifier of type pagerduty
+	// +optional
+	PagerDuty *PagerDutyConfig `json:"pagerDuty,omitempty"`
 }
 
 // WebhookConfig defines webhook notification settings
@@ -95,10 +562,21 @@ type WebhookConfig struct {
 	// +optional
 	Method string `json:"method,omitempty"`
 
-	// Headers are custom HTTP headers to include in the webhook request
+	// Headers are custom HTTP headers to include in the webhook request. They are stored in plain
+	// text; use HeadersFrom or BearerTokenSecretRef for credentials.
 	// +optional
 	Headers map[string]string `json:"headers,omitempty"`
 
+	// HeadersFrom are HTTP headers whose values are read from Secrets each time a request is sent.
+	// They take precedence over Headers, and their values are redacted from logs and status.
+	// +optional
+	HeadersFrom []HeaderFromSecret `json:"headersFrom,omitempty"`
+
+	// BearerTokenSecretRef selects a token sent as "Authorization: Bearer <token>".
+	// It takes precedence over an Authorization header in Headers or HeadersFrom.
+	// +optional
+	BearerTokenSecretRef *SecretKeyRef `json:"bearerTokenSecretRef,omitempty"`
+
 	// TimeoutSeconds is the request timeout in seconds (default: 30)
 	// +kubebuilder:default=30
 	// +kubebuilder:validation:Minimum=1
@@ -111,9 +589,92 @@ type WebhookConfig struct {
 	// +optional
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 
+	// CABundle is a PEM bundle of CA certificates that verify the endpoint's certificate.
+	// With CABundle or CASecretRef set, the system trust roots are not used.
+	// +optional
+	CABundle string `json:"caBundle,omitempty"`
+
+	// CASecretRef selects a Secret key holding a PEM bundle of CA certificates, trusted along with CABundle
+	// +optional
+	CASecretRef *SecretKeyRef `json:"caSecretRef,omitempty"`
+
+	// ClientCertSecretRef selects a kubernetes.io/tls Secret whose tls.crt and tls.key are presented
+	// as the client certificate, for endpoints that require mutual TLS
+	// +optional
+	ClientCertSecretRef *SecretRef `json:"clientCertSecretRef,omitempty"`
+
 	// RetryPolicy defines retry behavior for failed webhook calls
 	// +optional
 	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// MaxFindingsPerRequest splits larger results into sequential requests of at most this many findings,
+	// each carrying page metadata and a shared correlation ID (default: 0, all findings in one request)
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFindingsPerRequest int `json:"maxFindingsPerRequest,omitempty"`
+
+	// SigningSecretRef selects the key used to sign request bodies. Each request then carries
+	// an X-Korp-Signature header: "sha256=" and the hex HMAC-SHA256 of the body.
+	// +optional
+	SigningSecretRef *SecretKeyRef `json:"signingSecretRef,omitempty"`
+
+	// PayloadTemplate replaces the default JSON payload with a Go template rendered with it,
+	// to match the format a receiver expects
+	// +optional
+	PayloadTemplate *PayloadTemplate `json:"payloadTemplate,omitempty"`
+}
+
+// PayloadTemplate is a Go text/template rendering the webhook request body from the default payload.
+// The rendered body must be valid JSON. Exactly one of Inline and ConfigMapKeyRef is set.
+type PayloadTemplate struct {
+	// Inline is the template text
+	// +optional
+	Inline string `json:"inline,omitempty"`
+
+	// ConfigMapKeyRef selects a ConfigMap key in the KorpScan namespace holding the template text.
+	// It is read on every delivery.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeyRef `json:"configMapKeyRef,omitempty"`
+}
+
+// ConfigMapKeyRef selects a key of a ConfigMap in the KorpScan namespace
+type ConfigMapKeyRef struct {
+	// Name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key within the ConfigMap's data
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+// HeaderFromSecret is an HTTP header whose value is read from a Secret
+type HeaderFromSecret struct {
+	// Name of the header
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// SecretKeyRef selects the header value
+	// +kubebuilder:validation:Required
+	SecretKeyRef SecretKeyRef `json:"secretKeyRef"`
+}
+
+// SecretKeyRef selects a key of a Secret in the KorpScan namespace
+type SecretKeyRef struct {
+	// Name of the Secret
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key within the Secret's data
+	// +kubebuilder:validation:Required
+	Key string `json:"key"`
+}
+
+// SecretRef names a Secret in the KorpScan namespace
+type SecretRef struct {
+	// Name of the Secret
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
 }
 
 // RetryPolicy defines retry behavior for webhook notifications
@@ -162,6 +723,183 @@ type CleanupSpec struct {
 	// Example: "korp.io/preserve", "do-not-delete"
 	// +optional
 	PreservationLabels []string `json:"preservationLabels,omitempty"`
+
+	// IncludePausedRollouts makes Deployments and StatefulSets reported as PausedRollout
+	// eligible for cleanup. By default they are never deleted.
+	// +optional
+	IncludePausedRollouts bool `json:"includePausedRollouts,omitempty"`
+
+	// AllowedActions limits the remediation actions cleanup may apply: delete, scaleToZero, patch or label.
+	// A finding whose suggested action is not allowed is labeled instead when label is allowed, otherwise skipped.
+	// If empty, all actions are allowed.
+	// +optional
+	AllowedActions []string `json:"allowedActions,omitempty"`
+
+	// Mode selects when cleanup runs. "after-scan" (default) cleans once the whole scan is done;
+	// "pipeline" cleans each namespace as soon as it is scanned, so cleanup on large clusters
+	// finishes within the same reconcile.
+	// +kubebuilder:validation:Enum=after-scan;pipeline
+	// +kubebuilder:default="after-scan"
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// DeletionBudget is the maximum number of resources remediated in one cleanup run.
+	// Further eligible resources are skipped until the next run. 0 means no limit.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DeletionBudget int `json:"deletionBudget,omitempty"`
+
+	// RequireApproval remediates a resource only once a human approves it by annotating it
+	// korp.io/cleanup-approval=approved. Annotating it rejected keeps it. Every candidate and its
+	// decision is listed in status.cleanupStatus.approvals.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
+
+	// AnomalyThreshold defines when a cleanup run remediated more than expected. Such a run sends a
+	// high-priority notification and switches cleanup to dry run until the korp.io/resume-cleanup annotation resumes it.
+	// +optional
+	AnomalyThreshold *AnomalyThreshold `json:"anomalyThreshold,omitempty"`
+
+	// ObservationPeriodDays forces cleanup into dry run until this many days after the first
+	// successful scan, so what cleanup would do is on record before anything is deleted. 0 means none.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ObservationPeriodDays int `json:"observationPeriodDays,omitempty"`
+
+	// Backup stores the manifest of each resource before it is deleted, so deletions can be undone.
+	// A resource whose manifest cannot be stored is not deleted.
+	// +optional
+	Backup *BackupSpec `json:"backup,omitempty"`
+
+	// RequiredConsecutiveScans is how many successive scans must report a resource before it is
+	// remediated, so a transient state seen by a single scan never leads to a deletion. 0 or 1 means
+	// the first report suffices.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RequiredConsecutiveScans int `json:"requiredConsecutiveScans,omitempty"`
+
+	// MinSeverity remediates only findings of at least this severity; others are still reported
+	// +kubebuilder:validation:Enum=Low;Medium;High
+	// +optional
+	MinSeverity string `json:"minSeverity,omitempty"`
+
+	// MinConfidence set to "definite" remediates only definite findings; heuristic ones are still reported
+	// +kubebuilder:validation:Enum=heuristic;definite
+	// +optional
+	MinConfidence string `json:"minConfidence,omitempty"`
+
+	// TypePolicies override minAgeDays, dryRun and the remediation action for single resource types,
+	// e.g. Jobs after 3 days, Secrets after 30 and PersistentVolumeClaims never
+	// +optional
+	TypePolicies []CleanupTypePolicy `json:"typePolicies,omitempty"`
+}
+
+// CleanupTypePolicy overrides cleanup settings for the findings of one resource type
+type CleanupTypePolicy struct {
+	// ResourceType is the resource type as named in resourceTypes, e.g. jobs, secrets or pvcs
+	// +kubebuilder:validation:Required
+	ResourceType string `json:"resourceType"`
+
+	// MinAgeDays overrides minAgeDays for this type
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinAgeDays *int `json:"minAgeDays,omitempty"`
+
+	// DryRun makes cleanup of this type a dry run even when dryRun is false.
+	// It cannot lift a dry run of the whole cleanup.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Action replaces the suggested action of this type's findings when it is delete: delete, scaleToZero,
+	// patch or label. Findings suggesting another action keep it, so resources still in use are never deleted.
+	// none never remediates the type. AllowedActions still applies.
+	// +kubebuilder:validation:Enum=delete;scaleToZero;patch;label;none
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// TypePolicy returns the policy of a resource type as named in resourceTypes, or nil if it has none
+func (c *CleanupSpec) TypePolicy(resourceType string) *CleanupTypePolicy {
+	for i := range c.TypePolicies {
+		if c.TypePolicies[i].ResourceType == resourceType {
+			return &c.TypePolicies[i]
+		}
+	}
+	return nil
+}
+
+// BackupSpec selects where cleanup stores manifests before deleting resources. Set exactly one destination.
+// Manifests of a cleanup run are grouped under its run ID, the UTC start time of the run (20060102-150405).
+type BackupSpec struct {
+	// ConfigMap stores the manifests of a run in the ConfigMap "<korpscan>-backup-<run>" in the KorpScan
+	// namespace, continuing in "<korpscan>-backup-<run>-1" and so on when it is full. Secrets are stored in
+	// Secrets of the same names instead. All are deleted with the KorpScan.
+	// +optional
+	ConfigMap bool `json:"configMap,omitempty"`
+
+	// Path is a directory on a volume mounted into the operator, such as a PersistentVolumeClaim.
+	// Manifests of a run are written to <path>/<korpscan namespace>/<korpscan>/<run>/.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Bucket uploads manifests to an S3-compatible bucket under <prefix>/<korpscan namespace>/<korpscan>/<run>/
+	// +optional
+	Bucket *BackupBucket `json:"bucket,omitempty"`
+}
+
+// BackupBucket is an S3-compatible bucket: Amazon S3, MinIO, or Google Cloud Storage through its XML API with HMAC keys
+type BackupBucket struct {
+	// Endpoint is the URL of the object store, e.g. https://s3.eu-west-1.amazonaws.com or https://storage.googleapis.com
+	// +kubebuilder:validation:Required
+	Endpoint string `json:"endpoint"`
+
+	// Region requests are signed for (default: us-east-1; use auto for Google Cloud Storage)
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Name of the bucket
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Prefix is prepended to object keys
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// AccessKeyIDSecretRef selects the access key ID, in the KorpScan namespace
+	// +kubebuilder:validation:Required
+	AccessKeyIDSecretRef SecretKeyRef `json:"accessKeyIDSecretRef"`
+
+	// SecretAccessKeySecretRef selects the secret access key, in the KorpScan namespace
+	// +kubebuilder:validation:Required
+	SecretAccessKeySecretRef SecretKeyRef `json:"secretAccessKeySecretRef"`
+}
+
+// AnomalyThreshold bounds the remediations (deletions, scale-downs, patches and labels) of one cleanup run.
+// A zero value disables its check.
+type AnomalyThreshold struct {
+	// MaxDeletions is the number of resources one run may remediate
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxDeletions int `json:"maxDeletions,omitempty"`
+
+	// MaxIncreasePercent is how many percent more resources than the previous run a run may remediate.
+	// Runs following one that remediated nothing are not compared.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxIncreasePercent int `json:"maxIncreasePercent,omitempty"`
+}
+
+// IsActionAllowed returns true if cleanup may apply the given remediation action
+func (c *CleanupSpec) IsActionAllowed(action string) bool {
+	if len(c.AllowedActions) == 0 {
+		return true
+	}
+	for _, a := range c.AllowedActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
 }
 
 // IsDryRun returns true if dry-run mode is enabled (default: true for safety)
@@ -172,25 +910,86 @@ func (c *CleanupSpec) IsDryRun() bool {
 	return *c.DryRun
 }
 
+// ScanInterval returns the time between scans: Interval if set and positive,
+// otherwise IntervalMinutes, defaulting to 60 minutes
+func (s *KorpScanSpec) ScanInterval() time.Duration {
+	if s.Interval != nil && s.Interval.Duration > 0 {
+		return s.Interval.Duration
+	}
+	if s.IntervalMinutes > 0 {
+		return time.Duration(s.IntervalMinutes) * time.Minute
+	}
+	return 60 * time.Minute
+}
+
+// Watching reports whether watch mode is enabled
+func (s *KorpScanSpec) Watching() bool {
+	return s.Watch != nil && s.Watch.Enabled
+}
+
+// Debounce returns how long watch mode waits before rescanning changed namespaces
+func (w *WatchSpec) Debounce() time.Duration {
+	if w == nil || w.DebounceSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(w.DebounceSeconds) * time.Second
+}
+
 // KorpScanStatus defines the observed state of KorpScan
 type KorpScanStatus struct {
 	// LastScanTime is when the last scan completed
 	// +optional
 	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
 
+	// FirstScanTime is when the first scan completed; the cleanup observation period starts then
+	// +optional
+	FirstScanTime *metav1.Time `json:"firstScanTime,omitempty"`
+
 	// Phase represents the current state
 	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
 	// +optional
 	Phase string `json:"phase,omitempty"`
 
+	// Progress of the scan in progress; cleared when the scan finishes
+	// +optional
+	Progress *ScanProgress `json:"progress,omitempty"`
+
 	// Summary of findings
 	// +optional
 	Summary ScanSummary `json:"summary,omitempty"`
 
-	// Findings contains detailed orphan resource information
+	// Findings contains detailed orphan resource information.
+	// Deprecated: findings are stored in KorpReports (see LatestReport); the operator clears this field.
 	// +optional
 	Findings []Finding `json:"findings,omitempty"`
 
+	// LatestReport is the KorpReport, in the KorpScan namespace, holding the findings of the last scan
+	// +optional
+	LatestReport string `json:"latestReport,omitempty"`
+
+	// Cluster describes the scanned cluster and the detectors the last scan ran, so findings from
+	// clusters of different versions can be told apart
+	// +optional
+	Cluster *ClusterInfo `json:"cluster,omitempty"`
+
+	// NewFindings are findings of the last scan that the scan before did not report (at most 100)
+	// +optional
+	NewFindings []FindingRef `json:"newFindings,omitempty"`
+
+	// ResolvedFindings are findings of the scan before the last one that are no longer reported (at most 100)
+	// +optional
+	ResolvedFindings []FindingRef `json:"resolvedFindings,omitempty"`
+
+	// BlindSpots lists resource types korp was forbidden to list, so a clean result
+	// is not mistaken for full coverage
+	// +optional
+	BlindSpots []BlindSpot `json:"blindSpots,omitempty"`
+
+	// LeftBehind groups findings by the uninstalled application that left them behind.
+	// Set in uninstall-audit mode.
+	// +optional
+	LeftBehind []LeftBehindGroup `json:"leftBehind,omitempty"`
+
 	// History of recent scans
 	// +optional
 	History []HistoryEntry `json:"history,omitempty"`
@@ -203,9 +1002,65 @@ type KorpScanStatus struct {
 	// +optional
 	WebhookStatus *WebhookStatus `json:"webhookStatus,omitempty"`
 
+	// SlackStatus tracks Slack notification status
+	// +optional
+	SlackStatus *WebhookStatus `json:"slackStatus,omitempty"`
+
+	// NotifierStatuses track the deliveries of each notifier in reporting.notifiers
+	// +optional
+	NotifierStatuses []NotifierStatus `json:"notifierStatuses,omitempty"`
+
+	// PagerDutyStatus tracks PagerDuty alert delivery and whether the alert is open
+	// +optional
+	PagerDutyStatus *PagerDutyStatus `json:"pagerDutyStatus,omitempty"`
+
 	// CleanupStatus tracks cleanup operation status
 	// +optional
 	CleanupStatus *CleanupStatus `json:"cleanupStatus,omitempty"`
+
+	// LastCleanupAnomaly is the last cleanup run that remediated more than spec.cleanup.anomalyThreshold allows.
+	// Cleanup runs as a dry run until it is resumed.
+	// +optional
+	LastCleanupAnomaly *CleanupAnomaly `json:"lastCleanupAnomaly,omitempty"`
+}
+
+// CleanupAnomaly describes a cleanup run that deleted more resources than expected
+type CleanupAnomaly struct {
+	// DetectedAt is when the run finished
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// Deleted is the number of resources the run deleted, scaled to zero, patched or labeled
+	Deleted int `json:"deleted"`
+
+	// PreviousDeleted is the number of resources the run before remediated
+	PreviousDeleted int `json:"previousDeleted"`
+
+	// Message names the threshold that was exceeded
+	Message string `json:"message"`
+
+	// ResumedAt is when the korp.io/resume-cleanup annotation lifted the halt
+	// +optional
+	ResumedAt *metav1.Time `json:"resumedAt,omitempty"`
+}
+
+// ScanProgress reports how far a running scan has got
+type ScanProgress struct {
+	// NamespacesScanned is the number of namespaces finished so far
+	NamespacesScanned int `json:"namespacesScanned"`
+
+	// TotalNamespaces is the number of namespaces this scan covers
+	TotalNamespaces int `json:"totalNamespaces"`
+
+	// CurrentNamespace is the namespace being scanned
+	// +optional
+	CurrentNamespace string `json:"currentNamespace,omitempty"`
+
+	// StartedAt is when the scan started
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// Elapsed is the scan duration at the last progress update (e.g. "1m30s")
+	// +optional
+	Elapsed string `json:"elapsed,omitempty"`
 }
 
 // WebhookStatus tracks the status of webhook notifications
@@ -227,6 +1082,26 @@ type WebhookStatus struct {
 	LastError string `json:"lastError,omitempty"`
 }
 
+// NotifierStatus tracks the deliveries of one named notifier
+type NotifierStatus struct {
+	// Name of the notifier
+	Name string `json:"name"`
+
+	// Type of the notifier
+	Type string `json:"type"`
+
+	WebhookStatus `json:",inline"`
+}
+
+// PagerDutyStatus tracks the PagerDuty alert of a KorpScan
+type PagerDutyStatus struct {
+	WebhookStatus `json:",inline"`
+
+	// Triggered is true while the alert is open; the next scan below the threshold resolves it
+	// +optional
+	Triggered bool `json:"triggered,omitempty"`
+}
+
 // CleanupStatus tracks the status of cleanup operations
 type CleanupStatus struct {
 	// LastCleanupTime is when the last cleanup operation completed
@@ -241,13 +1116,58 @@ type CleanupStatus struct {
 	// +optional
 	Summary *CleanupSummary `json:"summary,omitempty"`
 
-	// DeletedResources lists resources that were deleted in the last cleanup
+	// DeletedResources lists resources that were remediated in the last cleanup, with the action applied
 	// +optional
 	DeletedResources []DeletedResource `json:"deletedResources,omitempty"`
 
 	// FailedDeletions lists resources that failed to delete
 	// +optional
 	FailedDeletions []FailedDeletion `json:"failedDeletions,omitempty"`
+
+	// WouldRemediate lists resources a dry run would have remediated, with the action it would apply.
+	// Nothing in this list was changed.
+	// +optional
+	WouldRemediate []PlannedRemediation `json:"wouldRemediate,omitempty"`
+
+	// Approvals lists the cleanup candidates of the last cleanup and their approval state, at most 100
+	// with pending ones first; summary.totalPendingApproval counts them all. Set when cleanup requires approval.
+	// +optional
+	Approvals []CleanupApproval `json:"approvals,omitempty"`
+
+	// BackupRun is the run ID the last cleanup backed up manifests under; pass it to korp restore --run
+	// +optional
+	BackupRun string `json:"backupRun,omitempty"`
+}
+
+// CleanupApproval is the approval state of one cleanup candidate
+type CleanupApproval struct {
+	// ResourceType is the kind of resource (ConfigMap, Secret, Service, etc.)
+	ResourceType string `json:"resourceType"`
+
+	// Namespace where the resource is located
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Action is the remediation awaiting approval: delete, scaleToZero, patch or label
+	Action string `json:"action"`
+
+	// State is pending, approved or rejected
+	// +kubebuilder:validation:Enum=pending;approved;rejected
+	State string `json:"state"`
+
+	// RequestedAt is when cleanup first asked for approval of the resource
+	RequestedAt metav1.Time `json:"requestedAt"`
+
+	// Approver is who approved or rejected the resource: the korp.io/cleanup-approver annotation,
+	// else the field manager that set the decision (e.g. "kubectl-annotate")
+	// +optional
+	Approver string `json:"approver,omitempty"`
+
+	// DecidedAt is when the resource was approved or rejected
+	// +optional
+	DecidedAt *metav1.Time `json:"decidedAt,omitempty"`
 }
 
 // CleanupSummary provides aggregate counts for cleanup operations
@@ -255,23 +1175,92 @@ type CleanupSummary struct {
 	// TotalEligible is the number of resources eligible for cleanup
 	TotalEligible int `json:"totalEligible"`
 
-	// TotalDeleted is the number of resources actually deleted
+	// TotalDeleted is the number of resources actually deleted. Always 0 in a dry run.
 	TotalDeleted int `json:"totalDeleted"`
 
+	// TotalWouldDelete is the number of resources a dry run would have deleted
+	// +optional
+	TotalWouldDelete int `json:"totalWouldDelete,omitempty"`
+
+	// TotalWouldScaleToZero is the number of workloads a dry run would have scaled to zero
+	// +optional
+	TotalWouldScaleToZero int `json:"totalWouldScaleToZero,omitempty"`
+
+	// TotalWouldPatch is the number of resources a dry run would have patched
+	// +optional
+	TotalWouldPatch int `json:"totalWouldPatch,omitempty"`
+
+	// TotalWouldLabel is the number of resources a dry run would have labeled as orphaned
+	// +optional
+	TotalWouldLabel int `json:"totalWouldLabel,omitempty"`
+
 	// TotalFailed is the number of failed deletion attempts
 	TotalFailed int `json:"totalFailed"`
 
+	// TotalPatched is the number of resources patched instead of deleted, e.g. ServiceAccounts
+	// with dangling imagePullSecrets entries removed
+	// +optional
+	TotalPatched int `json:"totalPatched,omitempty"`
+
+	// TotalScaledToZero is the number of workloads scaled to zero instead of deleted
+	// +optional
+	TotalScaledToZero int `json:"totalScaledToZero,omitempty"`
+
+	// TotalLabeled is the number of resources only labeled as orphaned
+	// +optional
+	TotalLabeled int `json:"totalLabeled,omitempty"`
+
+	// TotalSkippedAction is the count skipped because their action is not in AllowedActions
+	// +optional
+	TotalSkippedAction int `json:"totalSkippedAction,omitempty"`
+
+	// TotalSkippedPolicy is the count skipped because a KorpPolicy protects them or forbids their deletion
+	// +optional
+	TotalSkippedPolicy int `json:"totalSkippedPolicy,omitempty"`
+
+	// TotalSkippedBudget is the count skipped because the deletion budget was used up
+	// +optional
+	TotalSkippedBudget int `json:"totalSkippedBudget,omitempty"`
+
+	// TotalPendingApproval is the count waiting for approval
+	// +optional
+	TotalPendingApproval int `json:"totalPendingApproval,omitempty"`
+
+	// TotalRejected is the count skipped because their approval was rejected
+	// +optional
+	TotalRejected int `json:"totalRejected,omitempty"`
+
 	// TotalSkippedPreserved is the count skipped due to preservation labels
 	TotalSkippedPreserved int `json:"totalSkippedPreserved"`
 
 	// TotalSkippedAge is the count skipped due to age threshold
 	TotalSkippedAge int `json:"totalSkippedAge"`
 
+	// TotalSkippedScans is the count skipped because fewer than requiredConsecutiveScans successive scans reported them
+	// +optional
+	TotalSkippedScans int `json:"totalSkippedScans,omitempty"`
+
+	// TotalSkippedSeverity is the count skipped for a severity or confidence below minSeverity or minConfidence
+	// +optional
+	TotalSkippedSeverity int `json:"totalSkippedSeverity,omitempty"`
+
+	// ReclaimedStorage is the total capacity of deleted PVCs and PVs (e.g. "15Gi")
+	// +optional
+	ReclaimedStorage string `json:"reclaimedStorage,omitempty"`
+
+	// FreedCPU is the total CPU requested by deleted workloads (e.g. "1500m")
+	// +optional
+	FreedCPU string `json:"freedCPU,omitempty"`
+
+	// FreedMemory is the total memory requested by deleted workloads (e.g. "2Gi")
+	// +optional
+	FreedMemory string `json:"freedMemory,omitempty"`
+
 	// DryRun indicates if this was a dry-run operation
 	DryRun bool `json:"dryRun"`
 }
 
-// DeletedResource represents a resource that was deleted
+// DeletedResource represents a resource that was remediated; Action says how
 type DeletedResource struct {
 	// ResourceType is the type of resource (ConfigMap, Secret, etc.)
 	ResourceType string `json:"resourceType"`
@@ -282,8 +1271,32 @@ type DeletedResource struct {
 	// Name is the name of the deleted resource
 	Name string `json:"name"`
 
-	// DeletedAt is when the resource was deleted
+	// DeletedAt is when the resource was remediated
 	DeletedAt metav1.Time `json:"deletedAt"`
+
+	// Action is the remediation applied: delete, scaleToZero, patch or label
+	// +optional
+	Action string `json:"action,omitempty"`
+
+	// Backup is where the manifest of a deleted resource was stored: a configmap://, secret://,
+	// file:// or s3:// location. Set when cleanup backs up manifests.
+	// +optional
+	Backup string `json:"backup,omitempty"`
+}
+
+// PlannedRemediation is a remediation a dry run would have applied
+type PlannedRemediation struct {
+	// ResourceType is the type of resource (ConfigMap, Secret, etc.)
+	ResourceType string `json:"resourceType"`
+
+	// Namespace is the namespace of the resource
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Action is the remediation that would be applied: delete, scaleToZero, patch or label
+	Action string `json:"action"`
 }
 
 // FailedDeletion represents a resource that failed to delete
@@ -299,6 +1312,10 @@ type FailedDeletion struct {
 
 	// Error is the error message explaining the failure
 	Error string `json:"error"`
+
+	// Action is the remediation that failed: delete, scaleToZero, patch or label
+	// +optional
+	Action string `json:"action,omitempty"`
 }
 
 // ScanSummary provides aggregate counts of orphaned resources
@@ -307,9 +1324,39 @@ type ScanSummary struct {
 	// +optional
 	OrphanCount int `json:"orphanCount,omitempty"`
 
+	// NewOrphans is the number of findings the previous scan did not report
+	// +optional
+	NewOrphans int `json:"newOrphans,omitempty"`
+
+	// ResolvedOrphans is the number of findings of the previous scan that are no longer reported
+	// +optional
+	ResolvedOrphans int `json:"resolvedOrphans,omitempty"`
+
 	// TotalResources is the total number of resources scanned
 	TotalResources int `json:"totalResources"`
 
+	// CountsByType counts findings per resource type, including those that do not mark orphans.
+	// Detectors are counted here without changes to this struct.
+	// +optional
+	CountsByType map[string]int `json:"countsByType,omitempty"`
+
+	// CountsByReason counts findings per reason; OrphanCount is their total without
+	// OversizedObject and GitOpsManagedButUnused, which do not mark orphans
+	// +optional
+	CountsByReason map[string]int `json:"countsByReason,omitempty"`
+
+	// CountsBySeverity counts orphan findings per severity; OversizedObject and GitOpsManagedButUnused are left out
+	// +optional
+	CountsBySeverity map[string]int `json:"countsBySeverity,omitempty"`
+
+	// CountsByHelmRelease counts findings per Helm release ("<namespace>/<release>")
+	// +optional
+	CountsByHelmRelease map[string]int `json:"countsByHelmRelease,omitempty"`
+
+	// The per-type fields below are derived from CountsByType and CountsByReason for existing
+	// consumers. They are deprecated; v1alpha1 keeps serving them, and they are to be dropped once
+	// a v1alpha2 API version exists. Read the maps in new code.
+
 	// OrphanedConfigMaps is the count of orphaned ConfigMaps
 	OrphanedConfigMaps int `json:"orphanedConfigMaps"`
 
@@ -354,6 +1401,14 @@ type ScanSummary struct {
 	// +optional
 	OrphanedServiceAccounts int `json:"orphanedServiceAccounts,omitempty"`
 
+	// DanglingImagePullSecrets is the count of ServiceAccounts whose imagePullSecrets name missing Secrets
+	// +optional
+	DanglingImagePullSecrets int `json:"danglingImagePullSecrets,omitempty"`
+
+	// DanglingSecrets is the count of ServiceAccounts whose secrets list names missing Secrets
+	// +optional
+	DanglingSecrets int `json:"danglingSecrets,omitempty"`
+
 	// OrphanedRoles is the count of orphaned Roles (not referenced by any RoleBinding)
 	// +optional
 	OrphanedRoles int `json:"orphanedRoles,omitempty"`
@@ -390,23 +1445,84 @@ type ScanSummary struct {
 	// +optional
 	OrphanedEndpoints int `json:"orphanedEndpoints,omitempty"`
 
-	// OrphanedResourceQuotas is the count of orphaned ResourceQuotas (namespace has no pods)
+	// OrphanedEndpointSlices is the count of EndpointSlices whose Service no longer exists
+	// +optional
+	OrphanedEndpointSlices int `json:"orphanedEndpointSlices,omitempty"`
+
+	// OrphanedResourceQuotas is the count of ResourceQuotas in namespaces without workloads,
+	// or whose constrained resources were never created
 	// +optional
 	OrphanedResourceQuotas int `json:"orphanedResourceQuotas,omitempty"`
+
+	// OrphanedLimitRanges is the count of LimitRanges in namespaces without workloads
+	// +optional
+	OrphanedLimitRanges int `json:"orphanedLimitRanges,omitempty"`
+
+	// OrphanedSecretProviderClasses is the count of SecretProviderClasses not mounted by any pod
+	// +optional
+	OrphanedSecretProviderClasses int `json:"orphanedSecretProviderClasses,omitempty"`
+
+	// OrphanedExternalSecrets is the count of ExternalSecrets whose target Secret is unused
+	// +optional
+	OrphanedExternalSecrets int `json:"orphanedExternalSecrets,omitempty"`
+
+	// OrphanedFlowSchemas is the count of FlowSchemas referencing missing priority levels or deleted namespaces
+	// +optional
+	OrphanedFlowSchemas int `json:"orphanedFlowSchemas,omitempty"`
+
+	// OrphanedPriorityLevelConfigurations is the count of PriorityLevelConfigurations not used by any FlowSchema
+	// +optional
+	OrphanedPriorityLevelConfigurations int `json:"orphanedPriorityLevelConfigurations,omitempty"`
+
+	// StaleWebhookConfigurations is the count of Mutating/ValidatingWebhookConfigurations whose caBundle has no valid certificate
+	// +optional
+	StaleWebhookConfigurations int `json:"staleWebhookConfigurations,omitempty"`
+
+	// LeftoverResources is the count of resources left behind by uninstalled Helm releases or OLM operators
+	// +optional
+	LeftoverResources int `json:"leftoverResources,omitempty"`
+
+	// AgeDistribution counts findings by how long ago they were first detected
+	// +optional
+	AgeDistribution *AgeDistribution `json:"ageDistribution,omitempty"`
+
+	// OldestByType is the longest-standing finding of each resource type, sorted by type
+	// +optional
+	OldestByType []OldestFinding `json:"oldestByType,omitempty"`
+}
+
+// AgeDistribution counts findings per age bucket
+type AgeDistribution struct {
+	// Under7Days counts findings first detected less than 7 days ago
+	Under7Days int `json:"under7Days"`
+
+	// From7To30Days counts findings first detected 7 to 30 days ago
+	From7To30Days int `json:"from7To30Days"`
+
+	// From30To90Days counts findings first detected 30 to 90 days ago
+	From30To90Days int `json:"from30To90Days"`
+
+	// Over90Days counts findings first detected more than 90 days ago
+	Over90Days int `json:"over90Days"`
 }
 
-// TotalOrphans returns the sum of all orphaned resources
-func (s *ScanSummary) TotalOrphans() int {
-	return s.OrphanedConfigMaps + s.OrphanedSecrets + s.OrphanedPVCs +
-		s.ServicesWithoutEndpoints + s.OrphanedDeployments +
-		s.OrphanedJobs + s.OrphanedIngresses +
-		s.OrphanedStatefulSets + s.OrphanedDaemonSets +
-		s.OrphanedCronJobs + s.OrphanedReplicaSets +
-		s.OrphanedServiceAccounts + s.OrphanedRoles +
-		s.OrphanedClusterRoles + s.OrphanedRoleBindings +
-		s.OrphanedClusterRoleBindings + s.OrphanedNetworkPolicies +
-		s.OrphanedPodDisruptionBudgets + s.OrphanedHPAs +
-		s.OrphanedPVs + s.OrphanedEndpoints + s.OrphanedResourceQuotas
+// OldestFinding identifies the longest-standing finding of one resource type
+type OldestFinding struct {
+	// ResourceType is the kind of resource
+	ResourceType string `json:"resourceType"`
+
+	// Namespace of the finding, empty for cluster-scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the finding
+	Name string `json:"name"`
+
+	// DetectedAt is when the finding was first detected
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// AgeDays is the number of whole days since DetectedAt
+	AgeDays int `json:"ageDays"`
 }
 
 // Finding represents a single orphaned resource
@@ -428,11 +1544,134 @@ type Finding struct {
 	// Namespace where the resource is located
 	Namespace string `json:"namespace"`
 
+	// UID is the resource's UID when it was detected, so events can reference
+	// the resource without reading it again
+	// +optional
+	UID types.UID `json:"uid,omitempty"`
+
 	// Reason explains why this resource is considered orphaned
 	Reason string `json:"reason"`
 
 	// DetectedAt timestamp when this orphan was first detected
 	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// ConsecutiveScans is how many successive scans, this one included, reported the resource
+	// +optional
+	ConsecutiveScans int `json:"consecutiveScans,omitempty"`
+
+	// SuggestedAction is the remediation cleanup applies: delete, scaleToZero, patch or label
+	// +optional
+	SuggestedAction string `json:"suggestedAction,omitempty"`
+
+	// Severity is how much the finding matters: Low, Medium or High
+	// +kubebuilder:validation:Enum=Low;Medium;High
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// Confidence is how sure the detector is of the finding: definite, or heuristic when inferred
+	// from state that may yet change
+	// +kubebuilder:validation:Enum=heuristic;definite
+	// +optional
+	Confidence string `json:"confidence,omitempty"`
+
+	// LeftBehindBy names the uninstalled application that left this resource behind
+	// ("helm:<namespace>/<release>" or "olm:<namespace>/<csv>"). Set in uninstall-audit mode.
+	// +optional
+	LeftBehindBy string `json:"leftBehindBy,omitempty"`
+
+	// HelmRelease is the Helm release ("<namespace>/<release>") that manages the resource
+	// +optional
+	HelmRelease string `json:"helmRelease,omitempty"`
+
+	// HelmReleaseAbandoned is set when HelmRelease has no deployed revision left
+	// +optional
+	HelmReleaseAbandoned bool `json:"helmReleaseAbandoned,omitempty"`
+
+	// GitOpsOwner is the Argo CD Application ("argocd:<application>") or Flux object
+	// ("flux:<Kustomization|HelmRelease>/<namespace>/<name>") that deploys the resource.
+	// Set with reason GitOpsManagedButUnused.
+	// +optional
+	GitOpsOwner string `json:"gitOpsOwner,omitempty"`
+
+	// Claim is the deleted PersistentVolumeClaim ("<namespace>/<name>") a Released PersistentVolume
+	// was bound to. Set with reason ClaimDeleted.
+	// +optional
+	Claim string `json:"claim,omitempty"`
+}
+
+// FindingRef identifies a finding that appeared or disappeared between two scans
+type FindingRef struct {
+	// ResourceType is the kind of resource (ConfigMap, Secret, Service, etc.)
+	ResourceType string `json:"resourceType"`
+
+	// Namespace where the resource is located
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Reason is why the resource was reported
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// DetectedAt is when the resource was first reported
+	// +optional
+	DetectedAt *metav1.Time `json:"detectedAt,omitempty"`
+}
+
+// LeftBehindGroup counts the resources left behind by one uninstalled application
+type LeftBehindGroup struct {
+	// App is "helm:<namespace>/<release>" or "olm:<namespace>/<csv>"
+	App string `json:"app"`
+
+	// Count is the number of resources left behind
+	Count int `json:"count"`
+}
+
+// BlindSpot is a resource type korp could not scan because it lacked permission to list it
+type BlindSpot struct {
+	// ResourceType is the resource type as named in spec.resourceTypes
+	ResourceType string `json:"resourceType"`
+
+	// Namespaces lists up to the first 20 namespaces the type could not be listed in.
+	// Empty for cluster-scoped types.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceCount is the number of namespaces the type could not be listed in
+	// +optional
+	NamespaceCount int `json:"namespaceCount,omitempty"`
+
+	// Error is the first permission error returned by the API server
+	Error string `json:"error"`
+}
+
+// ClusterInfo describes a scanned cluster and what a scan could detect on it
+type ClusterInfo struct {
+	// Version is the Kubernetes version reported by the API server (e.g. "v1.30.2")
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Platform is the API server's operating system and architecture (e.g. "linux/amd64")
+	// +optional
+	Platform string `json:"platform,omitempty"`
+
+	// ActiveDetectors are the resource types the scan ran detectors for
+	// +optional
+	ActiveDetectors []string `json:"activeDetectors,omitempty"`
+
+	// SkippedDetectors are the resource types the scan did not run detectors for, and why
+	// +optional
+	SkippedDetectors []SkippedDetector `json:"skippedDetectors,omitempty"`
+}
+
+// SkippedDetector is a resource type a scan did not run its detector for
+type SkippedDetector struct {
+	// ResourceType is the resource type as written in spec.resourceTypes
+	ResourceType string `json:"resourceType"`
+
+	// Reason explains why the detector did not run
+	Reason string `json:"reason"`
 }
 
 // HistoryEntry represents a historical scan result
@@ -445,6 +1684,36 @@ type HistoryEntry struct {
 
 	// Duration is how long the scan took
 	Duration string `json:"duration"`
+
+	// NewOrphans is the number of orphans first detected in this scan
+	// +optional
+	NewOrphans int `json:"newOrphans,omitempty"`
+
+	// ResolvedOrphans is the number of orphans from the previous scan that are no longer found
+	// +optional
+	ResolvedOrphans int `json:"resolvedOrphans,omitempty"`
+
+	// Partial is set when the scan was cut short by a timeout or cancellation.
+	// OrphanCount then counts only what was found before, and findings are left unchanged.
+	// +optional
+	Partial bool `json:"partial,omitempty"`
+
+	// SkippedNamespaces lists namespaces a partial scan did not finish, up to the first 20
+	// +optional
+	SkippedNamespaces []string `json:"skippedNamespaces,omitempty"`
+
+	// SkippedNamespaceCount is the number of namespaces a partial scan did not finish
+	// +optional
+	SkippedNamespaceCount int `json:"skippedNamespaceCount,omitempty"`
+
+	// Incremental is set for watch-mode rescans, which scanned only the namespaces whose resources
+	// changed and carried over the findings of the others
+	// +optional
+	Incremental bool `json:"incremental,omitempty"`
+
+	// Report is the KorpReport holding the scan's findings, while it is retained
+	// +optional
+	Report string `json:"report,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -478,5 +1747,5 @@ type KorpScanList struct {
 }
 
 func init() {
-	SchemeBuilder.Register(&KorpScan{}, &KorpScanList{})
+	register(&KorpScan{}, &KorpScanList{})
 }
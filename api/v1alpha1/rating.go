@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package v1alpha1
+
+// severityRanks orders severities; findings without one rank lowest
+var severityRanks = map[string]int{SeverityLow: 1, SeverityMedium: 2, SeverityHigh: 3}
+
+// Meets reports whether the finding's severity and confidence reach the given minimums; empty minimums accept all
+func (f *Finding) Meets(minSeverity, minConfidence string) bool {
+	if minSeverity != "" && severityRanks[f.Severity] < severityRanks[minSeverity] {
+		return false
+	}
+	return minConfidence != ConfidenceDefinite || f.Confidence == ConfidenceDefinite
+}
+
+// CountAtLeast returns the number of orphan findings of at least minSeverity; "" counts every orphan
+func (s *ScanSummary) CountAtLeast(minSeverity string) int {
+	if minSeverity == "" {
+		return s.TotalOrphans()
+	}
+	count := 0
+	for severity, n := range s.CountsBySeverity {
+		if severityRanks[severity] >= severityRanks[minSeverity] {
+			count += n
+		}
+	}
+	return count
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package v1alpha1
+
+// legacyCounts maps the per-type ScanSummary fields to the findings they count. An entry with a
+// reason takes precedence over the entry for its resource type without one. Findings left behind
+// by uninstalled apps are counted in LeftoverResources instead.
+var legacyCounts = []struct {
+	resourceType string
+	reason       string
+	field        func(s *ScanSummary) *int
+}{
+	{"ConfigMap", "", func(s *ScanSummary) *int { return &s.OrphanedConfigMaps }},
+	{"Secret", "", func(s *ScanSummary) *int { return &s.OrphanedSecrets }},
+	{"PersistentVolumeClaim", "", func(s *ScanSummary) *int { return &s.OrphanedPVCs }},
+	{"Service", "", func(s *ScanSummary) *int { return &s.ServicesWithoutEndpoints }},
+	{"Deployment", "", func(s *ScanSummary) *int { return &s.OrphanedDeployments }},
+	{"Job", "", func(s *ScanSummary) *int { return &s.OrphanedJobs }},
+	{"Ingress", "", func(s *ScanSummary) *int { return &s.OrphanedIngresses }},
+	{"StatefulSet", "", func(s *ScanSummary) *int { return &s.OrphanedStatefulSets }},
+	{"DaemonSet", "", func(s *ScanSummary) *int { return &s.OrphanedDaemonSets }},
+	{"CronJob", "", func(s *ScanSummary) *int { return &s.OrphanedCronJobs }},
+	{"ReplicaSet", "", func(s *ScanSummary) *int { return &s.OrphanedReplicaSets }},
+	{"ServiceAccount", "", func(s *ScanSummary) *int { return &s.OrphanedServiceAccounts }},
+	{"ServiceAccount", "DanglingImagePullSecret", func(s *ScanSummary) *int { return &s.DanglingImagePullSecrets }},
+	{"ServiceAccount", "DanglingSecret", func(s *ScanSummary) *int { return &s.DanglingSecrets }},
+	{"Role", "", func(s *ScanSummary) *int { return &s.OrphanedRoles }},
+	{"ClusterRole", "", func(s *ScanSummary) *int { return &s.OrphanedClusterRoles }},
+	{"RoleBinding", "", func(s *ScanSummary) *int { return &s.OrphanedRoleBindings }},
+	{"ClusterRoleBinding", "", func(s *ScanSummary) *int { return &s.OrphanedClusterRoleBindings }},
+	{"NetworkPolicy", "", func(s *ScanSummary) *int { return &s.OrphanedNetworkPolicies }},
+	{"PodDisruptionBudget", "", func(s *ScanSummary) *int { return &s.OrphanedPodDisruptionBudgets }},
+	{"HorizontalPodAutoscaler", "", func(s *ScanSummary) *int { return &s.OrphanedHPAs }},
+	{"PersistentVolume", "", func(s *ScanSummary) *int { return &s.OrphanedPVs }},
+	{"Endpoints", "", func(s *ScanSummary) *int { return &s.OrphanedEndpoints }},
+	{"EndpointSlice", "", func(s *ScanSummary) *int { return &s.OrphanedEndpointSlices }},
+	{"ResourceQuota", "", func(s *ScanSummary) *int { return &s.OrphanedResourceQuotas }},
+	{"LimitRange", "", func(s *ScanSummary) *int { return &s.OrphanedLimitRanges }},
+	{"SecretProviderClass", "", func(s *ScanSummary) *int { return &s.OrphanedSecretProviderClasses }},
+	{"ExternalSecret", "", func(s *ScanSummary) *int { return &s.OrphanedExternalSecrets }},
+	{"FlowSchema", "", func(s *ScanSummary) *int { return &s.OrphanedFlowSchemas }},
+	{"PriorityLevelConfiguration", "", func(s *ScanSummary) *int { return &s.OrphanedPriorityLevelConfigurations }},
+	{"MutatingWebhookConfiguration", "", func(s *ScanSummary) *int { return &s.StaleWebhookConfigurations }},
+	{"ValidatingWebhookConfiguration", "", func(s *ScanSummary) *int { return &s.StaleWebhookConfigurations }},
+}
+
+// nonOrphanReasons are finding reasons reported about resources that may well be in use or that korp
+// cannot clean up: oversized objects and resources a GitOps controller deploys. Neither the orphan
+// total nor the per-type fields count them.
+var nonOrphanReasons = map[string]bool{
+	"OversizedObject":        true,
+	"GitOpsManagedButUnused": true,
+}
+
+// IsOrphan reports whether f marks an orphaned resource. Orphan counts, metrics, namespace annotations
+// and resource markings only take such findings into account.
+func IsOrphan(f Finding) bool {
+	return !nonOrphanReasons[f.Reason]
+}
+
+// Count sets CountsByType, CountsByReason, CountsBySeverity and CountsByHelmRelease from findings, and derives the per-type fields from them
+func (s *ScanSummary) Count(findings []Finding) {
+	s.CountsByType = nil
+	s.CountsByReason = nil
+	s.CountsBySeverity = nil
+	s.CountsByHelmRelease = nil
+	for _, entry := range legacyCounts {
+		*entry.field(s) = 0
+	}
+	s.LeftoverResources = 0
+
+	for _, f := range findings {
+		if s.CountsByType == nil {
+			s.CountsByType = make(map[string]int)
+			s.CountsByReason = make(map[string]int)
+		}
+		s.CountsByType[f.ResourceType]++
+		s.CountsByReason[f.Reason]++
+		if f.Severity != "" && IsOrphan(f) {
+			if s.CountsBySeverity == nil {
+				s.CountsBySeverity = make(map[string]int)
+			}
+			s.CountsBySeverity[f.Severity]++
+		}
+		if f.HelmRelease != "" {
+			if s.CountsByHelmRelease == nil {
+				s.CountsByHelmRelease = make(map[string]int)
+			}
+			s.CountsByHelmRelease[f.HelmRelease]++
+		}
+		if field := s.legacyField(f); field != nil {
+			*field++
+		}
+	}
+}
+
+// legacyField returns the per-type field that counts f, or nil if none does
+func (s *ScanSummary) legacyField(f Finding) *int {
+	if f.LeftBehindBy != "" {
+		return &s.LeftoverResources
+	}
+	if !IsOrphan(f) {
+		return nil
+	}
+	var field *int
+	for _, entry := range legacyCounts {
+		if entry.resourceType != f.ResourceType {
+			continue
+		}
+		if entry.reason == f.Reason {
+			return entry.field(s)
+		}
+		if entry.reason == "" {
+			field = entry.field(s)
+		}
+	}
+	return field
+}
+
+// TotalOrphans returns the number of findings counted in CountsByReason, except for reasons that do
+// not mark orphans. Summaries written before CountsByReason existed are totalled from their per-type fields.
+func (s *ScanSummary) TotalOrphans() int {
+	total := 0
+	if s.CountsByReason != nil {
+		for reason, n := range s.CountsByReason {
+			if IsOrphan(Finding{Reason: reason}) {
+				total += n
+			}
+		}
+		return total
+	}
+
+	counted := make(map[*int]bool)
+	for _, entry := range legacyCounts {
+		field := entry.field(s)
+		if !counted[field] {
+			counted[field] = true
+			total += *field
+		}
+	}
+	return total + s.LeftoverResources
+}
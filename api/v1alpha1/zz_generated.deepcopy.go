@@ -15,6 +15,148 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIRateLimitSpec) DeepCopyInto(out *APIRateLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIRateLimitSpec.
+func (in *APIRateLimitSpec) DeepCopy() *APIRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(APIRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeDistribution) DeepCopyInto(out *AgeDistribution) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeDistribution.
+func (in *AgeDistribution) DeepCopy() *AgeDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnomalyThreshold) DeepCopyInto(out *AnomalyThreshold) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnomalyThreshold.
+func (in *AnomalyThreshold) DeepCopy() *AnomalyThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(AnomalyThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucket) DeepCopyInto(out *BackupBucket) {
+	*out = *in
+	out.AccessKeyIDSecretRef = in.AccessKeyIDSecretRef
+	out.SecretAccessKeySecretRef = in.SecretAccessKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucket.
+func (in *BackupBucket) DeepCopy() *BackupBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(BackupBucket)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
+func (in *BackupSpec) DeepCopy() *BackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlindSpot) DeepCopyInto(out *BlindSpot) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlindSpot.
+func (in *BlindSpot) DeepCopy() *BlindSpot {
+	if in == nil {
+		return nil
+	}
+	out := new(BlindSpot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupAnomaly) DeepCopyInto(out *CleanupAnomaly) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.ResumedAt != nil {
+		in, out := &in.ResumedAt, &out.ResumedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupAnomaly.
+func (in *CleanupAnomaly) DeepCopy() *CleanupAnomaly {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupAnomaly)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupApproval) DeepCopyInto(out *CleanupApproval) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupApproval.
+func (in *CleanupApproval) DeepCopy() *CleanupApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CleanupSpec) DeepCopyInto(out *CleanupSpec) {
 	*out = *in
@@ -33,6 +175,28 @@ func (in *CleanupSpec) DeepCopyInto(out *CleanupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedActions != nil {
+		in, out := &in.AllowedActions, &out.AllowedActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnomalyThreshold != nil {
+		in, out := &in.AnomalyThreshold, &out.AnomalyThreshold
+		*out = new(AnomalyThreshold)
+		**out = **in
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TypePolicies != nil {
+		in, out := &in.TypePolicies, &out.TypePolicies
+		*out = make([]CleanupTypePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupSpec.
@@ -69,6 +233,18 @@ func (in *CleanupStatus) DeepCopyInto(out *CleanupStatus) {
 		*out = make([]FailedDeletion, len(*in))
 		copy(*out, *in)
 	}
+	if in.WouldRemediate != nil {
+		in, out := &in.WouldRemediate, &out.WouldRemediate
+		*out = make([]PlannedRemediation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]CleanupApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupStatus.
@@ -96,6 +272,86 @@ func (in *CleanupSummary) DeepCopy() *CleanupSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CleanupTypePolicy) DeepCopyInto(out *CleanupTypePolicy) {
+	*out = *in
+	if in.MinAgeDays != nil {
+		in, out := &in.MinAgeDays, &out.MinAgeDays
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupTypePolicy.
+func (in *CleanupTypePolicy) DeepCopy() *CleanupTypePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CleanupTypePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterInfo) DeepCopyInto(out *ClusterInfo) {
+	*out = *in
+	if in.ActiveDetectors != nil {
+		in, out := &in.ActiveDetectors, &out.ActiveDetectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkippedDetectors != nil {
+		in, out := &in.SkippedDetectors, &out.SkippedDetectors
+		*out = make([]SkippedDetector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterInfo.
+func (in *ClusterInfo) DeepCopy() *ClusterInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyRef) DeepCopyInto(out *ConfigMapKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyRef.
+func (in *ConfigMapKeyRef) DeepCopy() *ConfigMapKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResourceReferenceSpec) DeepCopyInto(out *CustomResourceReferenceSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]GroupVersionResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceReferenceSpec.
+func (in *CustomResourceReferenceSpec) DeepCopy() *CustomResourceReferenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResourceReferenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeletedResource) DeepCopyInto(out *DeletedResource) {
 	*out = *in
@@ -112,6 +368,36 @@ func (in *DeletedResource) DeepCopy() *DeletedResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DetectionSpec) DeepCopyInto(out *DetectionSpec) {
+	*out = *in
+	if in.ReferenceAnnotations != nil {
+		in, out := &in.ReferenceAnnotations, &out.ReferenceAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KnownPrincipals != nil {
+		in, out := &in.KnownPrincipals, &out.KnownPrincipals
+		*out = new(PrincipalSource)
+		**out = **in
+	}
+	if in.CustomResourceReferences != nil {
+		in, out := &in.CustomResourceReferences, &out.CustomResourceReferences
+		*out = new(CustomResourceReferenceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DetectionSpec.
+func (in *DetectionSpec) DeepCopy() *DetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedDeletion) DeepCopyInto(out *FailedDeletion) {
 	*out = *in
@@ -130,6 +416,18 @@ func (in *FailedDeletion) DeepCopy() *FailedDeletion {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 	*out = *in
+	if in.IncludeNamePatterns != nil {
+		in, out := &in.IncludeNamePatterns, &out.IncludeNamePatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludeLabels != nil {
+		in, out := &in.IncludeLabels, &out.IncludeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.ExcludeLabels != nil {
 		in, out := &in.ExcludeLabels, &out.ExcludeLabels
 		*out = make(map[string]string, len(*in))
@@ -147,6 +445,13 @@ func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GraceWindowMinutes != nil {
+		in, out := &in.GraceWindowMinutes, &out.GraceWindowMinutes
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
@@ -175,10 +480,81 @@ func (in *Finding) DeepCopy() *Finding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingDetection) DeepCopyInto(out *FindingDetection) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingDetection.
+func (in *FindingDetection) DeepCopy() *FindingDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingRef) DeepCopyInto(out *FindingRef) {
+	*out = *in
+	if in.DetectedAt != nil {
+		in, out := &in.DetectedAt, &out.DetectedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingRef.
+func (in *FindingRef) DeepCopy() *FindingRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupVersionResource) DeepCopyInto(out *GroupVersionResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupVersionResource.
+func (in *GroupVersionResource) DeepCopy() *GroupVersionResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupVersionResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderFromSecret) DeepCopyInto(out *HeaderFromSecret) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderFromSecret.
+func (in *HeaderFromSecret) DeepCopy() *HeaderFromSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderFromSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HistoryEntry) DeepCopyInto(out *HistoryEntry) {
 	*out = *in
 	in.ScanTime.DeepCopyInto(&out.ScanTime)
+	if in.SkippedNamespaces != nil {
+		in, out := &in.SkippedNamespaces, &out.SkippedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HistoryEntry.
@@ -192,26 +568,25 @@ func (in *HistoryEntry) DeepCopy() *HistoryEntry {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KorpScan) DeepCopyInto(out *KorpScan) {
+func (in *KorpPolicy) DeepCopyInto(out *KorpPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpScan.
-func (in *KorpScan) DeepCopy() *KorpScan {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpPolicy.
+func (in *KorpPolicy) DeepCopy() *KorpPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(KorpScan)
+	out := new(KorpPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KorpScan) DeepCopyObject() runtime.Object {
+func (in *KorpPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -219,13 +594,210 @@ func (in *KorpScan) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KorpScanList) DeepCopyInto(out *KorpScanList) {
+func (in *KorpPolicyList) DeepCopyInto(out *KorpPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KorpScan, len(*in))
+		*out = make([]KorpPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpPolicyList.
+func (in *KorpPolicyList) DeepCopy() *KorpPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(KorpPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KorpPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KorpPolicySpec) DeepCopyInto(out *KorpPolicySpec) {
+	*out = *in
+	if in.ScanSelector != nil {
+		in, out := &in.ScanSelector, &out.ScanSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectedNamespaces != nil {
+		in, out := &in.ProtectedNamespaces, &out.ProtectedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtectedLabels != nil {
+		in, out := &in.ProtectedLabels, &out.ProtectedLabels
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectedAnnotations != nil {
+		in, out := &in.ProtectedAnnotations, &out.ProtectedAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AllowedResourceTypes != nil {
+		in, out := &in.AllowedResourceTypes, &out.AllowedResourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NeverDelete != nil {
+		in, out := &in.NeverDelete, &out.NeverDelete
+		*out = make([]NeverDeleteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpPolicySpec.
+func (in *KorpPolicySpec) DeepCopy() *KorpPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KorpPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KorpReport) DeepCopyInto(out *KorpReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpReport.
+func (in *KorpReport) DeepCopy() *KorpReport {
+	if in == nil {
+		return nil
+	}
+	out := new(KorpReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KorpReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KorpReportList) DeepCopyInto(out *KorpReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KorpReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpReportList.
+func (in *KorpReportList) DeepCopy() *KorpReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(KorpReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KorpReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KorpReportSpec) DeepCopyInto(out *KorpReportSpec) {
+	*out = *in
+	in.ScanTime.DeepCopyInto(&out.ScanTime)
+	in.Summary.DeepCopyInto(&out.Summary)
+	if in.Findings != nil {
+		in, out := &in.Findings, &out.Findings
+		*out = make([]Finding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Detections != nil {
+		in, out := &in.Detections, &out.Detections
+		*out = make([]FindingDetection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpReportSpec.
+func (in *KorpReportSpec) DeepCopy() *KorpReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KorpReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KorpScan) DeepCopyInto(out *KorpScan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpScan.
+func (in *KorpScan) DeepCopy() *KorpScan {
+	if in == nil {
+		return nil
+	}
+	out := new(KorpScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KorpScan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KorpScanList) DeepCopyInto(out *KorpScanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KorpScan, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -253,12 +825,48 @@ func (in *KorpScanList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KorpScanSpec) DeepCopyInto(out *KorpScanSpec) {
 	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IncludeNamespaces != nil {
+		in, out := &in.IncludeNamespaces, &out.IncludeNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScanTimeout != nil {
+		in, out := &in.ScanTimeout, &out.ScanTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.APIRateLimit != nil {
+		in, out := &in.APIRateLimit, &out.APIRateLimit
+		*out = new(APIRateLimitSpec)
+		**out = **in
+	}
+	if in.Watch != nil {
+		in, out := &in.Watch, &out.Watch
+		*out = new(WatchSpec)
+		**out = **in
+	}
 	if in.ResourceTypes != nil {
 		in, out := &in.ResourceTypes, &out.ResourceTypes
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	in.Filters.DeepCopyInto(&out.Filters)
+	if in.FilterPresets != nil {
+		in, out := &in.FilterPresets, &out.FilterPresets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Detection.DeepCopyInto(&out.Detection)
 	in.Reporting.DeepCopyInto(&out.Reporting)
 	if in.Cleanup != nil {
 		in, out := &in.Cleanup, &out.Cleanup
@@ -284,7 +892,16 @@ func (in *KorpScanStatus) DeepCopyInto(out *KorpScanStatus) {
 		in, out := &in.LastScanTime, &out.LastScanTime
 		*out = (*in).DeepCopy()
 	}
-	out.Summary = in.Summary
+	if in.FirstScanTime != nil {
+		in, out := &in.FirstScanTime, &out.FirstScanTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(ScanProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Summary.DeepCopyInto(&out.Summary)
 	if in.Findings != nil {
 		in, out := &in.Findings, &out.Findings
 		*out = make([]Finding, len(*in))
@@ -292,6 +909,37 @@ func (in *KorpScanStatus) DeepCopyInto(out *KorpScanStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(ClusterInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NewFindings != nil {
+		in, out := &in.NewFindings, &out.NewFindings
+		*out = make([]FindingRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResolvedFindings != nil {
+		in, out := &in.ResolvedFindings, &out.ResolvedFindings
+		*out = make([]FindingRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlindSpots != nil {
+		in, out := &in.BlindSpots, &out.BlindSpots
+		*out = make([]BlindSpot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LeftBehind != nil {
+		in, out := &in.LeftBehind, &out.LeftBehind
+		*out = make([]LeftBehindGroup, len(*in))
+		copy(*out, *in)
+	}
 	if in.History != nil {
 		in, out := &in.History, &out.History
 		*out = make([]HistoryEntry, len(*in))
@@ -311,11 +959,33 @@ func (in *KorpScanStatus) DeepCopyInto(out *KorpScanStatus) {
 		*out = new(WebhookStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SlackStatus != nil {
+		in, out := &in.SlackStatus, &out.SlackStatus
+		*out = new(WebhookStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotifierStatuses != nil {
+		in, out := &in.NotifierStatuses, &out.NotifierStatuses
+		*out = make([]NotifierStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PagerDutyStatus != nil {
+		in, out := &in.PagerDutyStatus, &out.PagerDutyStatus
+		*out = new(PagerDutyStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CleanupStatus != nil {
 		in, out := &in.CleanupStatus, &out.CleanupStatus
 		*out = new(CleanupStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastCleanupAnomaly != nil {
+		in, out := &in.LastCleanupAnomaly, &out.LastCleanupAnomaly
+		*out = new(CleanupAnomaly)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KorpScanStatus.
@@ -328,14 +998,232 @@ func (in *KorpScanStatus) DeepCopy() *KorpScanStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeftBehindGroup) DeepCopyInto(out *LeftBehindGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeftBehindGroup.
+func (in *LeftBehindGroup) DeepCopy() *LeftBehindGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(LeftBehindGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NeverDeleteRule) DeepCopyInto(out *NeverDeleteRule) {
+	*out = *in
+	if in.ResourceTypes != nil {
+		in, out := &in.ResourceTypes, &out.ResourceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NeverDeleteRule.
+func (in *NeverDeleteRule) DeepCopy() *NeverDeleteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NeverDeleteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierSpec) DeepCopyInto(out *NotifierSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(PagerDutyConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierSpec.
+func (in *NotifierSpec) DeepCopy() *NotifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierStatus) DeepCopyInto(out *NotifierStatus) {
+	*out = *in
+	in.WebhookStatus.DeepCopyInto(&out.WebhookStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierStatus.
+func (in *NotifierStatus) DeepCopy() *NotifierStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OldestFinding) DeepCopyInto(out *OldestFinding) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OldestFinding.
+func (in *OldestFinding) DeepCopy() *OldestFinding {
+	if in == nil {
+		return nil
+	}
+	out := new(OldestFinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyConfig) DeepCopyInto(out *PagerDutyConfig) {
+	*out = *in
+	out.RoutingKeySecretRef = in.RoutingKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyConfig.
+func (in *PagerDutyConfig) DeepCopy() *PagerDutyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyStatus) DeepCopyInto(out *PagerDutyStatus) {
+	*out = *in
+	in.WebhookStatus.DeepCopyInto(&out.WebhookStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyStatus.
+func (in *PagerDutyStatus) DeepCopy() *PagerDutyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PayloadTemplate) DeepCopyInto(out *PayloadTemplate) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadTemplate.
+func (in *PayloadTemplate) DeepCopy() *PayloadTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PayloadTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedRemediation) DeepCopyInto(out *PlannedRemediation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedRemediation.
+func (in *PlannedRemediation) DeepCopy() *PlannedRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrincipalSource) DeepCopyInto(out *PrincipalSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrincipalSource.
+func (in *PrincipalSource) DeepCopy() *PrincipalSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PrincipalSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportingSpec) DeepCopyInto(out *ReportingSpec) {
 	*out = *in
+	if in.SeverityOverrides != nil {
+		in, out := &in.SeverityOverrides, &out.SeverityOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(WebhookConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifiers != nil {
+		in, out := &in.Notifiers, &out.Notifiers
+		*out = make([]NotifierSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(PagerDutyConfig)
+		**out = **in
+	}
+	if in.SARIF != nil {
+		in, out := &in.SARIF, &out.SARIF
+		*out = new(SARIFReportConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportingSpec.
@@ -363,9 +1251,80 @@ func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SARIFReportConfig) DeepCopyInto(out *SARIFReportConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SARIFReportConfig.
+func (in *SARIFReportConfig) DeepCopy() *SARIFReportConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SARIFReportConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScanProgress) DeepCopyInto(out *ScanProgress) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScanProgress.
+func (in *ScanProgress) DeepCopy() *ScanProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ScanProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScanSummary) DeepCopyInto(out *ScanSummary) {
 	*out = *in
+	if in.CountsByType != nil {
+		in, out := &in.CountsByType, &out.CountsByType
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CountsByReason != nil {
+		in, out := &in.CountsByReason, &out.CountsByReason
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CountsBySeverity != nil {
+		in, out := &in.CountsBySeverity, &out.CountsBySeverity
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CountsByHelmRelease != nil {
+		in, out := &in.CountsByHelmRelease, &out.CountsByHelmRelease
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AgeDistribution != nil {
+		in, out := &in.AgeDistribution, &out.AgeDistribution
+		*out = new(AgeDistribution)
+		**out = **in
+	}
+	if in.OldestByType != nil {
+		in, out := &in.OldestByType, &out.OldestByType
+		*out = make([]OldestFinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScanSummary.
@@ -378,6 +1337,108 @@ func (in *ScanSummary) DeepCopy() *ScanSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyRef) DeepCopyInto(out *SecretKeyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyRef.
+func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretRef.
+func (in *SecretRef) DeepCopy() *SecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedDetector) DeepCopyInto(out *SkippedDetector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedDetector.
+func (in *SkippedDetector) DeepCopy() *SkippedDetector {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedDetector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackConfig) DeepCopyInto(out *SlackConfig) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]SlackRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackConfig.
+func (in *SlackConfig) DeepCopy() *SlackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackRoute) DeepCopyInto(out *SlackRoute) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackRoute.
+func (in *SlackRoute) DeepCopy() *SlackRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchSpec) DeepCopyInto(out *WatchSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchSpec.
+func (in *WatchSpec) DeepCopy() *WatchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 	*out = *in
@@ -388,11 +1449,41 @@ func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.HeadersFrom != nil {
+		in, out := &in.HeadersFrom, &out.HeadersFrom
+		*out = make([]HeaderFromSecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.BearerTokenSecretRef != nil {
+		in, out := &in.BearerTokenSecretRef, &out.BearerTokenSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
 	if in.RetryPolicy != nil {
 		in, out := &in.RetryPolicy, &out.RetryPolicy
 		*out = new(RetryPolicy)
 		**out = **in
 	}
+	if in.SigningSecretRef != nil {
+		in, out := &in.SigningSecretRef, &out.SigningSecretRef
+		*out = new(SecretKeyRef)
+		**out = **in
+	}
+	if in.PayloadTemplate != nil {
+		in, out := &in.PayloadTemplate, &out.PayloadTemplate
+		*out = new(PayloadTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookConfig.
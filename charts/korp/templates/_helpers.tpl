@@ -69,3 +69,10 @@ Create the namespace
 {{- .Release.Namespace }}
 {{- end }}
 {{- end }}
+
+{{/*
+Name of the Secret holding the findings API serving certificate
+*/}}
+{{- define "korp.findingsAPISecretName" -}}
+{{- default (printf "%s-findings-api-tls" (include "korp.fullname" .)) .Values.findingsAPI.certSecretName }}
+{{- end }}
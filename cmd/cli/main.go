@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -9,6 +10,9 @@ import (
 
 func main() {
 	if err := app.Run(os.Args[1:]); err != nil {
+		if errors.Is(err, app.ErrOrphansFound) || errors.Is(err, app.ErrNewOrphansFound) {
+			os.Exit(2)
+		}
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -12,15 +12,22 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/metadata"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
 	"github.com/kamilbabayev/korp/internal/controller"
 	"github.com/kamilbabayev/korp/pkg/cleanup"
+	"github.com/kamilbabayev/korp/pkg/findingsapi"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
 	"github.com/kamilbabayev/korp/pkg/reporter"
 	"github.com/kamilbabayev/korp/pkg/scan"
 )
@@ -39,9 +46,34 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var secureMetrics bool
+	var metricsAuth bool
+	var metricsCertPath, metricsCertName, metricsCertKey string
+	var scanQPS float64
+	var scanBurst int
+	var listChunkSize int64
+	var findingsAPIAddr, findingsAPICertPath string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.BoolVar(&secureMetrics, "metrics-secure", false,
+		"Serve metrics over HTTPS. Without --metrics-cert-path a self-signed certificate is generated.")
+	flag.BoolVar(&metricsAuth, "metrics-auth", false,
+		"Require a bearer token authorized for the metrics path via TokenReview and SubjectAccessReview.")
+	flag.StringVar(&metricsCertPath, "metrics-cert-path", "", "The directory that contains the metrics server certificate.")
+	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
+	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
+	flag.Float64Var(&scanQPS, "scan-qps", 20,
+		"Requests per second of the API client scans use, separate from the controller's client. "+
+			"KorpScans can set their own limit with spec.apiRateLimit.")
+	flag.IntVar(&scanBurst, "scan-burst", 40, "Burst of the API client scans use.")
+	flag.Int64Var(&listChunkSize, "list-chunk-size", k8sutil.DefaultChunkSize,
+		"Number of items scans list per request; larger collections are listed in several requests. 0 lists them in one.")
+	flag.StringVar(&findingsAPIAddr, "findings-api-bind-address", "",
+		"The address the findings.korp.io aggregated API binds to (e.g. \":8443\"). Empty disables it.")
+	flag.StringVar(&findingsAPICertPath, "findings-api-cert-path", "",
+		"The directory with tls.crt and tls.key of the findings API, signed by the CA in the APIService's caBundle. "+
+			"Required with --findings-api-bind-address.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -54,11 +86,32 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	metricsOptions := metricsserver.Options{
+		BindAddress:   metricsAddr,
+		SecureServing: secureMetrics,
+	}
+	if metricsCertPath != "" {
+		metricsOptions.CertDir = metricsCertPath
+		metricsOptions.CertName = metricsCertName
+		metricsOptions.KeyName = metricsCertKey
+	}
+	if metricsAuth {
+		if !secureMetrics {
+			setupLog.Info("--metrics-auth without --metrics-secure sends bearer tokens in plaintext")
+		}
+		metricsOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
+		Metrics:                metricsOptions,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "korp.io",
+		// KorpReports hold full finding lists and are read rarely, so they are not cached
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&korpv1alpha1.KorpReport{}}},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -72,19 +125,56 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Create dynamic client for third-party custom resources
+	dynamicClient, err := dynamic.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create dynamic client")
+		os.Exit(1)
+	}
+
+	// Create metadata client for informers that cache object metadata only
+	metadataClient, err := metadata.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create metadata client")
+		os.Exit(1)
+	}
+
+	// Scans get clients of their own so heavy scans cannot throttle reconciliation
+	scanner, err := scan.NewScannerForConfig(mgr.GetConfig(), float32(scanQPS), scanBurst)
+	if err != nil {
+		setupLog.Error(err, "unable to create scan clients")
+		os.Exit(1)
+	}
+	scanner = scanner.WithChunkSize(listChunkSize)
+
 	// Setup the KorpScan controller
 	if err = (&controller.KorpScanReconciler{
 		Client:    mgr.GetClient(),
 		Scheme:    mgr.GetScheme(),
 		Clientset: clientset,
-		Scanner:   scan.NewScanner(clientset),
-		Reporter:  reporter.NewEventReporter(clientset, mgr.GetScheme()),
-		Cleaner:   cleanup.NewCleaner(clientset, ctrl.Log.WithName("cleaner")),
+		Scanner:   scanner,
+		Reporter:  reporter.NewEventReporter(clientset, dynamicClient, mgr.GetScheme()),
+		Cleaner:   cleanup.NewCleaner(clientset, dynamicClient, ctrl.Log.WithName("cleaner")),
+		Metadata:  metadataClient,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KorpScan")
 		os.Exit(1)
 	}
 
+	// Serve findings to namespace owners through an APIService
+	if findingsAPIAddr != "" {
+		if findingsAPICertPath == "" {
+			setupLog.Error(nil, "--findings-api-bind-address requires --findings-api-cert-path")
+			os.Exit(1)
+		}
+		server := findingsapi.NewServer(findingsAPIAddr, findingsAPICertPath, mgr.GetCache(), mgr.GetClient(), clientset,
+			ctrl.Log.WithName("findings-api"))
+		if err := mgr.Add(server); err != nil {
+			setupLog.Error(err, "unable to add findings API server")
+			os.Exit(1)
+		}
+	}
+
 	// Add health and readiness checks
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
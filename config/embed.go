@@ -0,0 +1,15 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package config embeds the operator's install manifests so the CLI can render them for the exact binary it ships with
+package config
+
+import "embed"
+
+// Manifests holds the CRDs, RBAC and operator Deployment under crd/, rbac/ and operator/
+//
+//go:embed crd/*.yaml rbac/*.yaml operator/*.yaml
+var Manifests embed.FS
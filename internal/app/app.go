@@ -2,58 +2,94 @@ package app
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/retry"
 
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
 	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/report"
+	"github.com/kamilbabayev/korp/pkg/scan"
 )
 
-type scanResult struct {
-	Namespace                string   `json:"namespace"`
-	Pods                     int      `json:"pods"`
-	ConfigMaps               int      `json:"configmaps"`
-	Secrets                  int      `json:"secrets"`
-	Services                 int      `json:"services"`
-	PVCs                     int      `json:"pvcs"`
-	Endpoints                int      `json:"endpoints"`
-	OrphanConfigMaps         int      `json:"orphan_configmaps"`
-	OrphanSecrets            int      `json:"orphan_secrets"`
-	OrphanPVCs               int      `json:"orphan_pvcs"`
-	ServicesNoEndpoints      int      `json:"services_no_endpoints"`
-	OrphanEndpoints          int      `json:"orphan_endpoints"`
-	OrphanConfigMapNames     []string `json:"orphan_configmap_names,omitempty"`
-	OrphanSecretNames        []string `json:"orphan_secret_names,omitempty"`
-	OrphanPVCNames           []string `json:"orphan_pvc_names,omitempty"`
-	ServicesNoEndpointsNames []string `json:"services_no_endpoints_names,omitempty"`
-	OrphanEndpointNames      []string `json:"orphan_endpoint_names,omitempty"`
+// kubeFlags are the kubectl connection flags of commands that talk to a cluster,
+// so `kubectl korp` can be pointed at a cluster like any other kubectl command
+type kubeFlags struct {
+	kubeconfig string
+	context    string
+	chunkSize  int64
 }
 
-func buildClient(kubeconfig string) (*kubernetes.Clientset, error) {
-	// Try in-cluster first when kubeconfig not provided
-	if kubeconfig == "" {
+// addKubeFlags registers --kubeconfig, --context and --list-chunk-size on fs
+func addKubeFlags(fs *flag.FlagSet, kubeconfigUsage string) *kubeFlags {
+	kube := &kubeFlags{}
+	fs.StringVar(&kube.kubeconfig, "kubeconfig", "", kubeconfigUsage)
+	fs.StringVar(&kube.context, "context", "", "name of the kubeconfig context to use")
+	fs.Int64Var(&kube.chunkSize, "list-chunk-size", k8sutil.DefaultChunkSize,
+		"number of items listed per request of core and workload resources; larger collections are listed in several requests, 0 lists them in one")
+	return kube
+}
+
+func buildConfig(kube *kubeFlags) (*rest.Config, error) {
+	// Try in-cluster first when no kubeconfig is given in any form
+	if kube.kubeconfig == "" && kube.context == "" && os.Getenv(clientcmd.RecommendedConfigPathEnvVar) == "" {
 		if cfg, err := rest.InClusterConfig(); err == nil {
-			return kubernetes.NewForConfig(cfg)
-		}
-		// fallback to default kubeconfig
-		if home, err := os.UserHomeDir(); err == nil {
-			kubeconfig = filepath.Join(home, ".kube", "config")
+			return cfg, nil
 		}
 	}
 
-	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	// Same resolution as kubectl: --kubeconfig, then $KUBECONFIG, then ~/.kube/config
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kube.kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kube.context}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+}
+
+// buildClient creates clients that list metadata through the metadata API and answer repeated Lists
+// of the resources detectors share from one snapshot, listed in chunks of --list-chunk-size items.
+// Commands that read the cluster more than once use buildLiveClient instead.
+func buildClient(kube *kubeFlags) (kubernetes.Interface, dynamic.Interface, error) {
+	client, dynamicClient, err := buildLiveClient(kube)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return kubernetes.NewForConfig(cfg)
+	return k8sutil.NewSnapshotClient(client, kube.chunkSize), dynamicClient, nil
+}
+
+// buildLiveClient creates clients that list metadata through the metadata API and send every request
+// to the API server
+func buildLiveClient(kube *kubeFlags) (kubernetes.Interface, dynamic.Interface, error) {
+	cfg, err := buildConfig(kube)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	metadataClient, err := metadata.NewForConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return k8sutil.NewMetadataClient(client, metadataClient), dynamicClient, nil
 }
 
 // getPodNamespace returns the namespace the pod is running in when running in-cluster.
@@ -66,222 +102,513 @@ func getPodNamespace() string {
 	return ""
 }
 
-// countIssueTypes returns the number of resource types with issues
-func countIssueTypes(res scanResult) int {
-	count := 0
-	if res.OrphanConfigMaps > 0 {
-		count++
-	}
-	if res.OrphanSecrets > 0 {
-		count++
-	}
-	if res.OrphanPVCs > 0 {
-		count++
+// objectNames returns the names of objs found scanning ns. Scans of all namespaces name each
+// object "namespace/name", so same-named objects of different namespaces stay apart.
+func objectNames(objs []metav1.ObjectMeta, ns string) []string {
+	names := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		if ns == metav1.NamespaceAll {
+			names = append(names, obj.Namespace+"/"+obj.Name)
+		} else {
+			names = append(names, obj.Name)
+		}
 	}
-	if res.ServicesNoEndpoints > 0 {
-		count++
+	return names
+}
+
+// withoutObjects returns the objs whose name is not present in the exclude set
+func withoutObjects(objs []metav1.ObjectMeta, exclude map[string]bool) []metav1.ObjectMeta {
+	var out []metav1.ObjectMeta
+	for _, obj := range objs {
+		if !exclude[obj.Name] {
+			out = append(out, obj)
+		}
 	}
-	if res.OrphanEndpoints > 0 {
-		count++
+	return out
+}
+
+// splitName splits a finding name of an all-namespaces scan into its namespace and name.
+// Names of a namespace scan are returned as they are, with ns.
+func splitName(ns, name string) (string, string) {
+	if ns == metav1.NamespaceAll {
+		if namespace, bare, ok := strings.Cut(name, "/"); ok {
+			return namespace, bare
+		}
 	}
-	return count
+	return ns, name
 }
 
-// Run performs the main application logic. Supports a simple `scan` command.
-func Run(args []string) error {
-	fs := flag.NewFlagSet("korp", flag.ContinueOnError)
-	namespace := fs.String("namespace", "", "namespace to scan")
-	allNamespaces := fs.Bool("all-namespaces", false, "scan all namespaces")
-	kubeconfig := fs.String("kubeconfig", "", "path to kubeconfig")
-	output := fs.String("output", "table", "output format: table|json")
+// detect runs the CLI detectors against a namespace (empty for all namespaces).
+// annotationKeys name annotations that reference ConfigMaps/Secrets in use.
+func detect(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, ns string, annotationKeys []string) (report.ScanResult, error) {
+	// Counts of types korp may not list stay zero; the detectors below report them as blind spots
+	res := report.ScanResult{SchemaVersion: report.SchemaVersion, Namespace: ns}
+	if list, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		res.Pods = len(list.Items)
+	} else if !apierrors.IsForbidden(err) {
+		return report.ScanResult{}, fmt.Errorf("listing pods: %w", err)
+	}
+	if list, err := client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		res.ConfigMaps = len(list.Items)
+	} else if !apierrors.IsForbidden(err) {
+		return report.ScanResult{}, fmt.Errorf("listing configmaps: %w", err)
+	}
+	if list, err := client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		res.Secrets = len(list.Items)
+	} else if !apierrors.IsForbidden(err) {
+		return report.ScanResult{}, fmt.Errorf("listing secrets: %w", err)
+	}
+	if list, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		res.Services = len(list.Items)
+	} else if !apierrors.IsForbidden(err) {
+		return report.ScanResult{}, fmt.Errorf("listing services: %w", err)
+	}
+	if list, err := client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		res.PVCs = len(list.Items)
+	} else if !apierrors.IsForbidden(err) {
+		return report.ScanResult{}, fmt.Errorf("listing pvcs: %w", err)
+	}
+	if list, err := client.CoreV1().Endpoints(ns).List(ctx, metav1.ListOptions{}); err == nil {
+		res.Endpoints = len(list.Items)
+	} else if !apierrors.IsForbidden(err) {
+		return report.ScanResult{}, fmt.Errorf("listing endpoints: %w", err)
+	}
 
-	if err := fs.Parse(args); err != nil {
-		return err
+	// Annotation references apply to both ConfigMaps and Secrets; look them up once.
+	// If korp may not list them, the ConfigMap and Secret detectors retry and report the blind spot.
+	referenced, err := k8sutil.AnnotationReferences(ctx, client, ns, annotationKeys)
+	if apierrors.IsForbidden(err) {
+		referenced = nil
+	} else if err != nil {
+		return report.ScanResult{}, fmt.Errorf("finding annotation references: %w", err)
 	}
 
-	// Determine target namespace
-	ns := *namespace
-	if *allNamespaces {
-		ns = metav1.NamespaceAll
-	} else if ns == "" {
-		// Default to scanning all namespaces
-		ns = metav1.NamespaceAll
-		fmt.Fprintf(os.Stderr, "Scanning all namespaces (use --namespace=<name> to scan specific namespace)\n")
+	for _, resourceType := range cliResourceTypes {
+		if err := detectType(ctx, client, dynamicClient, ns, resourceType, annotationKeys, referenced, &res); err != nil {
+			return report.ScanResult{}, err
+		}
 	}
+	return res, nil
+}
 
-	client, err := buildClient(*kubeconfig)
-	if err != nil {
-		return fmt.Errorf("building kube client: %w", err)
+// cliResourceTypes are the resource types the CLI detects, in output order
+var cliResourceTypes = []string{"configmaps", "secrets", "pvcs", "services", "endpoints"}
+
+// cliResourceTypeAliases maps short names accepted by `korp scan <type>` to resource types
+var cliResourceTypeAliases = map[string]string{
+	"configmap": "configmaps", "cm": "configmaps",
+	"secret": "secrets",
+	"pvc":    "pvcs", "persistentvolumeclaims": "pvcs",
+	"service": "services", "svc": "services",
+	"ep": "endpoints",
+}
+
+// cliResourceType resolves a resource type given on the command line, which may be a short name
+func cliResourceType(name string) string {
+	if alias, ok := cliResourceTypeAliases[name]; ok {
+		return alias
 	}
+	return name
+}
 
-	ctx := context.TODO()
+// detectType runs the detector for one resource type and stores its findings in res.
+// A type korp is forbidden to list is recorded in res.BlindSpots instead of failing the scan,
+// and transient API errors are retried with backoff before they are returned.
+func detectType(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, ns, resourceType string,
+	annotationKeys []string, referenced map[string]bool, res *report.ScanResult) error {
+	err := retry.OnError(retry.DefaultBackoff, scan.IsTransient, func() error {
+		err := runDetector(ctx, client, dynamicClient, ns, resourceType, annotationKeys, referenced, res)
+		return scan.DetectorError(resourceType, ns, err)
+	})
+	if scan.IsPermission(err) {
+		res.BlindSpots = scan.AddBlindSpot(res.BlindSpots, resourceType, ns, errors.Unwrap(err))
+		return nil
+	}
+	return err
+}
 
-	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("listing pods: %w", err)
+// runDetector runs the detector for one resource type and stores its findings in res.
+// Only the objects that detector needs are listed. referenced holds names found in
+// reference annotations; when nil it is looked up if the type needs it.
+func runDetector(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, ns, resourceType string,
+	annotationKeys []string, referenced map[string]bool, res *report.ScanResult) error {
+	if referenced == nil && (resourceType == "configmaps" || resourceType == "secrets") {
+		var err error
+		referenced, err = k8sutil.AnnotationReferences(ctx, client, ns, annotationKeys)
+		if err != nil {
+			return fmt.Errorf("finding annotation references: %w", err)
+		}
 	}
-	cms, err := client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("listing configmaps: %w", err)
+
+	switch resourceType {
+	case "configmaps":
+		orphans, err := k8sutil.OrphanConfigMaps(ctx, client, ns)
+		if err != nil {
+			return fmt.Errorf("finding orphan configmaps: %w", err)
+		}
+		res.OrphanConfigMapNames = objectNames(withoutObjects(orphans, referenced), ns)
+		res.OrphanConfigMaps = len(res.OrphanConfigMapNames)
+	case "secrets":
+		orphans, err := k8sutil.OrphanSecrets(ctx, client, ns)
+		if err != nil {
+			return fmt.Errorf("finding orphan secrets: %w", err)
+		}
+		synced, err := k8sutil.SecretsSyncedByOperators(ctx, dynamicClient, k8sutil.NewAPIVersions(client.Discovery()), ns)
+		if err != nil {
+			return fmt.Errorf("finding operator-synced secrets: %w", err)
+		}
+		clusterRefs, err := k8sutil.ClusterSecretReferences(ctx, client, dynamicClient)
+		if err != nil {
+			return fmt.Errorf("finding cluster secret references: %w", err)
+		}
+		var unreferenced []metav1.ObjectMeta
+		for _, obj := range withoutObjects(withoutObjects(orphans, synced), referenced) {
+			if !clusterRefs.Has(obj.Namespace, obj.Name) {
+				unreferenced = append(unreferenced, obj)
+			}
+		}
+		res.OrphanSecretNames = objectNames(unreferenced, ns)
+		res.OrphanSecrets = len(res.OrphanSecretNames)
+	case "pvcs":
+		orphans, err := k8sutil.OrphanPVCs(ctx, client, ns)
+		if err != nil {
+			return fmt.Errorf("finding orphan pvcs: %w", err)
+		}
+		res.OrphanPVCNames = objectNames(orphans, ns)
+		res.OrphanPVCs = len(orphans)
+	case "services":
+		orphans, err := k8sutil.ServicesWithoutEndpoints(ctx, client, ns)
+		if err != nil {
+			return fmt.Errorf("finding services without endpoints: %w", err)
+		}
+		res.ServicesNoEndpointsNames = objectNames(orphans, ns)
+		res.ServicesNoEndpoints = len(orphans)
+	case "endpoints":
+		orphans, err := k8sutil.OrphanEndpoints(ctx, client, ns)
+		if err != nil {
+			return fmt.Errorf("finding orphan endpoints: %w", err)
+		}
+		res.OrphanEndpointNames = objectNames(orphans, ns)
+		res.OrphanEndpoints = len(orphans)
+	default:
+		return fmt.Errorf("unknown resource type %q (use %s)", resourceType, strings.Join(cliResourceTypes, "|"))
 	}
-	secrets, err := client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("listing secrets: %w", err)
+	return nil
+}
+
+// referenceAnnotationKeys returns the default reference annotation keys followed by the extra keys
+// of a comma-separated --reference-annotations value
+func referenceAnnotationKeys(extra string) []string {
+	return append(append([]string{}, k8sutil.DefaultReferenceAnnotations...), splitList(extra)...)
+}
+
+// presetFilters resolves a comma-separated --preset value into filters
+func presetFilters(presets string) (korpv1alpha1.FilterSpec, error) {
+	if presets == "" {
+		return korpv1alpha1.FilterSpec{}, nil
 	}
-	svcs, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("listing services: %w", err)
+	return scan.ResolveFilters(korpv1alpha1.FilterSpec{}, strings.Split(presets, ","))
+}
+
+// applyFilters drops findings excluded by name pattern or in an excluded namespace,
+// and updates the orphan counts
+func applyFilters(res *report.ScanResult, filters korpv1alpha1.FilterSpec) {
+	res.OrphanConfigMapNames = filterNames(res.Namespace, res.OrphanConfigMapNames, filters)
+	res.OrphanSecretNames = filterNames(res.Namespace, res.OrphanSecretNames, filters)
+	res.OrphanPVCNames = filterNames(res.Namespace, res.OrphanPVCNames, filters)
+	res.ServicesNoEndpointsNames = filterNames(res.Namespace, res.ServicesNoEndpointsNames, filters)
+	res.OrphanEndpointNames = filterNames(res.Namespace, res.OrphanEndpointNames, filters)
+
+	res.OrphanConfigMaps = len(res.OrphanConfigMapNames)
+	res.OrphanSecrets = len(res.OrphanSecretNames)
+	res.OrphanPVCs = len(res.OrphanPVCNames)
+	res.ServicesNoEndpoints = len(res.ServicesNoEndpointsNames)
+	res.OrphanEndpoints = len(res.OrphanEndpointNames)
+}
+
+// filterNames returns the finding names of a scan of ns that pass the filters' name patterns and
+// are not in an excluded namespace
+func filterNames(ns string, names []string, filters korpv1alpha1.FilterSpec) []string {
+	var out []string
+	for _, name := range names {
+		namespace, bare := splitName(ns, name)
+		if contains(filters.ExcludeNamespaces, namespace) || len(scan.FilterNames([]string{bare}, filters)) == 0 {
+			continue
+		}
+		out = append(out, name)
 	}
-	pvcs, err := client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("listing pvcs: %w", err)
+	return out
+}
+
+// ErrOrphansFound is returned by --quiet scans that found orphaned resources
+var ErrOrphansFound = errors.New("orphaned resources found")
+
+// totalOrphans returns the number of findings across all resource types
+func totalOrphans(res report.ScanResult) int {
+	return res.OrphanConfigMaps + res.OrphanSecrets + res.OrphanPVCs + res.ServicesNoEndpoints + res.OrphanEndpoints
+}
+
+// Run performs the main application logic. Supports `scan`, which is also the
+// default when no command is given, `clean`, `report`, `version`, `snapshot`, `namespaces`, `notify`,
+// `serve`, `install-cron`, `uninstall`, `prune-events`, `generate` and `restore`.
+func Run(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "scan":
+			return runScan(args[1:])
+		case "clean":
+			return runClean(args[1:])
+		case "report":
+			return runReport(args[1:])
+		case "version":
+			return runVersion(args[1:])
+		case "help", "-h", "-help", "--help":
+			printUsage(os.Stdout)
+			return nil
+		case "snapshot":
+			return runSnapshot(args[1:])
+		case "namespaces":
+			return runNamespaces(args[1:])
+		case "notify":
+			return runNotify(args[1:])
+		case "serve":
+			return runServe(args[1:])
+		case "install-cron":
+			return runInstallCron(args[1:])
+		case "uninstall":
+			return runUninstall(args[1:])
+		case "prune-events":
+			return runPruneEvents(args[1:])
+		case "generate":
+			return runGenerate(args[1:])
+		case "restore":
+			return runRestore(args[1:])
+		}
 	}
-	endpoints, err := client.CoreV1().Endpoints(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return fmt.Errorf("listing endpoints: %w", err)
+	return runScan(args)
+}
+
+// commandName is how the CLI was invoked: "kubectl korp" when installed as the kubectl-korp plugin
+func commandName() string {
+	if strings.HasPrefix(filepath.Base(os.Args[0]), "kubectl-") {
+		return "kubectl korp"
 	}
+	return "korp"
+}
+
+// printUsage lists the commands
+func printUsage(w io.Writer) {
+	name := commandName()
+	fmt.Fprintf(w, `Find and clean up orphaned Kubernetes resources.
+
+Usage:
+  %[1]s [command] [flags]
 
-	res := scanResult{
-		Namespace:  ns,
-		Pods:       len(pods.Items),
-		ConfigMaps: len(cms.Items),
-		Secrets:    len(secrets.Items),
-		Services:   len(svcs.Items),
-		PVCs:       len(pvcs.Items),
-		Endpoints:  len(endpoints.Items),
+Commands:
+  scan [type]    Scan for orphaned resources (default command)
+  clean          Delete orphaned resources after confirmation
+  report         Print a saved scan result in another output format
+  version        Print the version
+  snapshot       Save the resources a scan needs for offline scans
+  namespaces     Summarize orphaned resources per namespace
+  notify         Send a test notification through a KorpScan's channels
+  serve          Serve scan results over HTTP
+  install-cron   Install a CronJob that scans on a schedule
+  uninstall      Remove korp and its resources from the cluster
+  prune-events   Delete Events older than a cutoff
+  generate       Print the operator install manifests for this version
+  restore        Re-create resources cleanup deleted from their backups
+
+Commands that talk to a cluster accept --kubeconfig and --context like kubectl.
+Use "%[1]s <command> -h" for the flags of a command.
+`, name)
+}
+
+// runScan implements `korp scan [type]`; with a type, only that detector runs
+func runScan(args []string) error {
+	var resourceType string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		resourceType = cliResourceType(args[0])
+		args = args[1:]
 	}
 
-	// Detect ownerless (no ownerReferences) items and collect names using helpers
-	orphanCMs, err := k8sutil.OrphanConfigMaps(ctx, client, ns)
-	if err != nil {
-		return fmt.Errorf("finding orphan configmaps: %w", err)
+	fs := flag.NewFlagSet(commandName()+" scan", flag.ContinueOnError)
+	namespace := fs.String("namespace", "", "namespace to scan")
+	fs.StringVar(namespace, "n", "", "shorthand for --namespace")
+	allNamespaces := fs.Bool("all-namespaces", false, "scan all namespaces")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	output := fs.String("output", "table", "output format: "+outputFormats)
+	fs.StringVar(output, "o", "table", "shorthand for --output")
+	referenceAnnotations := fs.String("reference-annotations", "", "comma-separated extra annotation keys whose values name ConfigMaps/Secrets in use")
+	storePath := fs.String("store", "", "path to a local state file that tracks findings across runs (e.g. ~/.korp/state.db)")
+	quiet := fs.Bool("quiet", false, "print nothing; exit with status 2 when orphaned resources are found")
+	summaryOnly := fs.Bool("summary-only", false, "print JSON with counts only, without finding names")
+	presets := fs.String("preset", "", "comma-separated filter presets for managed resources: "+strings.Join(scan.PresetNames(), ","))
+	uninstallAuditMode := fs.Bool("uninstall-audit", false, "report resources left behind by uninstalled Helm releases and OLM operators instead of orphans")
+	fromSnapshot := fs.String("from-snapshot", "", "scan a snapshot archive written by korp snapshot instead of a live cluster")
+	fromServer := fs.String("from-server", "", "get results from a korp serve --cache instance at this URL instead of scanning")
+	serverTokenFile := fs.String("server-token-file", "", "send the bearer token in this file to --from-server")
+	serverCAFile := fs.String("server-ca-file", "", "trust this PEM CA bundle for an HTTPS --from-server")
+	baselinePath := fs.String("baseline", "", "path to a previous korp scan --output json result to compare against")
+	failOnNew := fs.Bool("fail-on-new", false, "exit with status 2 only when findings missing from --baseline appear")
+
+	if err := fs.Parse(args); err != nil {
+		return err
 	}
-	orphanSecrets, err := k8sutil.OrphanSecrets(ctx, client, ns)
-	if err != nil {
-		return fmt.Errorf("finding orphan secrets: %w", err)
+	if resourceType == "" && fs.NArg() > 0 {
+		resourceType = cliResourceType(fs.Arg(0))
 	}
-	orphanPVCs, err := k8sutil.OrphanPVCs(ctx, client, ns)
-	if err != nil {
-		return fmt.Errorf("finding orphan pvcs: %w", err)
+	if *failOnNew && *baselinePath == "" {
+		return errors.New("--fail-on-new requires --baseline")
 	}
-	svcsNoEP, err := k8sutil.ServicesWithoutEndpoints(ctx, client, ns)
-	if err != nil {
-		return fmt.Errorf("finding services without endpoints: %w", err)
+	if *fromServer != "" && (*fromSnapshot != "" || *uninstallAuditMode) {
+		return errors.New("--from-server cannot be combined with --from-snapshot or --uninstall-audit")
 	}
-	orphanEPs, err := k8sutil.OrphanEndpoints(ctx, client, ns)
+
+	filters, err := presetFilters(*presets)
 	if err != nil {
-		return fmt.Errorf("finding orphan endpoints: %w", err)
+		return err
 	}
 
-	res.OrphanConfigMapNames = orphanCMs
-	res.OrphanSecretNames = orphanSecrets
-	res.OrphanPVCNames = orphanPVCs
-	res.ServicesNoEndpointsNames = svcsNoEP
-	res.OrphanEndpointNames = orphanEPs
+	var client kubernetes.Interface
+	var dynamicClient dynamic.Interface
 
-	res.OrphanConfigMaps = len(orphanCMs)
-	res.OrphanSecrets = len(orphanSecrets)
-	res.OrphanPVCs = len(orphanPVCs)
-	res.ServicesNoEndpoints = len(svcsNoEP)
-	res.OrphanEndpoints = len(orphanEPs)
-
-	switch *output {
-	case "json":
-		b, _ := json.MarshalIndent(res, "", "  ")
-		fmt.Println(string(b))
-	default:
-		// Print header
-		fmt.Println("================================================================================")
-		fmt.Println("KORP SCAN RESULTS")
-		fmt.Println("================================================================================")
-
-		// Show namespace info
-		nsDisplay := res.Namespace
-		if res.Namespace == "" || res.Namespace == metav1.NamespaceAll {
-			nsDisplay = "All Namespaces"
+	// Determine target namespace
+	ns := *namespace
+	if *fromSnapshot != "" {
+		snap, err := readSnapshot(*fromSnapshot)
+		if err != nil {
+			return err
+		}
+		client, dynamicClient = snap.clients()
+		// A snapshot only covers the namespace it was taken from
+		if ns == "" || *allNamespaces {
+			ns = snap.Namespace
 		}
-		fmt.Printf("\nTarget: %s\n\n", nsDisplay)
-
-		// Resource summary
-		fmt.Println("RESOURCE SUMMARY:")
-		fmt.Println("--------------------------------------------------------------------------------")
-		fmt.Printf("  Pods:         %d\n", res.Pods)
-		fmt.Printf("  ConfigMaps:   %d\n", res.ConfigMaps)
-		fmt.Printf("  Secrets:      %d\n", res.Secrets)
-		fmt.Printf("  Services:     %d\n", res.Services)
-		fmt.Printf("  PVCs:         %d\n", res.PVCs)
-		fmt.Printf("  Endpoints:    %d\n", res.Endpoints)
-
-		// Orphaned resources with inline details
-		fmt.Println("\nORPHANED RESOURCES:")
-		fmt.Println("================================================================================")
-
-		hasFindings := false
-
-		// Orphaned ConfigMaps
-		if res.OrphanConfigMaps > 0 {
-			hasFindings = true
-			fmt.Printf("\nConfigMaps: %d orphaned\n", res.OrphanConfigMaps)
-			for i, name := range res.OrphanConfigMapNames {
-				fmt.Printf("   %d. %s\n", i+1, name)
+	} else {
+		if *allNamespaces {
+			ns = metav1.NamespaceAll
+		} else if ns == "" {
+			// Default to scanning all namespaces
+			ns = metav1.NamespaceAll
+			if !*quiet {
+				fmt.Fprintf(os.Stderr, "Scanning all namespaces (use --namespace=<name> to scan specific namespace)\n")
 			}
-		} else {
-			fmt.Printf("\nConfigMaps: No orphaned resources\n")
 		}
 
-		// Orphaned Secrets
-		if res.OrphanSecrets > 0 {
-			hasFindings = true
-			fmt.Printf("\nSecrets: %d orphaned\n", res.OrphanSecrets)
-			for i, name := range res.OrphanSecretNames {
-				fmt.Printf("   %d. %s\n", i+1, name)
+		// The server does the scanning when --from-server is set
+		if *fromServer == "" {
+			clientset, dyn, err := buildClient(kube)
+			if err != nil {
+				return fmt.Errorf("building kube client: %w", err)
 			}
-		} else {
-			fmt.Printf("\nSecrets: No orphaned resources\n")
+			client, dynamicClient = clientset, dyn
 		}
+	}
 
-		// Orphaned PVCs
-		if res.OrphanPVCs > 0 {
-			hasFindings = true
-			fmt.Printf("\nPVCs: %d orphaned\n", res.OrphanPVCs)
-			for i, name := range res.OrphanPVCNames {
-				fmt.Printf("   %d. %s\n", i+1, name)
+	if *uninstallAuditMode {
+		return uninstallAudit(context.TODO(), client, dynamicClient, ns, *output)
+	}
+
+	annotationKeys := referenceAnnotationKeys(*referenceAnnotations)
+
+	var res report.ScanResult
+	if *fromServer != "" {
+		var token string
+		if *serverTokenFile != "" {
+			if token, err = readToken(*serverTokenFile); err != nil {
+				return err
 			}
-		} else {
-			fmt.Printf("\nPVCs: No orphaned resources\n")
 		}
+		res, err = fetchScan(context.TODO(), *fromServer, token, *serverCAFile, ns, resourceType)
+	} else if resourceType != "" {
+		res = report.ScanResult{SchemaVersion: report.SchemaVersion, Namespace: ns}
+		err = detectType(context.TODO(), client, dynamicClient, ns, resourceType, annotationKeys, nil, &res)
+	} else {
+		res, err = detect(context.TODO(), client, dynamicClient, ns, annotationKeys)
+	}
+	if err != nil {
+		return err
+	}
+	applyFilters(&res, filters)
 
-		// Services without endpoints
-		if res.ServicesNoEndpoints > 0 {
-			hasFindings = true
-			fmt.Printf("\nServices: %d without endpoints\n", res.ServicesNoEndpoints)
-			for i, name := range res.ServicesNoEndpointsNames {
-				fmt.Printf("   %d. %s\n", i+1, name)
+	// Track findings across invocations when a store is configured
+	var store *findingStore
+	now := time.Now()
+	if *storePath != "" {
+		store, err = openStore(*storePath)
+		if err != nil {
+			return err
+		}
+		current := map[string][]string{
+			"ConfigMap":             res.OrphanConfigMapNames,
+			"Secret":                res.OrphanSecretNames,
+			"PersistentVolumeClaim": res.OrphanPVCNames,
+			"Service":               res.ServicesNoEndpointsNames,
+			"Endpoints":             res.OrphanEndpointNames,
+		}
+		// A single-type scan says nothing about the other types
+		if resourceType != "" {
+			for kind := range current {
+				if k8sutil.SpecResourceTypes[kind] != resourceType {
+					delete(current, kind)
+				}
 			}
-		} else {
-			fmt.Printf("\nServices: All have endpoints\n")
 		}
+		diff := store.record(ns, current, now)
+		if err := store.save(); err != nil {
+			return err
+		}
+		res.NewFindings = diff.New
+		res.ResolvedFindings = diff.Resolved
+	}
 
-		// Orphan Endpoints (no matching Service)
-		if res.OrphanEndpoints > 0 {
-			hasFindings = true
-			fmt.Printf("\nEndpoints: %d orphaned (no matching Service)\n", res.OrphanEndpoints)
-			for i, name := range res.OrphanEndpointNames {
-				fmt.Printf("   %d. %s\n", i+1, name)
-			}
-		} else {
-			fmt.Printf("\nEndpoints: All have matching Services\n")
+	// Compare against a baseline so CI can accept known orphans and reject new ones
+	if *baselinePath != "" {
+		baseline, err := readBaseline(*baselinePath)
+		if err != nil {
+			return err
 		}
+		res.NotInBaseline = notInBaseline(res, baseline)
+	}
 
-		// Footer
-		fmt.Println("\n================================================================================")
-		if hasFindings {
-			fmt.Printf("Found issues in %d resource type(s)\n", countIssueTypes(res))
-		} else {
-			fmt.Println("No orphaned resources found - cluster is clean!")
+	// failure is the result returned after output; --fail-on-new fails only on findings missing from the baseline
+	var failure error
+	if *failOnNew && len(res.NotInBaseline) > 0 {
+		failure = ErrNewOrphansFound
+	}
+
+	if *quiet {
+		if *failOnNew {
+			return failure
+		}
+		if totalOrphans(res) > 0 {
+			return ErrOrphansFound
 		}
-		fmt.Println("================================================================================")
+		return nil
 	}
 
-	return nil
+	if *summaryOnly {
+		res.OrphanConfigMapNames = nil
+		res.OrphanSecretNames = nil
+		res.OrphanPVCNames = nil
+		res.ServicesNoEndpointsNames = nil
+		res.OrphanEndpointNames = nil
+		res.NewFindings = nil
+		res.ResolvedFindings = nil
+		res.NotInBaseline = nil
+		*output = "json"
+	}
+
+	opts := printOptions{
+		resourceType: resourceType,
+		store:        store,
+		now:          now,
+		showChanges:  store != nil,
+		showBaseline: *baselinePath != "",
+	}
+	if err := printResult(os.Stdout, res, *output, opts); err != nil {
+		return err
+	}
+	return failure
 }
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+// ErrNewOrphansFound is returned by --fail-on-new scans that found orphans missing from the baseline
+var ErrNewOrphansFound = errors.New("orphaned resources not in baseline found")
+
+// readBaseline loads a previous `korp scan --output json` result
+func readBaseline(path string) (report.ScanResult, error) {
+	var baseline report.ScanResult
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return baseline, fmt.Errorf("reading baseline %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return baseline, fmt.Errorf("parsing baseline %s: %w", path, err)
+	}
+	if totalOrphans(baseline) > 0 && len(findingList(baseline, nil)) == 0 {
+		return baseline, fmt.Errorf("baseline %s has no finding names; write it without --summary-only", path)
+	}
+	return baseline, nil
+}
+
+// notInBaseline returns "Type/name" entries for findings in res that the baseline does not have
+func notInBaseline(res, baseline report.ScanResult) []string {
+	known := make(map[string]bool)
+	for _, f := range findingList(baseline, nil) {
+		known[f.ResourceType+"/"+f.Name] = true
+	}
+
+	var out []string
+	for _, f := range findingList(res, nil) {
+		if key := f.ResourceType + "/" + f.Name; !known[key] {
+			out = append(out, key)
+		}
+	}
+	return out
+}
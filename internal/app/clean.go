@@ -0,0 +1,334 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/cleanup"
+	"github.com/kamilbabayev/korp/pkg/policy"
+	"github.com/kamilbabayev/korp/pkg/scan"
+)
+
+// runClean implements `korp clean -n <ns> [--dry-run] [--min-age 7] [--resource-types cm,secrets] [--yes]`.
+// Findings are planned with the operator's cleanup rules, printed, confirmed and then remediated.
+func runClean(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" clean", flag.ContinueOnError)
+	namespace := fs.String("namespace", "", "namespace to clean")
+	fs.StringVar(namespace, "n", "", "shorthand for --namespace")
+	allNamespaces := fs.Bool("all-namespaces", false, "clean all namespaces")
+	fs.BoolVar(allNamespaces, "A", false, "shorthand for --all-namespaces")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	dryRun := fs.Bool("dry-run", false, "only print what would be deleted")
+	minAge := fs.Int("min-age", 7, "minimum age in days: since first seen with --store, otherwise since creation; 0 cleans regardless of age")
+	resourceTypes := fs.String("resource-types", "", "comma-separated resource types to clean ("+strings.Join(cliResourceTypes, ",")+"; short names cm, pvc, svc, ep); all if empty")
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	fs.BoolVar(yes, "y", false, "shorthand for --yes")
+	confirmMode := fs.String("confirm", "batch", "ask once for all resources (batch) or for each resource (each)")
+	preservationLabels := fs.String("preservation-labels", "", "comma-separated label keys that keep a resource from being deleted")
+	referenceAnnotations := fs.String("reference-annotations", "", "comma-separated extra annotation keys whose values name ConfigMaps/Secrets in use")
+	presets := fs.String("preset", "", "comma-separated filter presets for managed resources: "+strings.Join(scan.PresetNames(), ","))
+	storePath := fs.String("store", "", "state file written by scan --store; ages count from when findings were first seen")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	// Deleting across the whole cluster must be asked for explicitly
+	if *namespace == "" && !*allNamespaces {
+		return errors.New("--namespace or --all-namespaces is required")
+	}
+	if *confirmMode != "batch" && *confirmMode != "each" {
+		return fmt.Errorf("unknown --confirm mode %q (use batch|each)", *confirmMode)
+	}
+	if *minAge < 0 {
+		return errors.New("--min-age must not be negative")
+	}
+
+	filters, err := presetFilters(*presets)
+	if err != nil {
+		return err
+	}
+	var types []string
+	if *resourceTypes != "" {
+		for _, t := range strings.Split(*resourceTypes, ",") {
+			rt := cliResourceType(strings.TrimSpace(t))
+			if !contains(cliResourceTypes, rt) {
+				return fmt.Errorf("unknown resource type %q (use %s)", t, strings.Join(cliResourceTypes, "|"))
+			}
+			types = append(types, rt)
+		}
+	}
+	var store *findingStore
+	if *storePath != "" {
+		if store, err = openStore(*storePath); err != nil {
+			return err
+		}
+	}
+	annotationKeys := referenceAnnotationKeys(*referenceAnnotations)
+
+	ctx := context.TODO()
+	client, dynamicClient, err := buildClient(kube)
+	if err != nil {
+		return fmt.Errorf("building kube client: %w", err)
+	}
+	ns := *namespace
+	if *allNamespaces {
+		ns = metav1.NamespaceAll
+	}
+
+	// Detect on one read of the cluster, namespace by namespace, like korp namespaces
+	snap, err := takeSnapshot(ctx, client, dynamicClient, ns)
+	if err != nil {
+		return err
+	}
+	snapClient, snapDynamic := snap.clients()
+	namespaces := []string{ns}
+	if ns == metav1.NamespaceAll {
+		namespaces = snap.namespaces()
+	}
+	now := time.Now()
+	var findings []korpv1alpha1.Finding
+	for _, n := range namespaces {
+		res, err := detect(ctx, snapClient, snapDynamic, n, annotationKeys)
+		if err != nil {
+			return fmt.Errorf("scanning namespace %s: %w", n, err)
+		}
+		applyFilters(&res, filters)
+		for _, spot := range res.BlindSpots {
+			fmt.Fprintf(os.Stderr, "warning: %s not scanned in %s: %s\n", spot.ResourceType, n, spot.Error)
+		}
+		for _, f := range findingList(res, nil) {
+			findings = append(findings, cleanFinding(f, snap, store, now, *minAge))
+		}
+	}
+
+	// KorpPolicies in the cluster are honored like in the operator
+	policies, err := policy.Load(ctx, dynamicClient, &korpv1alpha1.KorpScan{})
+	if err != nil {
+		return err
+	}
+	spec := &korpv1alpha1.CleanupSpec{
+		Enabled:            true,
+		ResourceTypes:      types,
+		MinAgeDays:         *minAge,
+		PreservationLabels: splitList(*preservationLabels),
+	}
+	cleaner := cleanup.NewCleaner(client, dynamicClient, logr.Discard())
+
+	// Plan with a dry run, which applies every rule without changing anything
+	dryRunPlan := true
+	spec.DryRun = &dryRunPlan
+	plan, err := cleaner.Clean(ctx, findings, spec, policies)
+	if err != nil {
+		return err
+	}
+	printCleanPlan(os.Stdout, plan)
+	if *dryRun || len(plan.WouldRemediate) == 0 {
+		return nil
+	}
+
+	// Only what was planned and confirmed is remediated
+	approved, err := confirmRemediations(plan.WouldRemediate, *yes, *confirmMode == "each")
+	if err != nil {
+		return err
+	}
+	if len(approved) == 0 {
+		fmt.Println("Nothing deleted.")
+		return nil
+	}
+	var selected []korpv1alpha1.Finding
+	for _, f := range findings {
+		if approved[plannedKey(f.ResourceType, f.Namespace, f.Name)] {
+			selected = append(selected, f)
+		}
+	}
+
+	apply := false
+	spec.DryRun = &apply
+	result, err := cleaner.Clean(ctx, selected, spec, policies)
+	if err != nil {
+		return err
+	}
+	for _, d := range result.DeletedResources {
+		fmt.Printf("%s %s %s\n", pastTense(d.Action), strings.ToLower(d.ResourceType), qualifiedName(d.Namespace, d.Name))
+	}
+	for _, f := range result.FailedDeletions {
+		fmt.Fprintf(os.Stderr, "failed %s %s: %s\n", strings.ToLower(f.ResourceType), qualifiedName(f.Namespace, f.Name), f.Error)
+	}
+	if len(result.FailedDeletions) > 0 {
+		return fmt.Errorf("%d of %d resources could not be cleaned", len(result.FailedDeletions), len(selected))
+	}
+	return nil
+}
+
+// cleanFinding converts a CLI finding for the cleaner. Its age counts from when the store first saw it,
+// or else from the resource's creation.
+func cleanFinding(f cliFinding, snap *clusterSnapshot, store *findingStore, now time.Time, minAgeDays int) korpv1alpha1.Finding {
+	detectedAt := now
+	if created := snap.createdAt(f.ResourceType, f.Namespace, []string{f.Name}); len(created) > 0 {
+		detectedAt = created[0]
+	}
+	if store != nil {
+		if firstSeen, ok := store.firstSeen(f.ResourceType, f.Namespace, f.Name); ok {
+			detectedAt = firstSeen
+		}
+	}
+	if minAgeDays == 0 {
+		// The cleaner reads a minimum age of 0 as its 7-day default, so the finding is made old enough
+		detectedAt = time.Time{}
+	}
+
+	return korpv1alpha1.Finding{
+		ResourceType:    f.ResourceType,
+		Namespace:       f.Namespace,
+		Name:            f.Name,
+		Reason:          f.Reason,
+		DetectedAt:      metav1.Time{Time: detectedAt},
+		SuggestedAction: scan.SuggestedAction(f.ResourceType, f.Reason),
+		Severity:        scan.Severity(f.ResourceType, f.Reason),
+		Confidence:      scan.Confidence(f.ResourceType, f.Reason),
+	}
+}
+
+// printCleanPlan lists what a cleanup would do and why other findings are left alone
+func printCleanPlan(w io.Writer, plan *cleanup.CleanupResult) {
+	if len(plan.WouldRemediate) == 0 {
+		fmt.Fprintln(w, "No resources to clean.")
+	} else {
+		tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(tw, "ACTION\tTYPE\tNAMESPACE\tNAME")
+		for _, p := range plan.WouldRemediate {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", p.Action, p.ResourceType, p.Namespace, p.Name)
+		}
+		tw.Flush()
+	}
+
+	s := plan.Summary
+	var skipped []string
+	for _, c := range []struct {
+		count  int
+		reason string
+	}{
+		{s.TotalSkippedAge, "too young"},
+		{s.TotalSkippedPreserved, "preservation label"},
+		{s.TotalSkippedPolicy, "KorpPolicy"},
+	} {
+		if c.count > 0 {
+			skipped = append(skipped, fmt.Sprintf("%d %s", c.count, c.reason))
+		}
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(w, "\nSkipped: %s\n", strings.Join(skipped, ", "))
+	}
+	for _, f := range plan.FailedDeletions {
+		fmt.Fprintf(w, "Not planned: %s %s: %s\n", f.ResourceType, qualifiedName(f.Namespace, f.Name), f.Error)
+	}
+}
+
+// confirmRemediations asks on the terminal which planned remediations to carry out, once for all of
+// them or once for each, and returns the approved ones keyed by plannedKey
+func confirmRemediations(plan []korpv1alpha1.PlannedRemediation, yes, each bool) (map[string]bool, error) {
+	approved := make(map[string]bool, len(plan))
+	if yes {
+		for _, p := range plan {
+			approved[plannedKey(p.ResourceType, p.Namespace, p.Name)] = true
+		}
+		return approved, nil
+	}
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return nil, errors.New("standard input is not a terminal; pass --yes to clean without confirmation")
+	}
+
+	in := bufio.NewReader(os.Stdin)
+	if !each {
+		ok, err := askYesNo(in, os.Stdout, fmt.Sprintf("\nClean %d resources?", len(plan)))
+		if err != nil || !ok {
+			return approved, err
+		}
+		for _, p := range plan {
+			approved[plannedKey(p.ResourceType, p.Namespace, p.Name)] = true
+		}
+		return approved, nil
+	}
+
+	fmt.Println()
+	for _, p := range plan {
+		ok, err := askYesNo(in, os.Stdout, fmt.Sprintf("%s %s %s?", p.Action, p.ResourceType, qualifiedName(p.Namespace, p.Name)))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			approved[plannedKey(p.ResourceType, p.Namespace, p.Name)] = true
+		}
+	}
+	return approved, nil
+}
+
+// askYesNo prints a [y/N] prompt and reports whether the answer was yes
+func askYesNo(in *bufio.Reader, w io.Writer, prompt string) (bool, error) {
+	fmt.Fprintf(w, "%s [y/N]: ", prompt)
+	answer, err := in.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// plannedKey identifies a resource in a cleanup plan
+func plannedKey(resourceType, namespace, name string) string {
+	return resourceType + "/" + namespace + "/" + name
+}
+
+// qualifiedName renders namespace/name, or name for cluster-scoped resources
+func qualifiedName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// pastTense describes a remediation that was carried out
+func pastTense(action string) string {
+	switch action {
+	case korpv1alpha1.ActionScaleToZero:
+		return "scaled to zero"
+	case korpv1alpha1.ActionPatch:
+		return "patched"
+	case korpv1alpha1.ActionLabel:
+		return "labeled"
+	}
+	return "deleted"
+}
+
+// splitList splits a comma-separated flag value, dropping empty items
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// contains reports whether list holds s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"path"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kamilbabayev/korp/config"
+)
+
+// operatorImage is the operator image published for each release
+const operatorImage = "kamilbabayev/korp"
+
+// generateOptions are the feature flags of `korp generate operator`
+type generateOptions struct {
+	namespace  string
+	image      string
+	readOnly   bool
+	metricsTLS bool
+}
+
+// readOnlyVerbs are the verbs a --read-only operator keeps on scanned resources
+var readOnlyVerbs = []string{"get", "list", "watch"}
+
+// operatorWriteGroups are API groups the operator writes to for its own bookkeeping, even when read-only
+var operatorWriteGroups = []string{"korp.io", "coordination.k8s.io", "authorization.k8s.io", "authentication.k8s.io"}
+
+// metricsAuthResources are only needed when the metrics endpoint authenticates scrapes (--metrics-auth)
+var metricsAuthResources = []string{"tokenreviews", "subjectaccessreviews"}
+
+// runGenerate implements `korp generate operator`
+func runGenerate(args []string) error {
+	if len(args) == 0 || args[0] != "operator" {
+		return errors.New("usage: " + commandName() + " generate operator [flags]")
+	}
+
+	fs := flag.NewFlagSet(commandName()+" generate operator", flag.ContinueOnError)
+	namespace := fs.String("namespace", "korp", "namespace to install the operator into")
+	fs.StringVar(namespace, "n", "korp", "shorthand for --namespace")
+	image := fs.String("image", defaultOperatorImage(), "operator image the Deployment runs")
+	readOnly := fs.Bool("read-only", false, "grant only read access to scanned resources; cleanup stays disabled")
+	metricsTLS := fs.Bool("metrics-tls", false, "serve metrics over HTTPS and require an authorized bearer token to scrape them")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	opts := generateOptions{namespace: *namespace, image: *image, readOnly: *readOnly, metricsTLS: *metricsTLS}
+	docs, err := operatorManifests(opts)
+	if err != nil {
+		return err
+	}
+	for _, data := range docs {
+		fmt.Printf("---\n%s", data)
+	}
+	return nil
+}
+
+// defaultOperatorImage is the operator image matching this CLI's version
+func defaultOperatorImage() string {
+	if version == "dev" {
+		return operatorImage + ":latest"
+	}
+	return operatorImage + ":" + version
+}
+
+// operatorManifests renders the embedded CRDs, RBAC and Deployment with the selected options, in apply order
+func operatorManifests(opts generateOptions) ([][]byte, error) {
+	var docs [][]byte
+
+	crds, err := fs.Glob(config.Manifests, "crd/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range crds {
+		data, err := config.Manifests.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		// controller-gen starts each CRD with a document separator of its own
+		docs = append(docs, bytes.TrimPrefix(data, []byte("---\n")))
+	}
+
+	namespace := corev1.Namespace{}
+	namespace.APIVersion, namespace.Kind = "v1", "Namespace"
+	namespace.Name = opts.namespace
+
+	var serviceAccount corev1.ServiceAccount
+	var role, metricsReader rbacv1.ClusterRole
+	var binding rbacv1.ClusterRoleBinding
+	var deployment appsv1.Deployment
+	for name, obj := range map[string]interface{}{
+		"rbac/service_account.yaml":     &serviceAccount,
+		"rbac/role.yaml":                &role,
+		"rbac/role_binding.yaml":        &binding,
+		"rbac/metrics_reader_role.yaml": &metricsReader,
+		"operator/deployment.yaml":      &deployment,
+	} {
+		if err := readManifest(name, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	serviceAccount.Namespace = opts.namespace
+	for i := range binding.Subjects {
+		binding.Subjects[i].Namespace = opts.namespace
+	}
+	role.Rules = operatorRules(role.Rules, opts)
+	deployment.Namespace = opts.namespace
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	container.Image = opts.image
+	if opts.metricsTLS {
+		container.Args = append(container.Args, "--metrics-secure", "--metrics-auth")
+	}
+
+	objects := []interface{}{&namespace, &serviceAccount, &role, &binding}
+	if opts.metricsTLS {
+		objects = append(objects, &metricsReader)
+	}
+	objects = append(objects, &deployment)
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, data)
+	}
+	return docs, nil
+}
+
+// readManifest decodes one embedded manifest into obj
+func readManifest(name string, obj interface{}) error {
+	data, err := config.Manifests.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("decoding %s: %w", path.Base(name), err)
+	}
+	return nil
+}
+
+// operatorRules narrows the operator ClusterRole to the selected features. --read-only keeps only read
+// verbs on scanned resources, and the metrics authentication rules are dropped without --metrics-tls.
+func operatorRules(rules []rbacv1.PolicyRule, opts generateOptions) []rbacv1.PolicyRule {
+	var out []rbacv1.PolicyRule
+	for _, rule := range rules {
+		if !opts.metricsTLS && overlaps(rule.Resources, metricsAuthResources) {
+			continue
+		}
+		if opts.readOnly && !overlaps(rule.APIGroups, operatorWriteGroups) && !contains(rule.Resources, "events") {
+			var verbs []string
+			for _, verb := range rule.Verbs {
+				if contains(readOnlyVerbs, verb) {
+					verbs = append(verbs, verb)
+				}
+			}
+			if len(verbs) == 0 {
+				continue
+			}
+			rule.Verbs = verbs
+		}
+		out = append(out, rule)
+	}
+	return out
+}
+
+// overlaps reports whether a and b share an element
+func overlaps(a, b []string) bool {
+	for _, s := range a {
+		if contains(b, s) {
+			return true
+		}
+	}
+	return false
+}
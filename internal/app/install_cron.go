@@ -0,0 +1,213 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// cronFieldManager owns the objects written by `korp install-cron`
+const cronFieldManager = "korp-install-cron"
+
+// cronReadRules are the read-only permissions the CLI needs to scan a cluster
+var cronReadRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "configmaps", "secrets", "services", "persistentvolumeclaims", "endpoints",
+			"namespaces", "serviceaccounts", "persistentvolumes"},
+		Verbs: []string{"get", "list"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets", "daemonsets", "replicasets"},
+		Verbs:     []string{"get", "list"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     []string{"get", "list"},
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses"},
+		Verbs:     []string{"get", "list"},
+	},
+	{
+		APIGroups: []string{"discovery.k8s.io"},
+		Resources: []string{"endpointslices"},
+		Verbs:     []string{"list"},
+	},
+	{
+		// Cluster-scoped sources of Secret references
+		APIGroups: []string{"admissionregistration.k8s.io"},
+		Resources: []string{"mutatingwebhookconfigurations", "validatingwebhookconfigurations"},
+		Verbs:     []string{"list"},
+	},
+	{
+		APIGroups: []string{"apiregistration.k8s.io"},
+		Resources: []string{"apiservices"},
+		Verbs:     []string{"list"},
+	},
+	{
+		APIGroups: []string{"apiextensions.k8s.io"},
+		Resources: []string{"customresourcedefinitions"},
+		Verbs:     []string{"list"},
+	},
+}
+
+// runInstallCron implements `korp install-cron --schedule "0 6 * * 1" --namespace korp [-- scan flags]`
+func runInstallCron(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" install-cron", flag.ContinueOnError)
+	schedule := fs.String("schedule", "", "cron schedule for the scans, e.g. \"0 6 * * 1\"")
+	namespace := fs.String("namespace", "korp", "namespace to install the CronJob into")
+	fs.StringVar(namespace, "n", "korp", "shorthand for --namespace")
+	name := fs.String("name", "korp-cli", "name of the CronJob, ServiceAccount and RBAC objects")
+	image := fs.String("image", "kamilbabayev/korp-cli:latest", "CLI image the CronJob runs")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	dryRun := fs.Bool("dry-run", false, "print the manifests instead of applying them")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: korp install-cron --schedule SCHEDULE [flags] [-- korp scan flags]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *schedule == "" {
+		return errors.New("--schedule is required")
+	}
+
+	// Everything after the flags (or after --) is passed to the scan the CronJob runs
+	scanArgs := fs.Args()
+	if len(scanArgs) == 0 {
+		scanArgs = []string{"--all-namespaces"}
+	}
+
+	objects := cronManifests(*name, *namespace, *schedule, *image, scanArgs)
+	if *dryRun {
+		for _, obj := range objects {
+			data, err := yaml.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("---\n%s", data)
+		}
+		return nil
+	}
+
+	client, _, err := buildClient(kube)
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+	for _, obj := range objects {
+		if err := applyCronObject(ctx, client, obj); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Installed CronJob %s/%s (schedule %q) running: korp %s\n", *namespace, *name, *schedule, strings.Join(scanArgs, " "))
+	return nil
+}
+
+// cronManifests renders the Namespace, ServiceAccount, read-only RBAC and CronJob that run the CLI on a schedule
+func cronManifests(name, namespace, schedule, image string, scanArgs []string) []runtime.Object {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "korp",
+		"app.kubernetes.io/component":  "cli",
+		"app.kubernetes.io/managed-by": cronFieldManager,
+	}
+	meta := metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}
+	clusterMeta := metav1.ObjectMeta{Name: name, Labels: labels}
+	noEscalation := false
+
+	return []runtime.Object{
+		&corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+		},
+		&corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+			ObjectMeta: meta,
+		},
+		&rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+			ObjectMeta: clusterMeta,
+			Rules:      cronReadRules,
+		},
+		&rbacv1.ClusterRoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"},
+			ObjectMeta: clusterMeta,
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: name},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: namespace}},
+		},
+		&batchv1.CronJob{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+			ObjectMeta: meta,
+			Spec: batchv1.CronJobSpec{
+				Schedule:          schedule,
+				ConcurrencyPolicy: batchv1.ForbidConcurrent,
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: labels},
+							Spec: corev1.PodSpec{
+								ServiceAccountName: name,
+								RestartPolicy:      corev1.RestartPolicyOnFailure,
+								Containers: []corev1.Container{{
+									Name:  "korp-cli",
+									Image: image,
+									Args:  scanArgs,
+									SecurityContext: &corev1.SecurityContext{
+										AllowPrivilegeEscalation: &noEscalation,
+										Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+									},
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// applyCronObject server-side applies one rendered object
+func applyCronObject(ctx context.Context, client kubernetes.Interface, obj runtime.Object) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	force := true
+	opts := metav1.PatchOptions{FieldManager: cronFieldManager, Force: &force}
+
+	switch o := obj.(type) {
+	case *corev1.Namespace:
+		_, err = client.CoreV1().Namespaces().Patch(ctx, o.Name, types.ApplyPatchType, data, opts)
+	case *corev1.ServiceAccount:
+		_, err = client.CoreV1().ServiceAccounts(o.Namespace).Patch(ctx, o.Name, types.ApplyPatchType, data, opts)
+	case *rbacv1.ClusterRole:
+		_, err = client.RbacV1().ClusterRoles().Patch(ctx, o.Name, types.ApplyPatchType, data, opts)
+	case *rbacv1.ClusterRoleBinding:
+		_, err = client.RbacV1().ClusterRoleBindings().Patch(ctx, o.Name, types.ApplyPatchType, data, opts)
+	case *batchv1.CronJob:
+		_, err = client.BatchV1().CronJobs(o.Namespace).Patch(ctx, o.Name, types.ApplyPatchType, data, opts)
+	default:
+		return fmt.Errorf("unsupported object %T", obj)
+	}
+	if err != nil {
+		return fmt.Errorf("applying %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+	}
+	return nil
+}
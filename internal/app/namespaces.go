@@ -0,0 +1,242 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kamilbabayev/korp/pkg/scan"
+)
+
+// namespaceRow is the per-namespace aggregate printed by `korp namespaces`
+type namespaceRow struct {
+	Namespace           string  `json:"namespace"`
+	OrphanConfigMaps    int     `json:"orphan_configmaps"`
+	OrphanSecrets       int     `json:"orphan_secrets"`
+	OrphanPVCs          int     `json:"orphan_pvcs"`
+	ServicesNoEndpoints int     `json:"services_no_endpoints"`
+	OrphanEndpoints     int     `json:"orphan_endpoints"`
+	TotalOrphans        int     `json:"total_orphans"`
+	SizeBytes           int64   `json:"size_bytes"`
+	OldestOrphanSeconds float64 `json:"oldest_orphan_seconds,omitempty"`
+}
+
+// namespaces returns the namespaces that have objects in the snapshot
+func (s *clusterSnapshot) namespaces() []string {
+	seen := make(map[string]bool)
+	add := func(meta metav1.ObjectMeta) {
+		seen[meta.Namespace] = true
+	}
+	for _, o := range s.Pods {
+		add(o.ObjectMeta)
+	}
+	for _, o := range s.ConfigMaps {
+		add(o.ObjectMeta)
+	}
+	for _, o := range s.Secrets {
+		add(o.ObjectMeta)
+	}
+	for _, o := range s.Services {
+		add(o.ObjectMeta)
+	}
+	for _, o := range s.PVCs {
+		add(o.ObjectMeta)
+	}
+	for _, o := range s.Endpoints {
+		add(o.ObjectMeta)
+	}
+
+	var out []string
+	for ns := range seen {
+		out = append(out, ns)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// createdAt returns creation timestamps of the named objects of a kind in a namespace
+func (s *clusterSnapshot) createdAt(resourceType, ns string, names []string) []time.Time {
+	want := make(map[string]bool, len(names))
+	for _, name := range names {
+		want[name] = true
+	}
+
+	var out []time.Time
+	add := func(meta metav1.ObjectMeta) {
+		if meta.Namespace == ns && want[meta.Name] && !meta.CreationTimestamp.IsZero() {
+			out = append(out, meta.CreationTimestamp.Time)
+		}
+	}
+	switch resourceType {
+	case "ConfigMap":
+		for _, o := range s.ConfigMaps {
+			add(o.ObjectMeta)
+		}
+	case "Secret":
+		for _, o := range s.Secrets {
+			add(o.ObjectMeta)
+		}
+	case "PersistentVolumeClaim":
+		for _, o := range s.PVCs {
+			add(o.ObjectMeta)
+		}
+	case "Service":
+		for _, o := range s.Services {
+			add(o.ObjectMeta)
+		}
+	case "Endpoints":
+		for _, o := range s.Endpoints {
+			add(o.ObjectMeta)
+		}
+	}
+	return out
+}
+
+// pvcStorage returns the total requested storage of the named PVCs in a namespace
+func (s *clusterSnapshot) pvcStorage(ns string, names []string) int64 {
+	want := make(map[string]bool, len(names))
+	for _, name := range names {
+		want[name] = true
+	}
+
+	var total int64
+	for _, pvc := range s.PVCs {
+		if pvc.Namespace != ns || !want[pvc.Name] {
+			continue
+		}
+		if q, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+			total += q.Value()
+		}
+	}
+	return total
+}
+
+// sortNamespaceRows orders rows by the given key; counts, size and age sort descending
+func sortNamespaceRows(rows []namespaceRow, key string) error {
+	var less func(a, b namespaceRow) bool
+	switch key {
+	case "name":
+		less = func(a, b namespaceRow) bool { return a.Namespace < b.Namespace }
+	case "orphans":
+		less = func(a, b namespaceRow) bool { return a.TotalOrphans > b.TotalOrphans }
+	case "size":
+		less = func(a, b namespaceRow) bool { return a.SizeBytes > b.SizeBytes }
+	case "age":
+		less = func(a, b namespaceRow) bool { return a.OldestOrphanSeconds > b.OldestOrphanSeconds }
+	default:
+		return fmt.Errorf("unknown sort key %q (use name|orphans|size|age)", key)
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return less(rows[i], rows[j]) })
+	return nil
+}
+
+// runNamespaces implements `korp namespaces`
+func runNamespaces(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" namespaces", flag.ContinueOnError)
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	output := fs.String("output", "table", "output format: table|json")
+	sortBy := fs.String("sort-by", "orphans", "sort rows by: name|orphans|size|age")
+	referenceAnnotations := fs.String("reference-annotations", "", "comma-separated extra annotation keys whose values name ConfigMaps/Secrets in use")
+	presets := fs.String("preset", "", "comma-separated filter presets for managed resources: "+strings.Join(scan.PresetNames(), ","))
+	fromSnapshot := fs.String("from-snapshot", "", "aggregate a snapshot archive written by korp snapshot instead of a live cluster")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	filters, err := presetFilters(*presets)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+
+	// Read the cluster once and evaluate each namespace in memory
+	var snap *clusterSnapshot
+	if *fromSnapshot != "" {
+		snap, err = readSnapshot(*fromSnapshot)
+	} else {
+		clientset, dynamicClient, buildErr := buildClient(kube)
+		if buildErr != nil {
+			return fmt.Errorf("building kube client: %w", buildErr)
+		}
+		snap, err = takeSnapshot(ctx, clientset, dynamicClient, metav1.NamespaceAll)
+	}
+	if err != nil {
+		return err
+	}
+	client, dynamicClient := snap.clients()
+
+	annotationKeys := referenceAnnotationKeys(*referenceAnnotations)
+
+	now := time.Now()
+	var rows []namespaceRow
+	for _, ns := range snap.namespaces() {
+		res, err := detect(ctx, client, dynamicClient, ns, annotationKeys)
+		if err != nil {
+			return fmt.Errorf("scanning namespace %s: %w", ns, err)
+		}
+		applyFilters(&res, filters)
+
+		row := namespaceRow{
+			Namespace:           ns,
+			OrphanConfigMaps:    res.OrphanConfigMaps,
+			OrphanSecrets:       res.OrphanSecrets,
+			OrphanPVCs:          res.OrphanPVCs,
+			ServicesNoEndpoints: res.ServicesNoEndpoints,
+			OrphanEndpoints:     res.OrphanEndpoints,
+			TotalOrphans:        totalOrphans(res),
+			SizeBytes:           snap.pvcStorage(ns, res.OrphanPVCNames),
+		}
+
+		var created []time.Time
+		created = append(created, snap.createdAt("ConfigMap", ns, res.OrphanConfigMapNames)...)
+		created = append(created, snap.createdAt("Secret", ns, res.OrphanSecretNames)...)
+		created = append(created, snap.createdAt("PersistentVolumeClaim", ns, res.OrphanPVCNames)...)
+		created = append(created, snap.createdAt("Service", ns, res.ServicesNoEndpointsNames)...)
+		created = append(created, snap.createdAt("Endpoints", ns, res.OrphanEndpointNames)...)
+		for _, t := range created {
+			if age := now.Sub(t).Seconds(); age > row.OldestOrphanSeconds {
+				row.OldestOrphanSeconds = age
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	if err := sortNamespaceRows(rows, *sortBy); err != nil {
+		return err
+	}
+
+	switch *output {
+	case "json":
+		b, _ := json.MarshalIndent(rows, "", "  ")
+		fmt.Println(string(b))
+	default:
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "NAMESPACE\tCONFIGMAPS\tSECRETS\tPVCS\tSERVICES\tENDPOINTS\tTOTAL\tSIZE\tOLDEST")
+		for _, row := range rows {
+			oldest := "-"
+			if row.OldestOrphanSeconds > 0 {
+				oldest = formatAge(time.Duration(row.OldestOrphanSeconds * float64(time.Second)))
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n",
+				row.Namespace, row.OrphanConfigMaps, row.OrphanSecrets, row.OrphanPVCs,
+				row.ServicesNoEndpoints, row.OrphanEndpoints, row.TotalOrphans,
+				resource.NewQuantity(row.SizeBytes, resource.BinarySI).String(), oldest)
+		}
+		tw.Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/notifier"
+)
+
+// runNotify implements `korp notify --test -f korpscan.yaml`
+func runNotify(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" notify", flag.ContinueOnError)
+	test := fs.Bool("test", false, "send a synthetic payload through the configured notification channels")
+	file := fs.String("f", "", "KorpScan manifest whose spec.reporting configures the notification channels")
+	kube := addKubeFlags(fs, "path to kubeconfig, used to read the Slack Secret")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if !*test {
+		return errors.New("korp notify currently supports only --test")
+	}
+	if *file == "" {
+		return errors.New("-f is required")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", *file, err)
+	}
+	var korpScan korpv1alpha1.KorpScan
+	if err := yaml.UnmarshalStrict(data, &korpScan); err != nil {
+		return fmt.Errorf("parsing %s: %w", *file, err)
+	}
+
+	// The Slack, PagerDuty and webhook Secrets and the payload template ConfigMap live in the cluster,
+	// in the KorpScan namespace
+	var client kubernetes.Interface
+	webhook := korpScan.Spec.Reporting.Webhook
+	if korpScan.Spec.Reporting.Slack != nil || korpScan.Spec.Reporting.PagerDuty != nil ||
+		(webhook != nil && readsCluster(*webhook)) || namedNotifiersReadCluster(korpScan.Spec.Reporting.Notifiers) {
+		if korpScan.Namespace == "" {
+			korpScan.Namespace = "default"
+		}
+		clientset, _, err := buildClient(kube)
+		if err != nil {
+			return err
+		}
+		client = clientset
+	}
+
+	results := notifier.SendTest(context.TODO(), client, korpScan.Spec.Reporting, notifier.ScanMetadata{
+		Name:            korpScan.Name,
+		Namespace:       korpScan.Namespace,
+		TargetNamespace: korpScan.Spec.TargetNamespace,
+	}, logr.Discard())
+	if len(results) == 0 {
+		return fmt.Errorf("%s configures no notification channels", *file)
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("FAIL  %s %s: %v\n", r.Channel, r.Target, r.Err)
+			continue
+		}
+		fmt.Printf("OK    %s %s\n", r.Channel, r.Target)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d notification channels failed", failed, len(results))
+	}
+	return nil
+}
+
+// readsCluster reports whether a webhook reads Secrets or its payload template from the cluster
+func readsCluster(webhook korpv1alpha1.WebhookConfig) bool {
+	return notifier.UsesSecrets(webhook) || notifier.UsesConfigMap(webhook)
+}
+
+// namedNotifiersReadCluster reports whether any of the named notifiers reads from the cluster.
+// Only webhooks without Secrets or a ConfigMap template do not.
+func namedNotifiersReadCluster(specs []korpv1alpha1.NotifierSpec) bool {
+	for _, spec := range specs {
+		if spec.Type != korpv1alpha1.NotifierTypeWebhook || spec.Webhook == nil || readsCluster(*spec.Webhook) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,402 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/report"
+	"github.com/kamilbabayev/korp/pkg/scan"
+)
+
+// cliFinding is one finding as seen by custom-columns and go-template output
+type cliFinding struct {
+	ResourceType string `json:"resourceType"`
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+	Reason       string `json:"reason"`
+	FirstSeen    string `json:"firstSeen,omitempty"`
+}
+
+// findingList flattens a scan result into findings; first-seen times come from the store when set
+func findingList(res report.ScanResult, store *findingStore) []cliFinding {
+	groups := []struct {
+		resourceType string
+		reason       string
+		names        []string
+	}{
+		{"ConfigMap", "NoOwnerReference", res.OrphanConfigMapNames},
+		{"Secret", "NoOwnerReference", res.OrphanSecretNames},
+		{"PersistentVolumeClaim", "NoOwnerReference", res.OrphanPVCNames},
+		{"Service", "NoEndpoints", res.ServicesNoEndpointsNames},
+		{"Endpoints", "NoMatchingService", res.OrphanEndpointNames},
+	}
+
+	var out []cliFinding
+	for _, g := range groups {
+		for _, name := range g.names {
+			ns, bare := splitName(res.Namespace, name)
+			f := cliFinding{ResourceType: g.resourceType, Namespace: ns, Name: bare, Reason: g.reason}
+			if store != nil {
+				if firstSeen, ok := store.firstSeen(g.resourceType, ns, bare); ok {
+					f.FirstSeen = firstSeen.Format(time.RFC3339)
+				}
+			}
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// toGeneric converts findings into the map form templates and JSONPath operate on,
+// so field names are the JSON names
+func toGeneric(findings []cliFinding) ([]interface{}, error) {
+	b, err := json.Marshal(findings)
+	if err != nil {
+		return nil, err
+	}
+	items := []interface{}{}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// relaxedJSONPath accepts ".name", "name" and "{.name}" like kubectl
+func relaxedJSONPath(expr string) string {
+	if strings.HasPrefix(expr, "{") && strings.HasSuffix(expr, "}") {
+		return expr
+	}
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+	return "{" + expr + "}"
+}
+
+// printCustomColumns prints findings as columns defined by "HEADER:.path,HEADER:.path"
+func printCustomColumns(w io.Writer, spec string, findings []cliFinding) error {
+	type column struct {
+		header string
+		parser *jsonpath.JSONPath
+	}
+
+	var columns []column
+	for _, part := range strings.Split(spec, ",") {
+		header, expr, ok := strings.Cut(part, ":")
+		if !ok || header == "" || expr == "" {
+			return fmt.Errorf("invalid custom-columns spec %q, expected HEADER:.field", part)
+		}
+		parser := jsonpath.New(header).AllowMissingKeys(true)
+		if err := parser.Parse(relaxedJSONPath(expr)); err != nil {
+			return fmt.Errorf("parsing column %s: %w", header, err)
+		}
+		columns = append(columns, column{header: header, parser: parser})
+	}
+
+	items, err := toGeneric(findings)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.header
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			results, err := c.parser.FindResults(item)
+			if err != nil {
+				return err
+			}
+			var values []string
+			for _, result := range results {
+				for _, v := range result {
+					if v.Kind() == reflect.Interface && v.IsNil() {
+						continue
+					}
+					values = append(values, fmt.Sprint(v.Interface()))
+				}
+			}
+			cells[i] = "<none>"
+			if len(values) > 0 {
+				cells[i] = strings.Join(values, ",")
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// printGoTemplate executes a Go template against {"items": [findings...]}, as kubectl does for lists
+func printGoTemplate(w io.Writer, text string, findings []cliFinding) error {
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return fmt.Errorf("parsing go-template: %w", err)
+	}
+
+	items, err := toGeneric(findings)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, map[string]interface{}{"items": items})
+}
+
+// printOptions control how printResult renders a scan result
+type printOptions struct {
+	// resourceType limits the table to one resource type; "" shows all types
+	resourceType string
+
+	// store adds first-seen times to findings, if set
+	store *findingStore
+
+	// now is the time first-seen ages are measured to
+	now time.Time
+
+	// showChanges lists the findings that are new or resolved since the last run
+	showChanges bool
+
+	// showBaseline lists the findings missing from the baseline
+	showBaseline bool
+}
+
+// describe renders a finding name, with its first-seen age when a store is used
+func (opts printOptions) describe(res report.ScanResult, resourceType, name string) string {
+	if opts.store == nil {
+		return name
+	}
+	ns, bare := splitName(res.Namespace, name)
+	if firstSeen, ok := opts.store.firstSeen(resourceType, ns, bare); ok {
+		return fmt.Sprintf("%s (first seen %s ago)", name, formatAge(opts.now.Sub(firstSeen)))
+	}
+	return name
+}
+
+// printResult writes a scan result in the given output format: table (the default), wide, json, yaml, name, sarif,
+// custom-columns=HEADER:.field,... or go-template=TEMPLATE, like kubectl's -o flag
+func printResult(w io.Writer, res report.ScanResult, output string, opts printOptions) error {
+	// kubectl-style projections over the findings list
+	if spec, ok := strings.CutPrefix(output, "custom-columns="); ok {
+		return printCustomColumns(w, spec, findingList(res, opts.store))
+	}
+	if text, ok := strings.CutPrefix(output, "go-template="); ok {
+		return printGoTemplate(w, text, findingList(res, opts.store))
+	}
+
+	switch output {
+	case "json":
+		b, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(b))
+	case "yaml":
+		b, err := yaml.Marshal(res)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, string(b))
+	case "name":
+		for _, f := range findingList(res, opts.store) {
+			fmt.Fprintf(w, "%s/%s\n", strings.ToLower(f.ResourceType), f.Name)
+		}
+	case "wide":
+		return printCustomColumns(w, wideColumns, findingList(res, opts.store))
+	case "sarif":
+		return printSARIF(w, res, opts.store)
+	case "", "table":
+		printTable(w, res, opts)
+	default:
+		return fmt.Errorf("unknown output format %q (use %s)", output, outputFormats)
+	}
+	return nil
+}
+
+// outputFormats lists the values of -o for flag help and errors
+const outputFormats = "table|wide|json|yaml|name|sarif|custom-columns=HEADER:.field,...|go-template=TEMPLATE"
+
+// wideColumns are the columns of -o wide, one row per finding
+const wideColumns = "NAMESPACE:.namespace,TYPE:.resourceType,NAME:.name,REASON:.reason,FIRST SEEN:.firstSeen"
+
+// printSARIF writes the findings as a SARIF log for GitHub code scanning and other SARIF consumers
+func printSARIF(w io.Writer, res report.ScanResult, store *findingStore) error {
+	var findings []korpv1alpha1.Finding
+	for _, f := range findingList(res, store) {
+		finding := korpv1alpha1.Finding{
+			ResourceType:    f.ResourceType,
+			Namespace:       f.Namespace,
+			Name:            f.Name,
+			Reason:          f.Reason,
+			SuggestedAction: scan.SuggestedAction(f.ResourceType, f.Reason),
+			Severity:        scan.Severity(f.ResourceType, f.Reason),
+			Confidence:      scan.Confidence(f.ResourceType, f.Reason),
+		}
+		if firstSeen, err := time.Parse(time.RFC3339, f.FirstSeen); err == nil {
+			finding.DetectedAt = metav1.NewTime(firstSeen)
+		}
+		findings = append(findings, finding)
+	}
+	b, err := json.MarshalIndent(report.NewSARIF(findings, version), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, string(b))
+	return nil
+}
+
+// printTable writes the human-readable scan report
+func printTable(w io.Writer, res report.ScanResult, opts printOptions) {
+	// Print header
+	fmt.Fprintln(w, "================================================================================")
+	fmt.Fprintln(w, "KORP SCAN RESULTS")
+	fmt.Fprintln(w, "================================================================================")
+
+	// Show namespace info
+	nsDisplay := res.Namespace
+	if res.Namespace == "" || res.Namespace == metav1.NamespaceAll {
+		nsDisplay = "All Namespaces"
+	}
+	fmt.Fprintf(w, "\nTarget: %s\n\n", nsDisplay)
+
+	// Resource summary (single-type scans do not list the other types)
+	if opts.resourceType == "" {
+		fmt.Fprintln(w, "RESOURCE SUMMARY:")
+		fmt.Fprintln(w, "--------------------------------------------------------------------------------")
+		fmt.Fprintf(w, "  Pods:         %d\n", res.Pods)
+		fmt.Fprintf(w, "  ConfigMaps:   %d\n", res.ConfigMaps)
+		fmt.Fprintf(w, "  Secrets:      %d\n", res.Secrets)
+		fmt.Fprintf(w, "  Services:     %d\n", res.Services)
+		fmt.Fprintf(w, "  PVCs:         %d\n", res.PVCs)
+		fmt.Fprintf(w, "  Endpoints:    %d\n", res.Endpoints)
+		fmt.Fprintln(w)
+	}
+
+	// Orphaned resources with inline details
+	fmt.Fprintln(w, "ORPHANED RESOURCES:")
+	fmt.Fprintln(w, "================================================================================")
+
+	issueTypes := 0
+	shown := func(t string) bool { return opts.resourceType == "" || opts.resourceType == t }
+
+	// Orphaned ConfigMaps
+	if shown("configmaps") {
+		if res.OrphanConfigMaps > 0 {
+			issueTypes++
+			fmt.Fprintf(w, "\nConfigMaps: %d orphaned\n", res.OrphanConfigMaps)
+			for i, name := range res.OrphanConfigMapNames {
+				fmt.Fprintf(w, "   %d. %s\n", i+1, opts.describe(res, "ConfigMap", name))
+			}
+		} else {
+			fmt.Fprintf(w, "\nConfigMaps: No orphaned resources\n")
+		}
+	}
+
+	// Orphaned Secrets
+	if shown("secrets") {
+		if res.OrphanSecrets > 0 {
+			issueTypes++
+			fmt.Fprintf(w, "\nSecrets: %d orphaned\n", res.OrphanSecrets)
+			for i, name := range res.OrphanSecretNames {
+				fmt.Fprintf(w, "   %d. %s\n", i+1, opts.describe(res, "Secret", name))
+			}
+		} else {
+			fmt.Fprintf(w, "\nSecrets: No orphaned resources\n")
+		}
+	}
+
+	// Orphaned PVCs
+	if shown("pvcs") {
+		if res.OrphanPVCs > 0 {
+			issueTypes++
+			fmt.Fprintf(w, "\nPVCs: %d orphaned\n", res.OrphanPVCs)
+			for i, name := range res.OrphanPVCNames {
+				fmt.Fprintf(w, "   %d. %s\n", i+1, opts.describe(res, "PersistentVolumeClaim", name))
+			}
+		} else {
+			fmt.Fprintf(w, "\nPVCs: No orphaned resources\n")
+		}
+	}
+
+	// Services without endpoints
+	if shown("services") {
+		if res.ServicesNoEndpoints > 0 {
+			issueTypes++
+			fmt.Fprintf(w, "\nServices: %d without endpoints\n", res.ServicesNoEndpoints)
+			for i, name := range res.ServicesNoEndpointsNames {
+				fmt.Fprintf(w, "   %d. %s\n", i+1, opts.describe(res, "Service", name))
+			}
+		} else {
+			fmt.Fprintf(w, "\nServices: All have endpoints\n")
+		}
+	}
+
+	// Orphan Endpoints (no matching Service)
+	if shown("endpoints") {
+		if res.OrphanEndpoints > 0 {
+			issueTypes++
+			fmt.Fprintf(w, "\nEndpoints: %d orphaned (no matching Service)\n", res.OrphanEndpoints)
+			for i, name := range res.OrphanEndpointNames {
+				fmt.Fprintf(w, "   %d. %s\n", i+1, opts.describe(res, "Endpoints", name))
+			}
+		} else {
+			fmt.Fprintf(w, "\nEndpoints: All have matching Services\n")
+		}
+	}
+
+	// Changes since the previous run recorded in the store
+	if opts.showChanges {
+		fmt.Fprintln(w, "\nCHANGES SINCE LAST RUN:")
+		fmt.Fprintln(w, "--------------------------------------------------------------------------------")
+		fmt.Fprintf(w, "  New:      %d\n", len(res.NewFindings))
+		for _, f := range res.NewFindings {
+			fmt.Fprintf(w, "    + %s\n", f)
+		}
+		fmt.Fprintf(w, "  Resolved: %d\n", len(res.ResolvedFindings))
+		for _, f := range res.ResolvedFindings {
+			fmt.Fprintf(w, "    - %s\n", f)
+		}
+	}
+
+	// Findings missing from the baseline
+	if opts.showBaseline {
+		fmt.Fprintln(w, "\nNEW SINCE BASELINE:")
+		fmt.Fprintln(w, "--------------------------------------------------------------------------------")
+		fmt.Fprintf(w, "  %d new of %d total\n", len(res.NotInBaseline), totalOrphans(res))
+		for _, f := range res.NotInBaseline {
+			fmt.Fprintf(w, "    + %s\n", f)
+		}
+	}
+
+	// Resource types korp could not list, so the result above is incomplete
+	if len(res.BlindSpots) > 0 {
+		fmt.Fprintln(w, "\nNOT SCANNED (FORBIDDEN):")
+		fmt.Fprintln(w, "--------------------------------------------------------------------------------")
+		for _, spot := range res.BlindSpots {
+			fmt.Fprintf(w, "  %s: %s\n", spot.ResourceType, spot.Error)
+		}
+	}
+
+	// Footer
+	fmt.Fprintln(w, "\n================================================================================")
+	if issueTypes > 0 {
+		fmt.Fprintf(w, "Found issues in %d resource type(s)\n", issueTypes)
+	} else if len(res.BlindSpots) > 0 {
+		fmt.Fprintf(w, "No orphaned resources found, but %d resource type(s) could not be scanned\n", len(res.BlindSpots))
+	} else {
+		fmt.Fprintln(w, "No orphaned resources found - cluster is clean!")
+	}
+	fmt.Fprintln(w, "================================================================================")
+}
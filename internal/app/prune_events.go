@@ -0,0 +1,213 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/pager"
+	"k8s.io/client-go/util/flowcontrol"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/cleanup"
+	"github.com/kamilbabayev/korp/pkg/policy"
+)
+
+// runPruneEvents implements `korp prune-events -n <ns> [--older-than 24h] [--dry-run] [--qps 10] [--yes]`.
+// Events whose last occurrence is older than the cutoff are counted per namespace, confirmed and then
+// deleted by the cleaner, which honors KorpPolicies like `korp clean`.
+func runPruneEvents(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" prune-events", flag.ContinueOnError)
+	namespace := fs.String("namespace", "", "namespace to prune")
+	fs.StringVar(namespace, "n", "", "shorthand for --namespace")
+	allNamespaces := fs.Bool("all-namespaces", false, "prune all namespaces")
+	fs.BoolVar(allNamespaces, "A", false, "shorthand for --all-namespaces")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	olderThan := fs.Duration("older-than", 24*time.Hour, "delete Events last seen longer ago than this")
+	dryRun := fs.Bool("dry-run", false, "only print how many Events would be deleted")
+	qps := fs.Float64("qps", 10, "maximum API requests per second")
+	yes := fs.Bool("yes", false, "do not ask for confirmation")
+	fs.BoolVar(yes, "y", false, "shorthand for --yes")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *namespace == "" && !*allNamespaces {
+		return errors.New("--namespace or --all-namespaces is required")
+	}
+	if *olderThan <= 0 {
+		return errors.New("--older-than must be positive")
+	}
+	if *qps <= 0 {
+		return errors.New("--qps must be positive")
+	}
+
+	// Deletes go through a client limited to --qps, so pruning a busy cluster does not crowd out other clients
+	cfg, err := buildConfig(kube)
+	if err != nil {
+		return fmt.Errorf("building kube client: %w", err)
+	}
+	burst := int(*qps)
+	if burst < 1 {
+		burst = 1
+	}
+	cfg.QPS = float32(*qps)
+	cfg.Burst = burst
+	cfg.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(cfg.QPS, burst)
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("building kube client: %w", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("building dynamic client: %w", err)
+	}
+
+	ctx := context.TODO()
+	namespaces := []string{*namespace}
+	if *allNamespaces {
+		list, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		namespaces = namespaces[:0]
+		for _, ns := range list.Items {
+			namespaces = append(namespaces, ns.Name)
+		}
+		sort.Strings(namespaces)
+	}
+
+	cutoff := time.Now().Add(-*olderThan)
+	var stale []korpv1alpha1.Finding
+	for _, ns := range namespaces {
+		events, err := staleEvents(ctx, clientset, ns, cutoff, kube.chunkSize)
+		if err != nil {
+			return fmt.Errorf("listing events in namespace %s: %w", ns, err)
+		}
+		stale = append(stale, events...)
+	}
+
+	// KorpPolicies in the cluster are honored like in the operator
+	policies, err := policy.Load(ctx, dynamicClient, &korpv1alpha1.KorpScan{})
+	if err != nil {
+		return err
+	}
+	cleaner := cleanup.NewCleaner(clientset, dynamicClient, logr.Discard())
+	spec := &korpv1alpha1.CleanupSpec{Enabled: true}
+
+	// Plan with a dry run, which applies every rule without changing anything
+	dryRunPlan := true
+	spec.DryRun = &dryRunPlan
+	plan, err := cleaner.Clean(ctx, stale, spec, policies)
+	if err != nil {
+		return err
+	}
+	planned := make(map[string]int, len(namespaces))
+	for _, p := range plan.WouldRemediate {
+		planned[p.Namespace]++
+	}
+	printPrunedEvents(os.Stdout, namespaces, planned)
+	if skipped := plan.Summary.TotalSkippedPolicy; skipped > 0 {
+		fmt.Printf("Skipped %d events protected by a KorpPolicy.\n", skipped)
+	}
+	if *dryRun || len(plan.WouldRemediate) == 0 {
+		fmt.Printf("Would delete %d events last seen before %s.\n", len(plan.WouldRemediate), cutoff.UTC().Format(time.RFC3339))
+		return nil
+	}
+
+	// Only what was planned and confirmed is deleted
+	approved, err := confirmRemediations(plan.WouldRemediate, *yes, false)
+	if err != nil {
+		return err
+	}
+	if len(approved) == 0 {
+		fmt.Println("Nothing deleted.")
+		return nil
+	}
+	var selected []korpv1alpha1.Finding
+	for _, f := range stale {
+		if approved[plannedKey(f.ResourceType, f.Namespace, f.Name)] {
+			selected = append(selected, f)
+		}
+	}
+
+	apply := false
+	spec.DryRun = &apply
+	result, err := cleaner.Clean(ctx, selected, spec, policies)
+	if err != nil {
+		return err
+	}
+	for _, f := range result.FailedDeletions {
+		fmt.Fprintf(os.Stderr, "failed event %s: %s\n", qualifiedName(f.Namespace, f.Name), f.Error)
+	}
+	fmt.Printf("Deleted %d events last seen before %s.\n", len(result.DeletedResources), cutoff.UTC().Format(time.RFC3339))
+	if len(result.FailedDeletions) > 0 {
+		return fmt.Errorf("%d of %d events could not be deleted", len(result.FailedDeletions), len(selected))
+	}
+	return nil
+}
+
+// staleEvents returns the Events in ns last seen before cutoff as findings for the cleaner.
+// Events are listed pageSize at a time, so namespaces with many Events are read in pages.
+func staleEvents(ctx context.Context, client kubernetes.Interface, ns string, cutoff time.Time, pageSize int64) ([]korpv1alpha1.Finding, error) {
+	var stale []korpv1alpha1.Finding
+	p := pager.New(pager.SimplePageFunc(func(opts metav1.ListOptions) (runtime.Object, error) {
+		return client.CoreV1().Events(ns).List(ctx, opts)
+	}))
+	p.PageSize = pageSize
+	err := p.EachListItem(ctx, metav1.ListOptions{}, func(obj runtime.Object) error {
+		event := obj.(*corev1.Event)
+		if !eventLastSeen(*event).Before(cutoff) {
+			return nil
+		}
+		// The cutoff was applied here; a zero DetectedAt passes the cleaner's own age check
+		stale = append(stale, korpv1alpha1.Finding{
+			ResourceType:    "Event",
+			Namespace:       ns,
+			Name:            event.Name,
+			Reason:          "Expired",
+			SuggestedAction: korpv1alpha1.ActionDelete,
+		})
+		return nil
+	})
+	return stale, err
+}
+
+// eventLastSeen returns when an Event last occurred. Events record this in different fields depending
+// on the API that wrote them, so the latest of them is used.
+func eventLastSeen(event corev1.Event) time.Time {
+	last := event.CreationTimestamp.Time
+	for _, t := range []time.Time{event.FirstTimestamp.Time, event.LastTimestamp.Time, event.EventTime.Time} {
+		if t.After(last) {
+			last = t
+		}
+	}
+	if event.Series != nil && event.Series.LastObservedTime.After(last) {
+		last = event.Series.LastObservedTime.Time
+	}
+	return last
+}
+
+// printPrunedEvents lists the Event count of each namespace that has Events to prune
+func printPrunedEvents(w io.Writer, namespaces []string, pruned map[string]int) {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tEVENTS")
+	for _, ns := range namespaces {
+		if pruned[ns] > 0 {
+			fmt.Fprintf(tw, "%s\t%d\n", ns, pruned[ns])
+		}
+	}
+	tw.Flush()
+}
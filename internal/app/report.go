@@ -0,0 +1,74 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+// runReport implements `korp report -f result.json [type]`: it prints a result saved with
+// `korp scan -o json` in any scan output format, so a scan can run once and be viewed many ways
+func runReport(args []string) error {
+	var resourceType string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		resourceType = cliResourceType(args[0])
+		args = args[1:]
+	}
+
+	fs := flag.NewFlagSet(commandName()+" report", flag.ContinueOnError)
+	file := fs.String("f", "", "scan result written by scan --output json; - reads standard input")
+	fs.StringVar(file, "filename", "", "same as -f")
+	output := fs.String("output", "table", "output format: "+outputFormats)
+	fs.StringVar(output, "o", "table", "shorthand for --output")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if resourceType == "" && fs.NArg() > 0 {
+		resourceType = cliResourceType(fs.Arg(0))
+	}
+	if *file == "" {
+		return errors.New("-f is required")
+	}
+
+	res, err := readResult(*file)
+	if err != nil {
+		return err
+	}
+
+	// The changes and baseline sections are shown when the saved scan computed them
+	opts := printOptions{
+		resourceType: resourceType,
+		showChanges:  res.NewFindings != nil || res.ResolvedFindings != nil,
+		showBaseline: res.NotInBaseline != nil,
+	}
+	return printResult(os.Stdout, res, *output, opts)
+}
+
+// readResult loads a `korp scan --output json` result from path, or from standard input if path is "-"
+func readResult(path string) (report.ScanResult, error) {
+	var res report.ScanResult
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return res, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return res, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if res.SchemaVersion != "" && res.SchemaVersion != report.SchemaVersion {
+		return res, fmt.Errorf("%s has result schema %s, this korp reads %s", path, res.SchemaVersion, report.SchemaVersion)
+	}
+	return res, nil
+}
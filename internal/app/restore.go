@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/backup"
+)
+
+// runRestore implements `korp restore -n <ns> --korpscan <name> [--run <id>] [<type>/<namespace>/<name>...] [--dry-run]`.
+// Resources deleted by a KorpScan's cleanup are created again from the manifests it backed up: every
+// resource of a run, the listed resources of a run, or the listed resources of the last cleanup.
+func runRestore(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" restore", flag.ContinueOnError)
+	namespace := fs.String("namespace", "", "namespace of the KorpScan")
+	fs.StringVar(namespace, "n", "", "shorthand for --namespace")
+	korpScanName := fs.String("korpscan", "", "KorpScan whose cleanup deleted the resources")
+	run := fs.String("run", "", "cleanup run ID (status.cleanupStatus.backupRun) to restore from")
+	path := fs.String("path", "", "read path backups from this directory instead of spec.cleanup.backup.path, e.g. a copy of the operator's volume")
+	dryRun := fs.Bool("dry-run", false, "only print what would be restored")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *namespace == "" || *korpScanName == "" {
+		return errors.New("--namespace and --korpscan are required")
+	}
+	var refs []backup.Ref
+	for _, arg := range fs.Args() {
+		ref, err := backup.ParseRef(arg)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, ref)
+	}
+	if *run == "" && len(refs) == 0 {
+		return errors.New("--run or at least one <type>/<namespace>/<name> is required")
+	}
+
+	client, dynamicClient, err := buildClient(kube)
+	if err != nil {
+		return fmt.Errorf("building kube client: %w", err)
+	}
+	ctx := context.TODO()
+
+	item, err := dynamicClient.Resource(korpScanGVR).Namespace(*namespace).Get(ctx, *korpScanName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("reading KorpScan: %w", err)
+	}
+	var korpScan korpv1alpha1.KorpScan
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &korpScan); err != nil {
+		return fmt.Errorf("reading KorpScan: %w", err)
+	}
+	if korpScan.Spec.Cleanup == nil || korpScan.Spec.Cleanup.Backup == nil {
+		return fmt.Errorf("KorpScan %s/%s does not back up cleanup deletions", *namespace, *korpScanName)
+	}
+	spec := korpScan.Spec.Cleanup.Backup.DeepCopy()
+	if *path != "" {
+		if spec.Path == "" {
+			return errors.New("--path applies only to KorpScans backing up to a path")
+		}
+		spec.Path = *path
+	}
+
+	src, err := backup.NewSource(ctx, client, spec, &korpScan)
+	if err != nil {
+		return err
+	}
+	var deleted []korpv1alpha1.DeletedResource
+	if korpScan.Status.CleanupStatus != nil {
+		deleted = korpScan.Status.CleanupStatus.DeletedResources
+	}
+	locations, err := backup.Select(ctx, src, deleted, *run, refs)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, location := range locations {
+		manifest, err := src.Get(ctx, location)
+		if err != nil {
+			fmt.Printf("failed   %s: %v\n", location, err)
+			failed++
+			continue
+		}
+		obj, err := backup.Decode(manifest)
+		if err != nil {
+			fmt.Printf("failed   %s: %v\n", location, err)
+			failed++
+			continue
+		}
+		ref := backup.Ref{ResourceType: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		if *dryRun {
+			fmt.Printf("would restore %s from %s\n", ref, location)
+			continue
+		}
+		if err := backup.Restore(ctx, dynamicClient, obj); err != nil {
+			fmt.Printf("failed   %s: %v\n", ref, err)
+			failed++
+			continue
+		}
+		fmt.Printf("restored %s from %s\n", ref, location)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d resource(s) could not be restored", failed, len(locations))
+	}
+	return nil
+}
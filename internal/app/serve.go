@@ -0,0 +1,300 @@
+package app
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+// scannedAtHeader carries the time of the snapshot a served result was computed from
+const scannedAtHeader = "X-Korp-Scanned-At"
+
+// scanCache holds in-memory clients over the latest cluster snapshot
+type scanCache struct {
+	mu        sync.RWMutex
+	client    kubernetes.Interface
+	dynamic   dynamic.Interface
+	scannedAt time.Time
+}
+
+// refresh lists the cluster once and swaps in the new snapshot
+func (c *scanCache) refresh(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface) error {
+	snap, err := takeSnapshot(ctx, client, dynamicClient, metav1.NamespaceAll)
+	if err != nil {
+		return err
+	}
+	snapClient, snapDynamic := snap.clients()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.client, c.dynamic, c.scannedAt = snapClient, snapDynamic, snap.CreatedAt
+	return nil
+}
+
+// current returns the cached clients; client is nil before the first refresh
+func (c *scanCache) current() (kubernetes.Interface, dynamic.Interface, time.Time) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.client, c.dynamic, c.scannedAt
+}
+
+// handleScan serves GET /scan?namespace=<ns>&type=<type> from the cached snapshot
+func (c *scanCache) handleScan(annotationKeys []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		client, dynamicClient, scannedAt := c.current()
+		if client == nil {
+			http.Error(w, "first scan has not completed yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		ns := r.URL.Query().Get("namespace")
+		resourceType := r.URL.Query().Get("type")
+
+		var res report.ScanResult
+		var err error
+		if resourceType != "" {
+			res = report.ScanResult{SchemaVersion: report.SchemaVersion, Namespace: ns}
+			err = detectType(r.Context(), client, dynamicClient, ns, resourceType, annotationKeys, nil, &res)
+		} else {
+			res, err = detect(r.Context(), client, dynamicClient, ns, annotationKeys)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(scannedAtHeader, scannedAt.Format(time.RFC3339))
+		_ = json.NewEncoder(w).Encode(res)
+	}
+}
+
+// requireToken rejects requests that do not carry token as a bearer token
+func requireToken(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isLoopback reports whether a listen address only accepts local connections
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// readToken reads a bearer token from a file, ignoring surrounding whitespace
+func readToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading token: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// runServe implements `korp serve --cache`
+func runServe(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" serve", flag.ContinueOnError)
+	cache := fs.Bool("cache", false, "scan the cluster on a schedule and serve cached results over HTTP")
+	listen := fs.String("listen", "127.0.0.1:8080", "address to serve on; non-loopback addresses require --tls-cert-file, --tls-key-file and --token-file")
+	interval := fs.Duration("interval", 5*time.Minute, "time between cluster scans")
+	tlsCertFile := fs.String("tls-cert-file", "", "serve HTTPS with this PEM certificate")
+	tlsKeyFile := fs.String("tls-key-file", "", "private key for --tls-cert-file")
+	tokenFile := fs.String("token-file", "", "require this bearer token on /scan")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	referenceAnnotations := fs.String("reference-annotations", "", "comma-separated extra annotation keys whose values name ConfigMaps/Secrets in use")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if !*cache {
+		return errors.New("korp serve currently supports only --cache")
+	}
+	if (*tlsCertFile == "") != (*tlsKeyFile == "") {
+		return errors.New("--tls-cert-file and --tls-key-file must be set together")
+	}
+	if !isLoopback(*listen) && (*tlsCertFile == "" || *tokenFile == "") {
+		return fmt.Errorf("serving on %s exposes cluster-wide scan results; set --tls-cert-file, --tls-key-file and --token-file or listen on a loopback address", *listen)
+	}
+
+	var token string
+	if *tokenFile != "" {
+		var err error
+		if token, err = readToken(*tokenFile); err != nil {
+			return err
+		}
+	}
+
+	client, dynamicClient, err := buildLiveClient(kube)
+	if err != nil {
+		return fmt.Errorf("building kube client: %w", err)
+	}
+
+	annotationKeys := referenceAnnotationKeys(*referenceAnnotations)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	c := &scanCache{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+		for {
+			// Each scan starts from a fresh snapshot of the cluster
+			snapshot := k8sutil.NewSnapshotClient(client, kube.chunkSize)
+			if err := c.refresh(ctx, snapshot, dynamicClient); err != nil && ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "scan failed, serving previous results: %v\n", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	var scanHandler http.Handler = c.handleScan(annotationKeys)
+	if token != "" {
+		scanHandler = requireToken(token, scanHandler)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/scan", scanHandler)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if client, _, _ := c.current(); client == nil {
+			http.Error(w, "first scan has not completed yet", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		fmt.Fprintf(os.Stderr, "Serving cached scan results on %s (rescanning every %s)\n", *listen, *interval)
+		if *tlsCertFile != "" {
+			serveErr <- server.ListenAndServeTLS(*tlsCertFile, *tlsKeyFile)
+		} else {
+			serveErr <- server.ListenAndServe()
+		}
+	}()
+
+	select {
+	case err = <-serveErr:
+		stop()
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		err = server.Shutdown(shutdownCtx)
+	}
+	wg.Wait()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// serverClient returns the HTTP client for --from-server, trusting caFile when set
+func serverClient(caFile string) (*http.Client, error) {
+	if caFile == "" {
+		return http.DefaultClient, nil
+	}
+	bundle, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return &http.Client{Transport: transport}, nil
+}
+
+// fetchScan gets a scan result from a korp serve --cache instance
+func fetchScan(ctx context.Context, serverURL, token, caFile, ns, resourceType string) (report.ScanResult, error) {
+	var res report.ScanResult
+
+	httpClient, err := serverClient(caFile)
+	if err != nil {
+		return res, err
+	}
+
+	query := url.Values{}
+	query.Set("namespace", ns)
+	if resourceType != "" {
+		query.Set("type", resourceType)
+	}
+	endpoint := strings.TrimSuffix(serverURL, "/") + "/scan?" + query.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return res, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return res, fmt.Errorf("querying korp server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return res, fmt.Errorf("korp server returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return res, fmt.Errorf("parsing korp server response: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,565 @@
+package app
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// snapshotVersion is the format version of snapshot archives.
+// Version 2 added EndpointSlices; version 1 archives are upgraded on read.
+// Version 3 added Jobs, CronJobs and the cluster-scoped sources of Secret references; version 2
+// archives are read without them.
+const snapshotVersion = 3
+
+// snapshotFile is the name of the manifest inside a snapshot archive
+const snapshotFile = "snapshot.json"
+
+// lastAppliedAnnotation may embed full object contents, including Secret data
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// clusterSnapshot holds the objects korp needs to re-run a scan offline.
+// Secret and ConfigMap payloads are never included.
+type clusterSnapshot struct {
+	Version               int                            `json:"version"`
+	CreatedAt             time.Time                      `json:"createdAt"`
+	Namespace             string                         `json:"namespace"`
+	Pods                  []corev1.Pod                   `json:"pods,omitempty"`
+	ConfigMaps            []corev1.ConfigMap             `json:"configMaps,omitempty"`
+	Secrets               []corev1.Secret                `json:"secrets,omitempty"`
+	Services              []corev1.Service               `json:"services,omitempty"`
+	PVCs                  []corev1.PersistentVolumeClaim `json:"pvcs,omitempty"`
+	Endpoints             []corev1.Endpoints             `json:"endpoints,omitempty"`
+	EndpointSlices        []discoveryv1.EndpointSlice    `json:"endpointSlices,omitempty"`
+	Deployments           []appsv1.Deployment            `json:"deployments,omitempty"`
+	StatefulSets          []appsv1.StatefulSet           `json:"statefulSets,omitempty"`
+	DaemonSets            []appsv1.DaemonSet             `json:"daemonSets,omitempty"`
+	Ingresses             []networkingv1.Ingress         `json:"ingresses,omitempty"`
+	ServiceAccounts       []corev1.ServiceAccount        `json:"serviceAccounts,omitempty"`
+	Jobs                  []batchv1.Job                  `json:"jobs,omitempty"`
+	CronJobs              []batchv1.CronJob              `json:"cronJobs,omitempty"`
+	SecretProviderClasses []unstructured.Unstructured    `json:"secretProviderClasses,omitempty"`
+	ExternalSecrets       []unstructured.Unstructured    `json:"externalSecrets,omitempty"`
+
+	// Cluster-scoped objects that reference Secrets; only the fields korp reads are kept
+	PersistentVolumes               []corev1.PersistentVolume                                `json:"persistentVolumes,omitempty"`
+	MutatingWebhookConfigurations   []admissionregistrationv1.MutatingWebhookConfiguration   `json:"mutatingWebhookConfigurations,omitempty"`
+	ValidatingWebhookConfigurations []admissionregistrationv1.ValidatingWebhookConfiguration `json:"validatingWebhookConfigurations,omitempty"`
+	APIServices                     []unstructured.Unstructured                              `json:"apiServices,omitempty"`
+	CustomResourceDefinitions       []unstructured.Unstructured                              `json:"customResourceDefinitions,omitempty"`
+}
+
+// stripMeta drops fields that are not needed for analysis and may leak data
+func stripMeta(meta *metav1.ObjectMeta) {
+	meta.ManagedFields = nil
+	delete(meta.Annotations, lastAppliedAnnotation)
+}
+
+// redactPodSpec drops literal environment variable values, which often hold credentials.
+// References through valueFrom are kept, since they are what the scan reads.
+func redactPodSpec(spec *corev1.PodSpec) {
+	for i := range spec.InitContainers {
+		redactEnv(spec.InitContainers[i].Env)
+	}
+	for i := range spec.Containers {
+		redactEnv(spec.Containers[i].Env)
+	}
+	for i := range spec.EphemeralContainers {
+		redactEnv(spec.EphemeralContainers[i].Env)
+	}
+}
+
+// redactEnv clears the literal value of each environment variable
+func redactEnv(env []corev1.EnvVar) {
+	for i := range env {
+		env[i].Value = ""
+	}
+}
+
+// metadataOnly returns the type, name and annotations of a custom resource, without
+// last-applied-configuration
+func metadataOnly(item unstructured.Unstructured) unstructured.Unstructured {
+	out := unstructured.Unstructured{}
+	out.SetAPIVersion(item.GetAPIVersion())
+	out.SetKind(item.GetKind())
+	out.SetName(item.GetName())
+	if annotations := item.GetAnnotations(); len(annotations) > 0 {
+		delete(annotations, lastAppliedAnnotation)
+		out.SetAnnotations(annotations)
+	}
+	return out
+}
+
+// takeSnapshot lists the resources used by the CLI scan
+func takeSnapshot(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, ns string) (*clusterSnapshot, error) {
+	snap := &clusterSnapshot{Version: snapshotVersion, CreatedAt: time.Now().UTC(), Namespace: ns}
+
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods: %w", err)
+	}
+	for _, item := range pods.Items {
+		stripMeta(&item.ObjectMeta)
+		redactPodSpec(&item.Spec)
+		snap.Pods = append(snap.Pods, item)
+	}
+
+	cms, err := client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing configmaps: %w", err)
+	}
+	for _, item := range cms.Items {
+		stripMeta(&item.ObjectMeta)
+		item.Data = nil
+		item.BinaryData = nil
+		snap.ConfigMaps = append(snap.ConfigMaps, item)
+	}
+
+	secrets, err := client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing secrets: %w", err)
+	}
+	for _, item := range secrets.Items {
+		stripMeta(&item.ObjectMeta)
+		item.Data = nil
+		item.StringData = nil
+		snap.Secrets = append(snap.Secrets, item)
+	}
+
+	svcs, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+	for _, item := range svcs.Items {
+		stripMeta(&item.ObjectMeta)
+		snap.Services = append(snap.Services, item)
+	}
+
+	pvcs, err := client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing pvcs: %w", err)
+	}
+	for _, item := range pvcs.Items {
+		stripMeta(&item.ObjectMeta)
+		snap.PVCs = append(snap.PVCs, item)
+	}
+
+	endpoints, err := client.CoreV1().Endpoints(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing endpoints: %w", err)
+	}
+	for _, item := range endpoints.Items {
+		stripMeta(&item.ObjectMeta)
+		snap.Endpoints = append(snap.Endpoints, item)
+	}
+
+	endpointSlices, err := client.DiscoveryV1().EndpointSlices(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing endpointslices: %w", err)
+	}
+	for _, item := range endpointSlices.Items {
+		stripMeta(&item.ObjectMeta)
+		snap.EndpointSlices = append(snap.EndpointSlices, item)
+	}
+
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing deployments: %w", err)
+	}
+	for _, item := range deployments.Items {
+		stripMeta(&item.ObjectMeta)
+		redactPodSpec(&item.Spec.Template.Spec)
+		snap.Deployments = append(snap.Deployments, item)
+	}
+
+	statefulsets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing statefulsets: %w", err)
+	}
+	for _, item := range statefulsets.Items {
+		stripMeta(&item.ObjectMeta)
+		redactPodSpec(&item.Spec.Template.Spec)
+		snap.StatefulSets = append(snap.StatefulSets, item)
+	}
+
+	daemonsets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing daemonsets: %w", err)
+	}
+	for _, item := range daemonsets.Items {
+		stripMeta(&item.ObjectMeta)
+		redactPodSpec(&item.Spec.Template.Spec)
+		snap.DaemonSets = append(snap.DaemonSets, item)
+	}
+
+	ingresses, err := client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing ingresses: %w", err)
+	}
+	for _, item := range ingresses.Items {
+		stripMeta(&item.ObjectMeta)
+		snap.Ingresses = append(snap.Ingresses, item)
+	}
+
+	serviceAccounts, err := client.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing serviceaccounts: %w", err)
+	}
+	for _, item := range serviceAccounts.Items {
+		stripMeta(&item.ObjectMeta)
+		snap.ServiceAccounts = append(snap.ServiceAccounts, item)
+	}
+
+	jobs, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing jobs: %w", err)
+	}
+	for _, item := range jobs.Items {
+		stripMeta(&item.ObjectMeta)
+		redactPodSpec(&item.Spec.Template.Spec)
+		snap.Jobs = append(snap.Jobs, item)
+	}
+
+	cronJobs, err := client.BatchV1().CronJobs(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing cronjobs: %w", err)
+	}
+	for _, item := range cronJobs.Items {
+		stripMeta(&item.ObjectMeta)
+		redactPodSpec(&item.Spec.JobTemplate.Spec.Template.Spec)
+		snap.CronJobs = append(snap.CronJobs, item)
+	}
+
+	spcs, err := k8sutil.ListOptional(ctx, dynamicClient, k8sutil.SecretProviderClassGVR, ns)
+	if err != nil {
+		return nil, fmt.Errorf("listing secretproviderclasses: %w", err)
+	}
+	for _, item := range spcs {
+		unstructured.RemoveNestedField(item.Object, "metadata", "managedFields")
+		unstructured.RemoveNestedField(item.Object, "metadata", "annotations", lastAppliedAnnotation)
+		snap.SecretProviderClasses = append(snap.SecretProviderClasses, item)
+	}
+
+	externalSecrets, err := k8sutil.ListOptional(ctx, dynamicClient, k8sutil.NewAPIVersions(client.Discovery()).GVR("ExternalSecret"), ns)
+	if err != nil {
+		return nil, fmt.Errorf("listing externalsecrets: %w", err)
+	}
+	for _, item := range externalSecrets {
+		// The fields korp reads are the same in every version, so the snapshot serves them in one
+		item.SetAPIVersion(k8sutil.ExternalSecretGVR.GroupVersion().String())
+		unstructured.RemoveNestedField(item.Object, "metadata", "managedFields")
+		unstructured.RemoveNestedField(item.Object, "metadata", "annotations", lastAppliedAnnotation)
+		snap.ExternalSecrets = append(snap.ExternalSecrets, item)
+	}
+
+	if err := takeClusterReferences(ctx, client, dynamicClient, snap); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+// takeClusterReferences captures the cluster-scoped objects that reference Secrets in any namespace.
+// Kinds the caller may not list are left out with a warning, as a scan would report them as blind spots.
+func takeClusterReferences(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, snap *clusterSnapshot) error {
+	skip := func(kind string, err error) error {
+		if apierrors.IsForbidden(err) {
+			fmt.Fprintf(os.Stderr, "warning: %s not captured: %v\n", kind, err)
+			return nil
+		}
+		return fmt.Errorf("listing %s: %w", kind, err)
+	}
+
+	pvs, err := client.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if err := skip("persistentvolumes", err); err != nil {
+			return err
+		}
+	} else {
+		for _, item := range pvs.Items {
+			stripMeta(&item.ObjectMeta)
+			snap.PersistentVolumes = append(snap.PersistentVolumes, item)
+		}
+	}
+
+	mutating, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if err := skip("mutatingwebhookconfigurations", err); err != nil {
+			return err
+		}
+	} else {
+		for _, item := range mutating.Items {
+			stripMeta(&item.ObjectMeta)
+			snap.MutatingWebhookConfigurations = append(snap.MutatingWebhookConfigurations,
+				admissionregistrationv1.MutatingWebhookConfiguration{ObjectMeta: item.ObjectMeta})
+		}
+	}
+
+	validating, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if err := skip("validatingwebhookconfigurations", err); err != nil {
+			return err
+		}
+	} else {
+		for _, item := range validating.Items {
+			stripMeta(&item.ObjectMeta)
+			snap.ValidatingWebhookConfigurations = append(snap.ValidatingWebhookConfigurations,
+				admissionregistrationv1.ValidatingWebhookConfiguration{ObjectMeta: item.ObjectMeta})
+		}
+	}
+
+	apiServices, err := k8sutil.ListOptional(ctx, dynamicClient, k8sutil.APIServiceGVR, "")
+	if err != nil {
+		if err := skip("apiservices", err); err != nil {
+			return err
+		}
+	}
+	for _, item := range apiServices {
+		snap.APIServices = append(snap.APIServices, metadataOnly(item))
+	}
+
+	crds, err := k8sutil.ListOptional(ctx, dynamicClient, k8sutil.CustomResourceDefinitionGVR, "")
+	if err != nil {
+		if err := skip("customresourcedefinitions", err); err != nil {
+			return err
+		}
+	}
+	for _, item := range crds {
+		snap.CustomResourceDefinitions = append(snap.CustomResourceDefinitions, metadataOnly(item))
+	}
+	return nil
+}
+
+// clients returns in-memory clients serving the snapshot contents
+func (s *clusterSnapshot) clients() (kubernetes.Interface, dynamic.Interface) {
+	var objects []runtime.Object
+	for i := range s.Pods {
+		objects = append(objects, &s.Pods[i])
+	}
+	for i := range s.ConfigMaps {
+		objects = append(objects, &s.ConfigMaps[i])
+	}
+	for i := range s.Secrets {
+		objects = append(objects, &s.Secrets[i])
+	}
+	for i := range s.Services {
+		objects = append(objects, &s.Services[i])
+	}
+	for i := range s.PVCs {
+		objects = append(objects, &s.PVCs[i])
+	}
+	for i := range s.Endpoints {
+		objects = append(objects, &s.Endpoints[i])
+	}
+	for i := range s.EndpointSlices {
+		objects = append(objects, &s.EndpointSlices[i])
+	}
+	for i := range s.Deployments {
+		objects = append(objects, &s.Deployments[i])
+	}
+	for i := range s.StatefulSets {
+		objects = append(objects, &s.StatefulSets[i])
+	}
+	for i := range s.DaemonSets {
+		objects = append(objects, &s.DaemonSets[i])
+	}
+	for i := range s.Ingresses {
+		objects = append(objects, &s.Ingresses[i])
+	}
+	for i := range s.ServiceAccounts {
+		objects = append(objects, &s.ServiceAccounts[i])
+	}
+	for i := range s.Jobs {
+		objects = append(objects, &s.Jobs[i])
+	}
+	for i := range s.CronJobs {
+		objects = append(objects, &s.CronJobs[i])
+	}
+	for i := range s.PersistentVolumes {
+		objects = append(objects, &s.PersistentVolumes[i])
+	}
+	for i := range s.MutatingWebhookConfigurations {
+		objects = append(objects, &s.MutatingWebhookConfigurations[i])
+	}
+	for i := range s.ValidatingWebhookConfigurations {
+		objects = append(objects, &s.ValidatingWebhookConfigurations[i])
+	}
+
+	var dynamicObjects []runtime.Object
+	for i := range s.SecretProviderClasses {
+		dynamicObjects = append(dynamicObjects, &s.SecretProviderClasses[i])
+	}
+	for i := range s.ExternalSecrets {
+		dynamicObjects = append(dynamicObjects, &s.ExternalSecrets[i])
+	}
+	for i := range s.APIServices {
+		dynamicObjects = append(dynamicObjects, &s.APIServices[i])
+	}
+	for i := range s.CustomResourceDefinitions {
+		dynamicObjects = append(dynamicObjects, &s.CustomResourceDefinitions[i])
+	}
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		k8sutil.SecretProviderClassGVR:      "SecretProviderClassList",
+		k8sutil.ExternalSecretGVR:           "ExternalSecretList",
+		k8sutil.APIServiceGVR:               "APIServiceList",
+		k8sutil.CustomResourceDefinitionGVR: "CustomResourceDefinitionList",
+	}, dynamicObjects...)
+
+	return fake.NewClientset(objects...), dynamicClient
+}
+
+// writeSnapshot writes the snapshot as a gzipped tar archive
+func writeSnapshot(path string, snap *clusterSnapshot) error {
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating snapshot: %w", err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    snapshotFile,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: snap.CreatedAt,
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// readSnapshot loads a snapshot archive written by writeSnapshot
+func readSnapshot(path string) (*clusterSnapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening snapshot: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot %s: %w", path, err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("snapshot %s does not contain %s", path, snapshotFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading snapshot %s: %w", path, err)
+		}
+		if hdr.Name != snapshotFile {
+			continue
+		}
+
+		snap := &clusterSnapshot{}
+		if err := json.NewDecoder(tr).Decode(snap); err != nil {
+			return nil, fmt.Errorf("parsing snapshot %s: %w", path, err)
+		}
+		if snap.Version == 1 {
+			snap.EndpointSlices = endpointSlicesFromEndpoints(snap.Endpoints)
+			snap.Version = 2
+		}
+		if snap.Version == 2 {
+			snap.Version = snapshotVersion
+		}
+		if snap.Version != snapshotVersion {
+			return nil, fmt.Errorf("snapshot %s has unsupported version %d", path, snap.Version)
+		}
+		return snap, nil
+	}
+}
+
+// endpointSlicesFromEndpoints converts the Endpoints of a version 1 snapshot into one EndpointSlice per
+// service, which is all ServicesWithoutEndpoints needs
+func endpointSlicesFromEndpoints(endpoints []corev1.Endpoints) []discoveryv1.EndpointSlice {
+	slices := make([]discoveryv1.EndpointSlice, 0, len(endpoints))
+	for _, ep := range endpoints {
+		slice := discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ep.Name,
+				Namespace: ep.Namespace,
+				Labels:    map[string]string{discoveryv1.LabelServiceName: ep.Name},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+		}
+		for _, subset := range ep.Subsets {
+			for _, addr := range subset.Addresses {
+				slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{Addresses: []string{addr.IP}})
+			}
+			for _, addr := range subset.NotReadyAddresses {
+				slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{Addresses: []string{addr.IP}})
+			}
+		}
+		slices = append(slices, slice)
+	}
+	return slices
+}
+
+// runSnapshot implements `korp snapshot`
+func runSnapshot(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" snapshot", flag.ContinueOnError)
+	namespace := fs.String("namespace", "", "namespace to snapshot (default: all namespaces)")
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	output := fs.String("o", "korp-snapshot.tar.gz", "path of the snapshot archive to write")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, dynamicClient, err := buildClient(kube)
+	if err != nil {
+		return fmt.Errorf("building kube client: %w", err)
+	}
+
+	snap, err := takeSnapshot(context.TODO(), client, dynamicClient, *namespace)
+	if err != nil {
+		return err
+	}
+	if err := writeSnapshot(*output, snap); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Snapshot written to %s\n", *output)
+	return nil
+}
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// storeVersion is the on-disk format version of the finding store
+const storeVersion = 1
+
+// storeRetentionRuns is how many runs a finding may go unseen before it is dropped from the store
+const storeRetentionRuns = 30
+
+// storedFinding tracks a single finding across CLI invocations
+type storedFinding struct {
+	ResourceType string     `json:"resourceType"`
+	Namespace    string     `json:"namespace"`
+	Name         string     `json:"name"`
+	FirstSeen    time.Time  `json:"firstSeen"`
+	LastSeen     time.Time  `json:"lastSeen"`
+	ResolvedAt   *time.Time `json:"resolvedAt,omitempty"`
+
+	// LastSeenRun is the run that last reported the finding
+	LastSeenRun int `json:"lastSeenRun,omitempty"`
+}
+
+// findingStore is a local state file that records findings across CLI runs,
+// so users running the CLI from cron get first-seen ages and resolved tracking
+type findingStore struct {
+	path     string
+	Version  int                       `json:"version"`
+	LastRun  time.Time                 `json:"lastRun"`
+	Findings map[string]*storedFinding `json:"findings"`
+
+	// Runs counts the runs recorded in the store
+	Runs int `json:"runs,omitempty"`
+}
+
+// storeDiff is the change in findings since the previous run
+type storeDiff struct {
+	New      []string
+	Resolved []string
+}
+
+// openStore loads the store at path, creating an empty one if it does not exist
+func openStore(path string) (*findingStore, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("resolving home directory: %w", err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	store := &findingStore{path: path, Version: storeVersion, Findings: map[string]*storedFinding{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading store %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, fmt.Errorf("parsing store %s: %w", path, err)
+	}
+	if store.Version != storeVersion {
+		return nil, fmt.Errorf("store %s has unsupported version %d", path, store.Version)
+	}
+	if store.Findings == nil {
+		store.Findings = map[string]*storedFinding{}
+	}
+	return store, nil
+}
+
+// storeKey identifies a finding in the store
+func storeKey(resourceType, namespace, name string) string {
+	return resourceType + "/" + namespace + "/" + name
+}
+
+// record merges the current findings into the store and returns what changed.
+// Findings are grouped by resource type and scoped to the scanned namespace; scans of all
+// namespaces name them "namespace/name". Only resource types present in current can be resolved.
+// Findings no run has reported for storeRetentionRuns runs are dropped.
+func (s *findingStore) record(namespace string, current map[string][]string, now time.Time) storeDiff {
+	var diff storeDiff
+	seen := make(map[string]bool)
+	s.Runs++
+
+	for resourceType, names := range current {
+		for _, name := range names {
+			ns, bare := splitName(namespace, name)
+			key := storeKey(resourceType, ns, bare)
+			seen[key] = true
+			f, ok := s.Findings[key]
+			if !ok || f.ResolvedAt != nil {
+				f = &storedFinding{ResourceType: resourceType, Namespace: ns, Name: bare, FirstSeen: now}
+				s.Findings[key] = f
+				diff.New = append(diff.New, resourceType+"/"+name)
+			}
+			f.LastSeen = now
+			f.LastSeenRun = s.Runs
+		}
+	}
+
+	for key, f := range s.Findings {
+		if s.Runs-f.LastSeenRun > storeRetentionRuns {
+			delete(s.Findings, key)
+			continue
+		}
+		if (namespace != metav1.NamespaceAll && f.Namespace != namespace) || f.ResolvedAt != nil || seen[key] {
+			continue
+		}
+		if _, scanned := current[f.ResourceType]; !scanned {
+			continue
+		}
+		resolvedAt := now
+		f.ResolvedAt = &resolvedAt
+		name := f.Name
+		if namespace == metav1.NamespaceAll {
+			name = f.Namespace + "/" + f.Name
+		}
+		diff.Resolved = append(diff.Resolved, f.ResourceType+"/"+name)
+	}
+
+	sort.Strings(diff.New)
+	sort.Strings(diff.Resolved)
+	s.LastRun = now
+	return diff
+}
+
+// firstSeen returns when a finding was first recorded
+func (s *findingStore) firstSeen(resourceType, namespace, name string) (time.Time, bool) {
+	f, ok := s.Findings[storeKey(resourceType, namespace, name)]
+	if !ok {
+		return time.Time{}, false
+	}
+	return f.FirstSeen, true
+}
+
+// save writes the store atomically
+func (s *findingStore) save() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("creating store directory: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("writing store: %w", err)
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// formatAge renders a duration in days/hours for table output
+func formatAge(d time.Duration) string {
+	if d >= 24*time.Hour {
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+	if d >= time.Hour {
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
@@ -0,0 +1,223 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// korpScanGVR is the API resource of KorpScans
+var korpScanGVR = korpv1alpha1.GroupVersion.WithResource("korpscans")
+
+// korpCRDs are the CustomResourceDefinitions korp installs
+var korpCRDs = []string{"korpscans.korp.io", "korppolicies.korp.io", "korpreports.korp.io"}
+
+// korpEventComponent is the event source component of events created by the operator
+const korpEventComponent = "korp"
+
+// uninstaller removes korp's own artifacts from a cluster, or only reports them in dry-run mode
+type uninstaller struct {
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+	dryRun  bool
+	removed int
+}
+
+// runUninstall implements `korp uninstall [--crds] [--dry-run]`
+func runUninstall(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" uninstall", flag.ContinueOnError)
+	kube := addKubeFlags(fs, "path to kubeconfig")
+	crds := fs.Bool("crds", false, "also delete korp's CustomResourceDefinitions")
+	dryRun := fs.Bool("dry-run", false, "print what would be removed without changing the cluster")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	client, dynamicClient, err := buildClient(kube)
+	if err != nil {
+		return err
+	}
+	u := &uninstaller{client: client, dynamic: dynamicClient, dryRun: *dryRun}
+	ctx := context.TODO()
+
+	// KorpScans go first so a running operator stops marking resources
+	if err := u.deleteAll(ctx, korpScanGVR, metav1.ListOptions{}); err != nil {
+		return fmt.Errorf("deleting KorpScans: %w", err)
+	}
+	if err := u.unmarkResources(ctx); err != nil {
+		return err
+	}
+	if err := u.unannotateNamespaces(ctx); err != nil {
+		return err
+	}
+	if err := u.deleteEvents(ctx); err != nil {
+		return err
+	}
+	if err := u.deleteCronInstall(ctx); err != nil {
+		return err
+	}
+	if *crds {
+		for _, name := range korpCRDs {
+			if err := u.delete(ctx, k8sutil.CustomResourceDefinitionGVR, "", name); err != nil {
+				return fmt.Errorf("deleting CRD %s: %w", name, err)
+			}
+		}
+	}
+
+	verb := "Removed"
+	if u.dryRun {
+		verb = "Would remove"
+	}
+	fmt.Printf("%s %d korp artifacts\n", verb, u.removed)
+	return nil
+}
+
+// report prints one artifact and counts it
+func (u *uninstaller) report(action, kind, ns, name string) {
+	if u.dryRun {
+		action = "would " + action
+	}
+	if ns != "" {
+		name = ns + "/" + name
+	}
+	fmt.Printf("%s %s %s\n", action, kind, name)
+	u.removed++
+}
+
+// unmarkResources removes korp's annotations and orphaned label from every resource type korp reports
+func (u *uninstaller) unmarkResources(ctx context.Context) error {
+	kinds := make([]string, 0, len(k8sutil.ResourceGVRs))
+	for kind := range k8sutil.ResourceGVRs {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		list, err := u.dynamic.Resource(k8sutil.ResourceGVRs[kind]).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) {
+			// The cluster does not serve this type
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", kind, err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if !k8sutil.MarkedOrphaned(obj) {
+				continue
+			}
+			if !u.dryRun {
+				if _, err := k8sutil.UnmarkOrphaned(ctx, u.dynamic, kind, obj.GetNamespace(), obj.GetName()); err != nil {
+					return fmt.Errorf("unmarking %s %s/%s: %w", kind, obj.GetNamespace(), obj.GetName(), err)
+				}
+			}
+			u.report("unmarked", kind, obj.GetNamespace(), obj.GetName())
+		}
+	}
+	return nil
+}
+
+// unannotateNamespaces removes the summary annotations written by reporting.annotateNamespaces
+func (u *uninstaller) unannotateNamespaces(ctx context.Context) error {
+	namespaces, err := u.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing namespaces: %w", err)
+	}
+	for _, ns := range namespaces.Items {
+		_, count := ns.Annotations[k8sutil.OrphanCountAnnotation]
+		_, lastScan := ns.Annotations[k8sutil.LastScanAnnotation]
+		_, scannedBy := ns.Annotations[k8sutil.ScannedByAnnotation]
+		if !count && !lastScan && !scannedBy {
+			continue
+		}
+		if !u.dryRun {
+			if _, err := k8sutil.UnannotateNamespace(ctx, u.dynamic, ns.Name); err != nil {
+				return fmt.Errorf("unannotating namespace %s: %w", ns.Name, err)
+			}
+		}
+		u.report("unannotated", "Namespace", "", ns.Name)
+	}
+	return nil
+}
+
+// deleteEvents deletes events created by the operator
+func (u *uninstaller) deleteEvents(ctx context.Context) error {
+	events, err := u.client.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing events: %w", err)
+	}
+	for _, event := range events.Items {
+		if event.Source.Component != korpEventComponent && event.ReportingController != korpEventComponent {
+			continue
+		}
+		if !u.dryRun {
+			err := u.client.CoreV1().Events(event.Namespace).Delete(ctx, event.Name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("deleting event %s/%s: %w", event.Namespace, event.Name, err)
+			}
+		}
+		u.report("deleted", "Event", event.Namespace, event.Name)
+	}
+	return nil
+}
+
+// deleteCronInstall deletes the objects created by `korp install-cron`
+func (u *uninstaller) deleteCronInstall(ctx context.Context) error {
+	selector := metav1.ListOptions{LabelSelector: "app.kubernetes.io/managed-by=" + cronFieldManager}
+	for _, gvr := range []schema.GroupVersionResource{
+		k8sutil.ResourceGVRs["CronJob"],
+		k8sutil.ResourceGVRs["ServiceAccount"],
+		k8sutil.ResourceGVRs["ClusterRoleBinding"],
+		k8sutil.ResourceGVRs["ClusterRole"],
+	} {
+		if err := u.deleteAll(ctx, gvr, selector); err != nil {
+			return fmt.Errorf("deleting install-cron %s: %w", gvr.Resource, err)
+		}
+	}
+	return nil
+}
+
+// deleteAll deletes every object of a resource in all namespaces that matches opts.
+// A resource the cluster does not serve has nothing to delete.
+func (u *uninstaller) deleteAll(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) error {
+	list, err := u.dynamic.Resource(gvr).List(ctx, opts)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, obj := range list.Items {
+		if err := u.delete(ctx, gvr, obj.GetNamespace(), obj.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delete deletes one object, treating an already deleted object as success
+func (u *uninstaller) delete(ctx context.Context, gvr schema.GroupVersionResource, ns, name string) error {
+	if !u.dryRun {
+		err := u.dynamic.Resource(gvr).Namespace(ns).Delete(ctx, name, metav1.DeleteOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	u.report("deleted", gvr.Resource, ns, name)
+	return nil
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+// uninstallAudit reports resources left behind by uninstalled Helm releases and OLM operators
+func uninstallAudit(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, ns, output string) error {
+	tracker := k8sutil.NewAppTracker(client, dynamicClient)
+
+	leftovers, err := tracker.LeftoverResources(ctx, ns)
+	if err != nil {
+		return fmt.Errorf("finding leftover resources: %w", err)
+	}
+	if ns == metav1.NamespaceAll {
+		clusterLeftovers, err := tracker.ClusterLeftoverResources(ctx)
+		if err != nil {
+			return fmt.Errorf("finding leftover cluster resources: %w", err)
+		}
+		leftovers = append(leftovers, clusterLeftovers...)
+	}
+
+	byApp := make(map[string][]string)
+	for _, l := range leftovers {
+		byApp[l.App] = append(byApp[l.App], l.Kind+"/"+l.Namespace+"/"+l.Name)
+	}
+
+	res := report.ScanResult{SchemaVersion: report.SchemaVersion, Namespace: ns}
+	for app, resources := range byApp {
+		sort.Strings(resources)
+		res.LeftBehind = append(res.LeftBehind, report.LeftBehindGroup{App: app, Resources: resources})
+	}
+	sort.Slice(res.LeftBehind, func(i, j int) bool { return res.LeftBehind[i].App < res.LeftBehind[j].App })
+
+	switch output {
+	case "json":
+		b, _ := json.MarshalIndent(res, "", "  ")
+		fmt.Println(string(b))
+	default:
+		fmt.Println("================================================================================")
+		fmt.Println("KORP UNINSTALL AUDIT")
+		fmt.Println("================================================================================")
+		if len(res.LeftBehind) == 0 {
+			fmt.Println("\nNo resources left behind by uninstalled Helm releases or OLM operators")
+		}
+		for _, group := range res.LeftBehind {
+			fmt.Printf("\nLeft behind by %s: %d resource(s)\n", group.App, len(group.Resources))
+			for i, r := range group.Resources {
+				fmt.Printf("   %d. %s\n", i+1, r)
+			}
+		}
+		fmt.Println("\n================================================================================")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"runtime"
+
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+// version is the CLI version, set at build time with -ldflags "-X github.com/kamilbabayev/korp/internal/app.version=v1.2.3"
+var version = "dev"
+
+// versionInfo is the output of `korp version -o json`
+type versionInfo struct {
+	Version       string `json:"version"`
+	SchemaVersion string `json:"schemaVersion"`
+	GoVersion     string `json:"goVersion"`
+	Platform      string `json:"platform"`
+}
+
+// runVersion implements `korp version`
+func runVersion(args []string) error {
+	fs := flag.NewFlagSet(commandName()+" version", flag.ContinueOnError)
+	output := fs.String("output", "", "output format: json")
+	fs.StringVar(output, "o", "", "shorthand for --output")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	info := versionInfo{
+		Version:       version,
+		SchemaVersion: report.SchemaVersion,
+		GoVersion:     runtime.Version(),
+		Platform:      runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	switch *output {
+	case "json":
+		b, _ := json.MarshalIndent(info, "", "  ")
+		fmt.Println(string(b))
+	case "":
+		fmt.Printf("korp %s (result schema %s, %s, %s)\n", info.Version, info.SchemaVersion, info.GoVersion, info.Platform)
+	default:
+		return fmt.Errorf("unknown output format %q (use json)", *output)
+	}
+	return nil
+}
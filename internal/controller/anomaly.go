@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/notifier"
+)
+
+// remediated returns how many resources a cleanup run deleted, scaled to zero, patched or labeled
+func remediated(summary *korpv1alpha1.CleanupSummary) int {
+	if summary == nil {
+		return 0
+	}
+	return summary.TotalDeleted + summary.TotalScaledToZero + summary.TotalPatched + summary.TotalLabeled
+}
+
+// lastRemediated returns how many resources the last recorded cleanup run remediated
+func lastRemediated(status *korpv1alpha1.CleanupStatus) int {
+	if status == nil {
+		return 0
+	}
+	return remediated(status.Summary)
+}
+
+// cleanupHalted reports whether an anomaly halted the KorpScan's cleanup and no one resumed it yet
+func cleanupHalted(korpScan *korpv1alpha1.KorpScan) bool {
+	anomaly := korpScan.Status.LastCleanupAnomaly
+	return anomaly != nil && anomaly.ResumedAt == nil
+}
+
+// cleanupAnomaly returns the anomaly of a cleanup run that remediated more than threshold allows,
+// given what the run before remediated, or nil if it stayed within threshold
+func cleanupAnomaly(threshold *korpv1alpha1.AnomalyThreshold, deleted, previous int) *korpv1alpha1.CleanupAnomaly {
+	if threshold == nil || deleted == 0 {
+		return nil
+	}
+
+	var message string
+	switch {
+	case threshold.MaxDeletions > 0 && deleted > threshold.MaxDeletions:
+		message = fmt.Sprintf("remediated %d resources, more than maxDeletions %d", deleted, threshold.MaxDeletions)
+	case threshold.MaxIncreasePercent > 0 && previous > 0 && deleted*100 > previous*(100+threshold.MaxIncreasePercent):
+		message = fmt.Sprintf("remediated %d resources, %d%% more than the %d of the previous run (maxIncreasePercent %d)",
+			deleted, (deleted-previous)*100/previous, previous, threshold.MaxIncreasePercent)
+	default:
+		return nil
+	}
+
+	return &korpv1alpha1.CleanupAnomaly{
+		DetectedAt:      metav1.Now(),
+		Deleted:         deleted,
+		PreviousDeleted: previous,
+		Message:         message,
+	}
+}
+
+// haltCleanup records anomaly in status, which keeps the KorpScan's cleanup in dry run until a human
+// resumes it with the korp.io/resume-cleanup annotation. The spec is left alone, so GitOps cannot undo the halt.
+func (r *KorpScanReconciler) haltCleanup(korpScan *korpv1alpha1.KorpScan, anomaly *korpv1alpha1.CleanupAnomaly) {
+	korpScan.Status.LastCleanupAnomaly = anomaly
+	r.Reporter.CreateEvent(korpScan, "Warning", "CleanupAnomaly",
+		fmt.Sprintf("Cleanup %s; switched to dry run until the KorpScan is annotated %s", anomaly.Message,
+			korpv1alpha1.ResumeCleanupAnnotation))
+}
+
+// resumeCleanup lifts a halt recorded by haltCleanup and removes the korp.io/resume-cleanup annotation
+func (r *KorpScanReconciler) resumeCleanup(ctx context.Context, korpScan *korpv1alpha1.KorpScan) error {
+	if cleanupHalted(korpScan) {
+		now := metav1.Now()
+		korpScan.Status.LastCleanupAnomaly.ResumedAt = &now
+		if err := r.applyStatus(ctx, korpScan); err != nil {
+			return err
+		}
+		r.Reporter.CreateEvent(korpScan, "Normal", "CleanupResumed", "Cleanup resumed after the anomaly of "+
+			korpScan.Status.LastCleanupAnomaly.DetectedAt.UTC().Format(time.RFC3339))
+	}
+
+	// The patch response carries the stored status, which lags behind the one being built
+	status := korpScan.Status.DeepCopy()
+	patch := client.MergeFrom(korpScan.DeepCopy())
+	delete(korpScan.Annotations, korpv1alpha1.ResumeCleanupAnnotation)
+	err := r.Patch(ctx, korpScan, patch)
+	korpScan.Status = *status
+	return err
+}
+
+// sendAnomalyAlert sends the recorded cleanup anomaly through every configured notification channel,
+// regardless of notifyOnlyNew
+func (r *KorpScanReconciler) sendAnomalyAlert(ctx context.Context, korpScan *korpv1alpha1.KorpScan, payload notifier.WebhookPayload) {
+	log := log.FromContext(ctx)
+	alert := notifier.AnomalyPayload(payload, *korpScan.Status.LastCleanupAnomaly)
+
+	specs := notifier.Specs(korpScan.Spec.Reporting)
+	for i, err := range notifier.FanOut(ctx, r.Clientset, korpScan.Namespace, specs, alert, log) {
+		if err != nil {
+			log.Error(err, "Failed to send cleanup anomaly", "type", specs[i].Type, "notifier", specs[i].Name)
+			reason, message := deliveryFailure(specs[i], true, err)
+			r.Reporter.CreateEvent(korpScan, "Warning", reason, message)
+		}
+	}
+}
@@ -9,6 +9,9 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -16,17 +19,37 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/backup"
 	"github.com/kamilbabayev/korp/pkg/cleanup"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/metrics"
 	"github.com/kamilbabayev/korp/pkg/notifier"
+	"github.com/kamilbabayev/korp/pkg/policy"
+	"github.com/kamilbabayev/korp/pkg/report"
 	"github.com/kamilbabayev/korp/pkg/reporter"
 	"github.com/kamilbabayev/korp/pkg/scan"
 )
 
+// progressUpdateInterval is the minimum time between scan progress status updates
+const progressUpdateInterval = 10 * time.Second
+
+// maxSkippedNamespacesInHistory caps the namespace names kept in a partial history entry
+const maxSkippedNamespacesInHistory = 20
+
+// maxFindingChangesInStatus caps the new and resolved findings listed in status
+const maxFindingChangesInStatus = 100
+
+// maxApprovalsInStatus caps the cleanup candidates listed in status.cleanupStatus.approvals
+const maxApprovalsInStatus = 100
+
 // KorpScanReconciler reconciles a KorpScan object
 type KorpScanReconciler struct {
 	client.Client
@@ -35,248 +58,727 @@ type KorpScanReconciler struct {
 	Scanner   *scan.Scanner
 	Reporter  *reporter.EventReporter
 	Cleaner   *cleanup.Cleaner
+
+	// Metadata watches the metadata of the Secrets and ConfigMaps watch-mode scans read
+	Metadata metadata.Interface
+
+	// requeueAt holds the time each KorpScan asked to be reconciled again, keyed by NamespacedName
+	requeueAt sync.Map
+
+	// informers serve the Lists of watch-mode scans and report changes to changes
+	informers *k8sutil.Informers
+	changes   *changeTracker
 }
 
 // +kubebuilder:rbac:groups=korp.io,resources=korpscans,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=korp.io,resources=korpscans/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=korp.io,resources=korpscans/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;delete
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list
+// +kubebuilder:rbac:groups=korp.io,resources=korppolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=korp.io,resources=korpreports,verbs=get;list;create;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups="",resources=limitranges,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
-// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;delete
-// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;delete
-// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;delete
-// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;delete
-// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;delete
-// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;delete
-// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;delete
-// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;delete
-// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;delete
-// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;delete
-// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;delete
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=selfsubjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=secrets-store.csi.x-k8s.io,resources=secretproviderclasses,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups=external-secrets.io,resources=externalsecrets,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=prioritylevelconfigurations,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;patch;delete
+// +kubebuilder:rbac:groups=apiregistration.k8s.io,resources=apiservices,verbs=list
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=list
+// +kubebuilder:rbac:groups=operators.coreos.com,resources=clusterserviceversions,verbs=get
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheuses;alertmanagers;servicemonitors;podmonitors;probes;alertmanagerconfigs,verbs=list
+// +kubebuilder:rbac:groups=grafana.integreatly.org,resources=grafanadashboards;grafanadatasources,verbs=list
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates;issuers;clusterissuers,verbs=list
+// +kubebuilder:rbac:groups=keda.sh,resources=triggerauthentications;clustertriggerauthentications,verbs=list
 
 // Reconcile is the main reconciliation loop
 func (r *KorpScanReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 
+	// Measure how late requeued reconciles run; earlier reconciles were triggered by watch events
+	if due, ok := r.requeueAt.Load(req.NamespacedName); ok && !time.Now().Before(due.(time.Time)) {
+		metrics.RecordQueueLatency(req.Namespace, req.Name, time.Since(due.(time.Time)))
+		r.requeueAt.Delete(req.NamespacedName)
+	}
+
 	// Fetch the KorpScan resource
 	var korpScan korpv1alpha1.KorpScan
 	if err := r.Get(ctx, req.NamespacedName, &korpScan); err != nil {
 		if errors.IsNotFound(err) {
-			// Resource was deleted, nothing to do
+			// Resource was deleted, drop its metric series
+			r.requeueAt.Delete(req.NamespacedName)
+			r.changes.forget(req.NamespacedName)
+			metrics.Forget(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "Failed to get KorpScan")
 		return ctrl.Result{}, err
 	}
 
+	// Validate notification channels on request without waiting for the next scan
+	if _, ok := korpScan.Annotations[korpv1alpha1.TestNotificationAnnotation]; ok {
+		if err := r.sendTestNotification(ctx, &korpScan); err != nil {
+			log.Error(err, "Failed to clear test notification annotation")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Lift a cleanup halted by an anomaly on request
+	if _, ok := korpScan.Annotations[korpv1alpha1.ResumeCleanupAnnotation]; ok {
+		if err := r.resumeCleanup(ctx, &korpScan); err != nil {
+			log.Error(err, "Failed to resume cleanup")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Determine scan interval
-	interval := time.Duration(korpScan.Spec.IntervalMinutes) * time.Minute
-	if interval == 0 {
-		interval = 60 * time.Minute // Default to 60 minutes
+	interval := korpScan.Spec.ScanInterval()
+
+	// In watch mode, namespaces whose resources changed are rescanned between full scans
+	watching := korpScan.Spec.Watching() && korpScan.Spec.Mode != scan.ModeUninstallAudit
+	if watching {
+		r.changes.watch(req.NamespacedName, korpScan.Spec.TargetNamespace)
+	} else {
+		r.changes.forget(req.NamespacedName)
 	}
 
 	// Check if scan is due
+	var changed []string
 	if korpScan.Status.LastScanTime != nil {
-		nextScan := korpScan.Status.LastScanTime.Add(interval)
+		nextScan := lastFullScanTime(&korpScan).Add(interval)
 		if time.Now().Before(nextScan) {
 			requeueAfter := time.Until(nextScan)
-			log.Info("Scan not due yet", "requeueAfter", requeueAfter)
-			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+			if watching {
+				var wait time.Duration
+				if changed, wait = r.dueRescan(&korpScan); wait > 0 {
+					requeueAfter = min(requeueAfter, wait)
+				}
+			}
+			if changed == nil {
+				log.Info("Scan not due yet", "requeueAfter", requeueAfter)
+				metrics.RecordSkip(korpScan.Namespace, korpScan.Name)
+				return r.requeue(req, requeueAfter), nil
+			}
 		}
 	}
+	if watching && changed == nil {
+		// A full scan covers every change so far
+		r.changes.take(req.NamespacedName)
+	}
 
 	// Update status to Running
+	startTime := time.Now()
+	if korpScan.Status.LastScanTime != nil && changed == nil {
+		metrics.RecordDrift(korpScan.Namespace, korpScan.Name, startTime.Sub(lastFullScanTime(&korpScan).Add(interval)))
+	}
 	korpScan.Status.Phase = "Running"
-	if err := r.Status().Update(ctx, &korpScan); err != nil {
+	korpScan.Status.Progress = &korpv1alpha1.ScanProgress{StartedAt: metav1.Time{Time: startTime}}
+	if err := r.applyStatus(ctx, &korpScan); err != nil {
 		log.Error(err, "Failed to update status to Running")
 		return ctrl.Result{}, err
 	}
 
 	// Perform scan
-	log.Info("Starting scan", "targetNamespace", korpScan.Spec.TargetNamespace)
-	startTime := time.Now()
+	log.Info("Starting scan", "targetNamespace", korpScan.Spec.TargetNamespace, "changedNamespaces", changed)
+
+	// Publish progress at a throttled rate so long scans are observable
+	lastProgressUpdate := startTime
+	progress := func(scanned, total int, current string) {
+		korpScan.Status.Progress.NamespacesScanned = scanned
+		korpScan.Status.Progress.TotalNamespaces = total
+		korpScan.Status.Progress.CurrentNamespace = current
+		if time.Since(lastProgressUpdate) < progressUpdateInterval {
+			return
+		}
+		lastProgressUpdate = time.Now()
+		korpScan.Status.Progress.Elapsed = time.Since(startTime).Round(time.Second).String()
+		if err := r.applyStatus(ctx, &korpScan); err != nil {
+			log.V(1).Info("Failed to update scan progress", "error", err.Error())
+		}
+	}
+
+	scanCtx := ctx
+	if timeout := korpScan.Spec.ScanTimeout; timeout != nil && timeout.Duration > 0 {
+		var cancel context.CancelFunc
+		scanCtx, cancel = context.WithTimeout(ctx, timeout.Duration)
+		defer cancel()
+	}
+
+	// The last scan's findings keep their first detection time and are compared with the new ones
+	previous, err := r.previousFindings(ctx, &korpScan)
+	if err != nil {
+		log.Error(err, "Failed to read the findings of the last scan")
+		return ctrl.Result{}, err
+	}
+
+	// In pipeline mode each namespace is cleaned as soon as it is scanned
+	var pipeline *cleanup.Run
+	var pipelineErr error
+	pipelined := make(map[string]bool)
+	var cleanNamespace scan.NamespaceFunc
+	if spec := korpScan.Spec.Cleanup; spec != nil && spec.Enabled && spec.Mode == cleanup.ModePipeline {
+		detected := scan.NewDetectionTimes(previous)
+		cleanNamespace = func(ns string, findings []korpv1alpha1.Finding, policies *policy.Set) {
+			if pipeline == nil && pipelineErr == nil {
+				pipeline, pipelineErr = r.startCleanup(ctx, &korpScan, policies)
+			}
+			if pipelineErr != nil {
+				return
+			}
+			// Persisting findings keep their first detection time so the age threshold holds
+			detected.Apply(findings)
+			pipeline.Clean(ctx, findings)
+			pipelined[ns] = true
+		}
+	}
 
-	result, err := r.Scanner.Scan(ctx, &korpScan)
+	// Scans with their own API rate limit get clients of their own
+	scanner, err := r.Scanner.ForKorpScan(&korpScan)
+	if err != nil {
+		log.Error(err, "Failed to create rate-limited scan clients")
+		return ctrl.Result{}, err
+	}
+
+	var result *scan.ScanResult
+	if watching {
+		scanner = scanner.WithInformers(r.informers)
+	}
+	if changed != nil {
+		result, err = scanner.Rescan(scanCtx, &korpScan, changed, previous, progress, cleanNamespace)
+	} else {
+		result, err = scanner.ScanPipelined(scanCtx, &korpScan, progress, cleanNamespace)
+	}
+	korpScan.Status.Progress = nil
 	if err != nil {
 		log.Error(err, "Scan failed")
+		if changed != nil {
+			r.changes.restore(req.NamespacedName, changed)
+		}
 		korpScan.Status.Phase = "Failed"
-		r.updateCondition(&korpScan, "Ready", metav1.ConditionFalse, "ScanFailed", err.Error())
-		if statusErr := r.Status().Update(ctx, &korpScan); statusErr != nil {
+		r.updateCondition(&korpScan, "Ready", metav1.ConditionFalse, scanFailureReason(err), err.Error())
+		if result != nil && result.Partial {
+			// Keep a record of the cut-short run; findings stay as of the last complete scan
+			skipped := result.SkippedNamespaces
+			entry := korpv1alpha1.HistoryEntry{
+				ScanTime:              metav1.Now(),
+				OrphanCount:           len(result.Details),
+				Duration:              time.Since(startTime).String(),
+				Partial:               true,
+				Incremental:           changed != nil,
+				SkippedNamespaces:     skipped[:min(len(skipped), maxSkippedNamespacesInHistory)],
+				SkippedNamespaceCount: len(skipped),
+			}
+			appendHistory(&korpScan, entry)
+			r.updateCondition(&korpScan, "Ready", metav1.ConditionFalse, "ScanIncomplete",
+				fmt.Sprintf("Scan cut short after finding %d orphans, %d namespaces not scanned: %v",
+					len(result.Details), len(skipped), err))
+		}
+		// Namespaces cleaned before the scan failed are still recorded
+		if pipeline != nil {
+			r.recordCleanup(&korpScan, pipeline.Result())
+		}
+		if statusErr := r.applyStatus(ctx, &korpScan); statusErr != nil {
 			log.Error(statusErr, "Failed to update status after scan failure")
 		}
+		if scan.IsPermission(err) {
+			// Retrying cannot help until korp's RBAC changes; the condition surfaces the error
+			return ctrl.Result{RequeueAfter: interval}, nil
+		}
 		return ctrl.Result{RequeueAfter: interval}, err
 	}
 
 	duration := time.Since(startTime)
 	log.Info("Scan completed", "duration", duration, "orphans", len(result.Details))
+	if watching && changed == nil {
+		r.changes.scanned(req.NamespacedName, korpScan.Generation)
+	}
+
+	// Compare with the previous scan so persisting findings keep their first detection time;
+	// findings of types the scan could not see are carried over rather than resolved
+	diff := result.Track(previous)
+	result.Summary.NewOrphans = len(diff.New)
+	result.Summary.ResolvedOrphans = len(diff.Resolved)
+
+	// Record detection metadata on the flagged resources themselves
+	if korpScan.Spec.Reporting.AnnotateResources {
+		if err := scanner.MarkFindings(ctx, result.Details); err != nil {
+			log.Error(err, "Failed to annotate some flagged resources")
+		}
+	}
+
+	// Resources that are in use again lose korp's annotations and the orphaned label set by cleanup
+	if korpScan.Spec.Reporting.AnnotateResources || (korpScan.Spec.Cleanup != nil && korpScan.Spec.Cleanup.Enabled) {
+		unmarked, err := scanner.UnmarkFindings(ctx, diff.Resolved)
+		if err != nil {
+			log.Error(err, "Failed to remove markings from some resolved resources")
+		}
+		// Markings the diff cannot see, e.g. of findings a truncated report left out, are found by their labels
+		stale, err := scanner.UnmarkStale(ctx, result)
+		if err != nil {
+			log.Error(err, "Failed to remove markings from some resources no longer flagged")
+		}
+		unmarked = append(unmarked, stale...)
+		for _, obj := range unmarked {
+			r.Reporter.CreateEvent(obj, "Normal", "Resolved", "Resource is no longer orphaned - korp markings removed")
+		}
+	}
 
 	// Update status with results
 	now := metav1.Time{Time: time.Now()}
+	scan.SummarizeAges(&result.Summary, result.Details, now.Time)
+
+	// Findings are stored in a KorpReport of their own; the status keeps only summaries
+	scanReport, reportErr := r.createReport(ctx, &korpScan, result, now, duration)
+	if reportErr != nil {
+		log.Error(reportErr, "Failed to store findings in a KorpReport")
+		r.updateCondition(&korpScan, "FindingsStored", metav1.ConditionFalse, "StoreFailed", reportErr.Error())
+	} else {
+		korpScan.Status.LatestReport = scanReport.Name
+		if stored := len(scanReport.Spec.Findings); stored < len(result.Details) {
+			log.Info("Report too large for all findings, storing a subset", "stored", stored, "total", len(result.Details))
+			r.updateCondition(&korpScan, "FindingsStored", metav1.ConditionFalse, "ReportTooLarge",
+				fmt.Sprintf("Stored %d of %d findings in KorpReport %s; the full list exceeds the API server object size limit",
+					stored, len(result.Details), scanReport.Name))
+		} else {
+			r.updateCondition(&korpScan, "FindingsStored", metav1.ConditionTrue, "AllStored",
+				fmt.Sprintf("All %d findings are stored in KorpReport %s", len(result.Details), scanReport.Name))
+		}
+	}
+	if err := r.clearStatusFindings(ctx, &korpScan); err != nil {
+		log.Error(err, "Failed to remove deprecated findings from status")
+	}
+
 	korpScan.Status.LastScanTime = &now
+	if korpScan.Status.FirstScanTime == nil {
+		korpScan.Status.FirstScanTime = firstScanTime(korpScan.Status.History, now)
+	}
 	korpScan.Status.Phase = "Completed"
 	korpScan.Status.Summary = result.Summary
 	korpScan.Status.Summary.OrphanCount = result.Summary.TotalOrphans()
-	korpScan.Status.Findings = result.Details
+	korpScan.Status.NewFindings = scan.Refs(diff.New, maxFindingChangesInStatus)
+	korpScan.Status.ResolvedFindings = scan.Refs(diff.Resolved, maxFindingChangesInStatus)
+	korpScan.Status.LeftBehind = scan.GroupLeftBehind(result.Details)
+	cluster := result.Cluster()
+	korpScan.Status.Cluster = &cluster
 
 	// Add to history
-	historyLimit := korpScan.Spec.Reporting.HistoryLimit
-	if historyLimit == 0 {
-		historyLimit = 5
-	}
-
 	totalOrphans := result.Summary.TotalOrphans()
-	korpScan.Status.History = append([]korpv1alpha1.HistoryEntry{{
-		ScanTime:    now,
-		OrphanCount: totalOrphans,
-		Duration:    duration.String(),
-	}}, korpScan.Status.History...)
-
-	if len(korpScan.Status.History) > historyLimit {
-		korpScan.Status.History = korpScan.Status.History[:historyLimit]
+	entry := korpv1alpha1.HistoryEntry{
+		ScanTime:        now,
+		OrphanCount:     totalOrphans,
+		Duration:        duration.String(),
+		NewOrphans:      len(diff.New),
+		ResolvedOrphans: len(diff.Resolved),
+		Incremental:     changed != nil,
+	}
+	if scanReport != nil {
+		entry.Report = scanReport.Name
+	}
+	appendHistory(&korpScan, entry)
+
+	metrics.RecordTrend(&korpScan, len(diff.New), len(diff.Resolved), result.Details, now.Time)
+	metrics.RecordScan(&korpScan, result.Details, duration)
+
+	// Warn when scans typically take longer than the interval, so every scan starts late
+	if typical := typicalScanDuration(korpScan.Status.History); typical > interval {
+		r.updateCondition(&korpScan, "IntervalSufficient", metav1.ConditionFalse, "IntervalShorterThanScan",
+			fmt.Sprintf("Scans take %s on average, longer than the %s interval; scans run back to back",
+				typical.Round(time.Second), interval))
+	} else {
+		r.updateCondition(&korpScan, "IntervalSufficient", metav1.ConditionTrue, "IntervalSufficient",
+			fmt.Sprintf("Scans take %s on average, within the %s interval", typical.Round(time.Second), interval))
 	}
 
 	// Update condition
 	r.updateCondition(&korpScan, "Ready", metav1.ConditionTrue, "ScanCompleted",
 		fmt.Sprintf("Found %d orphaned resources", totalOrphans))
+	korpScan.Status.BlindSpots = result.BlindSpots
+	if len(result.BlindSpots) > 0 {
+		blind := make([]string, 0, len(result.BlindSpots))
+		for _, spot := range result.BlindSpots {
+			if spot.NamespaceCount > 0 {
+				blind = append(blind, fmt.Sprintf("%s (%d namespaces)", spot.ResourceType, spot.NamespaceCount))
+			} else {
+				blind = append(blind, spot.ResourceType)
+			}
+		}
+		r.updateCondition(&korpScan, "FullCoverage", metav1.ConditionFalse, "Forbidden",
+			"Operator may not list: "+strings.Join(blind, ", ")+"; see status.blindSpots")
+	} else {
+		r.updateCondition(&korpScan, "FullCoverage", metav1.ConditionTrue, "AllListed",
+			"All requested resource types were listed in every scanned namespace")
+	}
+	if len(result.UnsupportedTypes) > 0 {
+		skipped := make([]string, 0, len(result.UnsupportedTypes))
+		for _, u := range result.UnsupportedTypes {
+			skipped = append(skipped, u.String())
+		}
+		r.updateCondition(&korpScan, "ResourceTypesSupported", metav1.ConditionFalse, "UnsupportedResourceTypes",
+			"Skipped: "+strings.Join(skipped, ", "))
+	} else {
+		r.updateCondition(&korpScan, "ResourceTypesSupported", metav1.ConditionTrue, "AllSupported",
+			"All requested resource types are scanned")
+	}
+	if len(result.Warnings) > 0 {
+		r.updateCondition(&korpScan, "DetectorsReady", metav1.ConditionFalse, "DetectorSkipped", strings.Join(result.Warnings, "; "))
+	} else {
+		r.updateCondition(&korpScan, "DetectorsReady", metav1.ConditionTrue, "AllDetectorsRan",
+			"Every configured detector ran")
+	}
 
-	// Update status
-	if err := r.Status().Update(ctx, &korpScan); err != nil {
+	if err := r.applyStatus(ctx, &korpScan); err != nil {
 		log.Error(err, "Failed to update status")
 		return ctrl.Result{}, err
 	}
 
+	// Reports of scans that dropped out of history are deleted
+	if err := r.pruneReports(ctx, &korpScan); err != nil {
+		log.Error(err, "Failed to prune old KorpReports")
+	}
+
+	// Publish the findings as a SARIF log for code scanning tools
+	if korpScan.Spec.Reporting.SARIF != nil {
+		if err := r.writeSARIF(ctx, &korpScan, result.Details); err != nil {
+			log.Error(err, "Failed to write SARIF report", "configMap", sarifConfigMapName(&korpScan))
+		}
+	}
+
 	// Create events if enabled
 	if korpScan.Spec.Reporting.CreateEvents {
 		r.Reporter.CreateEvents(ctx, &korpScan, result)
 	}
 
+	// Summarize findings on the scanned namespaces themselves
+	if korpScan.Spec.Reporting.AnnotateNamespaces {
+		if err := r.Reporter.AnnotateNamespaces(ctx, &korpScan, result, now.Time); err != nil {
+			log.Error(err, "Failed to annotate some scanned namespaces")
+		}
+	}
+
 	// Perform cleanup if enabled
+	var cleanupSummary *korpv1alpha1.CleanupSummary
+	var anomaly *korpv1alpha1.CleanupAnomaly
 	if korpScan.Spec.Cleanup != nil && korpScan.Spec.Cleanup.Enabled {
-		cleanupResult, cleanupErr := r.performCleanup(ctx, &korpScan, result)
+		// Findings a rescan carried over were already up for cleanup after the scan that found them
+		for _, ns := range result.CarriedNamespaces {
+			pipelined[ns] = true
+		}
+		cleanupResult, cleanupErr := r.performCleanup(ctx, &korpScan, result, pipeline, pipelined)
 		if cleanupErr != nil {
 			log.Error(cleanupErr, "Cleanup operation failed")
 			r.Reporter.CreateEvent(&korpScan, "Warning", "CleanupFailed",
 				fmt.Sprintf("Cleanup failed: %v", cleanupErr))
 		} else {
-			// Update cleanup status
-			cleanupTime := metav1.Now()
-			resultType := "Success"
-			if cleanupResult.Summary.DryRun {
-				resultType = "DryRun"
-			}
-			if cleanupResult.Summary.TotalFailed > 0 {
-				resultType = "PartialFailure"
-			}
-
-			korpScan.Status.CleanupStatus = &korpv1alpha1.CleanupStatus{
-				LastCleanupTime:   &cleanupTime,
-				LastCleanupResult: resultType,
-				Summary:           cleanupResult.Summary,
-				DeletedResources:  cleanupResult.DeletedResources,
-				FailedDeletions:   cleanupResult.FailedDeletions,
+			cleanupSummary = cleanupResult.Summary
+			previousRemediated := lastRemediated(korpScan.Status.CleanupStatus)
+			r.recordCleanup(&korpScan, cleanupResult)
+
+			// A run that remediated more than expected stops further remediations until a human resumes them
+			anomaly = cleanupAnomaly(korpScan.Spec.Cleanup.AnomalyThreshold, remediated(cleanupSummary), previousRemediated)
+			if anomaly != nil {
+				r.haltCleanup(&korpScan, anomaly)
 			}
 
-			// Create cleanup event
-			eventMsg := fmt.Sprintf("Cleanup completed: %d deleted, %d failed, %d skipped (preserved), %d skipped (age)",
-				cleanupResult.Summary.TotalDeleted,
-				cleanupResult.Summary.TotalFailed,
-				cleanupResult.Summary.TotalSkippedPreserved,
-				cleanupResult.Summary.TotalSkippedAge)
-			if cleanupResult.Summary.DryRun {
-				eventMsg = "[DRY-RUN] " + eventMsg
-			}
-			r.Reporter.CreateEvent(&korpScan, "Normal", "CleanupCompleted", eventMsg)
-
 			// Update status with cleanup results
-			if err := r.Status().Update(ctx, &korpScan); err != nil {
+			if err := r.applyStatus(ctx, &korpScan); err != nil {
 				log.Error(err, "Failed to update cleanup status")
 			}
 		}
 	}
 
-	// Send webhook notification if configured
-	if korpScan.Spec.Reporting.Webhook != nil {
-		webhookErr := r.sendWebhook(ctx, &korpScan, result, duration)
-
-		// Update webhook status based on result
-		if webhookErr != nil {
-			log.Error(webhookErr, "Failed to send webhook notification")
-
-			// Create warning event
-			r.Reporter.CreateEvent(&korpScan, "Warning", "WebhookFailed",
-				fmt.Sprintf("Failed to send webhook to %s: %v",
-					korpScan.Spec.Reporting.Webhook.URL, webhookErr))
-
-			// Update webhook failure status
-			failureTime := metav1.Now()
-			failureCount := 0
-			if korpScan.Status.WebhookStatus != nil {
-				failureCount = korpScan.Status.WebhookStatus.FailureCount
-			}
-
-			korpScan.Status.WebhookStatus = &korpv1alpha1.WebhookStatus{
-				LastFailure:  &failureTime,
-				FailureCount: failureCount + 1,
-				LastError:    webhookErr.Error(),
-			}
-		} else {
-			// Update webhook success status
-			successTime := metav1.Now()
-			korpScan.Status.WebhookStatus = &korpv1alpha1.WebhookStatus{
-				LastSuccess:  &successTime,
-				FailureCount: 0,
-				LastError:    "",
-			}
-			log.V(1).Info("Webhook notification sent successfully")
-		}
-
-		// Update status with webhook result (non-blocking)
-		if err := r.Status().Update(ctx, &korpScan); err != nil {
-			log.Error(err, "Failed to update webhook status")
-			// Don't fail the reconciliation on webhook status update failure
+	// Send notifications if configured; with notifyOnlyNew only scans with new orphans notify, while
+	// PagerDuty alerts are evaluated after every scan. Rescans only notify when orphans came or went,
+	// so watch mode does not repeat the same results.
+	payload := notificationPayload(&korpScan, result, diff, cleanupSummary, duration)
+	if anomaly != nil {
+		r.sendAnomalyAlert(ctx, &korpScan, payload)
+	}
+	if quiet := changed != nil && len(diff.New) == 0 && len(diff.Resolved) == 0; !quiet {
+		specs := notifier.Specs(korpScan.Spec.Reporting)
+		if korpScan.Spec.Reporting.NotifyOnlyNew && len(diff.New) == 0 {
+			specs = alertSpecs(specs)
 		}
+		r.notify(ctx, &korpScan, specs, payload)
 	}
 
 	// Requeue for next scan
-	log.Info("Scan completed successfully", "nextScanIn", interval)
-	return ctrl.Result{RequeueAfter: interval}, nil
+	nextScan := time.Until(lastFullScanTime(&korpScan).Add(interval))
+	log.Info("Scan completed successfully", "nextScanIn", nextScan)
+	return r.requeue(req, nextScan), nil
 }
 
-// sendWebhook sends a webhook notification with scan results
-func (r *KorpScanReconciler) sendWebhook(
-	ctx context.Context,
+// requeue asks for another reconcile after the given delay and remembers when it is due
+func (r *KorpScanReconciler) requeue(req ctrl.Request, after time.Duration) ctrl.Result {
+	r.requeueAt.Store(req.NamespacedName, time.Now().Add(after))
+	return ctrl.Result{RequeueAfter: after}
+}
+
+// scanFailureReason returns the Ready condition reason for a failed scan
+func scanFailureReason(err error) string {
+	switch {
+	case scan.IsPermission(err):
+		return "PermissionDenied"
+	case scan.IsTransient(err):
+		return "APIUnavailable"
+	}
+	return "ScanFailed"
+}
+
+// notificationPayload builds the payload sent to the notification channels after a scan
+func notificationPayload(
 	korpScan *korpv1alpha1.KorpScan,
 	result *scan.ScanResult,
+	diff scan.FindingsDiff,
+	cleanupSummary *korpv1alpha1.CleanupSummary,
 	duration time.Duration,
-) error {
-	log := log.FromContext(ctx)
-
-	// Create webhook notifier
-	webhookNotifier := notifier.NewWebhookNotifier(*korpScan.Spec.Reporting.Webhook, log)
-
-	// Build payload
-	payload := notifier.WebhookPayload{
-		EventType: "scan.completed",
-		Timestamp: time.Now().Format(time.RFC3339),
+) notifier.WebhookPayload {
+	findings := result.Details
+	if korpScan.Spec.Reporting.NotifyOnlyNew {
+		findings = diff.New
+	}
+	var wouldRemediate []korpv1alpha1.PlannedRemediation
+	if cleanupSummary != nil && korpScan.Status.CleanupStatus != nil {
+		wouldRemediate = korpScan.Status.CleanupStatus.WouldRemediate
+	}
+	return notifier.WebhookPayload{
+		SchemaVersion: report.SchemaVersion,
+		EventType:     "scan.completed",
+		Timestamp:     time.Now().Format(time.RFC3339),
 		KorpScan: notifier.ScanMetadata{
 			Name:            korpScan.Name,
 			Namespace:       korpScan.Namespace,
 			TargetNamespace: korpScan.Spec.TargetNamespace,
+			Cluster:         korpScan.Status.Cluster,
 		},
-		Summary:      result.Summary,
-		Findings:     result.Details,
-		ScanDuration: duration.String(),
+		Summary:          result.Summary,
+		Findings:         findings,
+		ScanDuration:     duration.String(),
+		Cleanup:          cleanupSummary,
+		WouldRemediate:   wouldRemediate,
+		NewFindings:      scan.Refs(diff.New, 0),
+		ResolvedFindings: scan.Refs(diff.Resolved, 0),
+	}
+}
+
+// notificationStatus records the outcome of one delivery on top of the previous status
+func notificationStatus(previous *korpv1alpha1.WebhookStatus, err error) *korpv1alpha1.WebhookStatus {
+	now := metav1.Now()
+	if err == nil {
+		return &korpv1alpha1.WebhookStatus{LastSuccess: &now}
+	}
+
+	failureCount := 0
+	if previous != nil {
+		failureCount = previous.FailureCount
+	}
+	return &korpv1alpha1.WebhookStatus{
+		LastFailure:  &now,
+		FailureCount: failureCount + 1,
+		LastError:    err.Error(),
+	}
+}
+
+// sendTestNotification sends a synthetic payload through each configured channel,
+// reports the delivery results as events and removes the request annotation
+func (r *KorpScanReconciler) sendTestNotification(ctx context.Context, korpScan *korpv1alpha1.KorpScan) error {
+	log := log.FromContext(ctx)
+
+	results := notifier.SendTest(ctx, r.Clientset, korpScan.Spec.Reporting, notifier.ScanMetadata{
+		Name:            korpScan.Name,
+		Namespace:       korpScan.Namespace,
+		TargetNamespace: korpScan.Spec.TargetNamespace,
+	}, log)
+	if len(results) == 0 {
+		r.Reporter.CreateEvent(korpScan, "Warning", "TestNotificationFailed", "No notification channels are configured")
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			r.Reporter.CreateEvent(korpScan, "Warning", "TestNotificationFailed",
+				fmt.Sprintf("Test notification to %s %s failed: %v", result.Channel, result.Target, result.Err))
+			continue
+		}
+		r.Reporter.CreateEvent(korpScan, "Normal", "TestNotificationSent",
+			fmt.Sprintf("Test notification delivered to %s %s", result.Channel, result.Target))
+	}
+
+	patch := client.MergeFrom(korpScan.DeepCopy())
+	delete(korpScan.Annotations, korpv1alpha1.TestNotificationAnnotation)
+	return r.Patch(ctx, korpScan, patch)
+}
+
+// appendHistory records a scan as the newest history entry, keeping at most the configured number of entries
+func appendHistory(korpScan *korpv1alpha1.KorpScan, entry korpv1alpha1.HistoryEntry) {
+	limit := historyLimit(korpScan)
+	korpScan.Status.History = append([]korpv1alpha1.HistoryEntry{entry}, korpScan.Status.History...)
+	if len(korpScan.Status.History) > limit {
+		korpScan.Status.History = korpScan.Status.History[:limit]
+	}
+}
+
+// typicalScanDuration returns the mean duration of the complete scans in history
+func typicalScanDuration(history []korpv1alpha1.HistoryEntry) time.Duration {
+	var total time.Duration
+	count := 0
+	for _, entry := range history {
+		if entry.Partial || entry.Incremental {
+			continue
+		}
+		d, err := time.ParseDuration(entry.Duration)
+		if err != nil {
+			continue
+		}
+		total += d
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / time.Duration(count)
+}
+
+// cleanupMessage summarizes the counts of a cleanup run; dry runs report what they would have done
+func cleanupMessage(summary *korpv1alpha1.CleanupSummary) string {
+	if summary.DryRun {
+		msg := fmt.Sprintf("Cleanup dry run completed: %d would be deleted, %d skipped (preserved), %d skipped (age)",
+			summary.TotalWouldDelete,
+			summary.TotalSkippedPreserved,
+			summary.TotalSkippedAge)
+		if summary.TotalWouldScaleToZero > 0 {
+			msg += fmt.Sprintf(", %d would be scaled to zero", summary.TotalWouldScaleToZero)
+		}
+		if summary.TotalWouldPatch > 0 {
+			msg += fmt.Sprintf(", %d would be patched", summary.TotalWouldPatch)
+		}
+		if summary.TotalWouldLabel > 0 {
+			msg += fmt.Sprintf(", %d would be labeled", summary.TotalWouldLabel)
+		}
+		if summary.TotalSkippedScans > 0 {
+			msg += fmt.Sprintf(", %d skipped (consecutive scans)", summary.TotalSkippedScans)
+		}
+		if summary.TotalSkippedSeverity > 0 {
+			msg += fmt.Sprintf(", %d skipped (severity)", summary.TotalSkippedSeverity)
+		}
+		if summary.TotalSkippedPolicy > 0 {
+			msg += fmt.Sprintf(", %d skipped (KorpPolicy)", summary.TotalSkippedPolicy)
+		}
+		if summary.TotalSkippedBudget > 0 {
+			msg += fmt.Sprintf(", %d skipped (budget)", summary.TotalSkippedBudget)
+		}
+		if summary.TotalPendingApproval > 0 {
+			msg += fmt.Sprintf(", %d awaiting approval", summary.TotalPendingApproval)
+		}
+		if summary.TotalRejected > 0 {
+			msg += fmt.Sprintf(", %d rejected", summary.TotalRejected)
+		}
+		return msg
 	}
 
-	// Send webhook
-	return webhookNotifier.Send(ctx, payload)
+	msg := fmt.Sprintf("Cleanup completed: %d deleted, %d failed, %d skipped (preserved), %d skipped (age)",
+		summary.TotalDeleted,
+		summary.TotalFailed,
+		summary.TotalSkippedPreserved,
+		summary.TotalSkippedAge)
+	if summary.TotalScaledToZero > 0 {
+		msg += fmt.Sprintf(", %d scaled to zero", summary.TotalScaledToZero)
+	}
+	if summary.TotalPatched > 0 {
+		msg += fmt.Sprintf(", %d patched", summary.TotalPatched)
+	}
+	if summary.TotalLabeled > 0 {
+		msg += fmt.Sprintf(", %d labeled", summary.TotalLabeled)
+	}
+	if summary.TotalSkippedScans > 0 {
+		msg += fmt.Sprintf(", %d skipped (consecutive scans)", summary.TotalSkippedScans)
+	}
+	if summary.TotalSkippedSeverity > 0 {
+		msg += fmt.Sprintf(", %d skipped (severity)", summary.TotalSkippedSeverity)
+	}
+	if summary.TotalSkippedPolicy > 0 {
+		msg += fmt.Sprintf(", %d skipped (KorpPolicy)", summary.TotalSkippedPolicy)
+	}
+	if summary.TotalSkippedBudget > 0 {
+		msg += fmt.Sprintf(", %d skipped (budget)", summary.TotalSkippedBudget)
+	}
+	if summary.TotalPendingApproval > 0 {
+		msg += fmt.Sprintf(", %d awaiting approval", summary.TotalPendingApproval)
+	}
+	if summary.TotalRejected > 0 {
+		msg += fmt.Sprintf(", %d rejected", summary.TotalRejected)
+	}
+	return msg
+}
+
+// keepApprovalRequests carries the time approval was first requested over from the previous
+// cleanup, so a candidate's approval list entry shows how long it has been waiting
+func keepApprovalRequests(previous, current []korpv1alpha1.CleanupApproval) []korpv1alpha1.CleanupApproval {
+	requested := make(map[string]metav1.Time, len(previous))
+	for _, a := range previous {
+		requested[a.ResourceType+"/"+a.Namespace+"/"+a.Name] = a.RequestedAt
+	}
+	for i, a := range current {
+		if t, ok := requested[a.ResourceType+"/"+a.Namespace+"/"+a.Name]; ok && !t.IsZero() {
+			current[i].RequestedAt = t
+		}
+	}
+	return current
+}
+
+// capApprovals returns at most limit approvals, pending ones first, so the candidates still waiting
+// for a decision stay visible however many there are
+func capApprovals(approvals []korpv1alpha1.CleanupApproval, limit int) []korpv1alpha1.CleanupApproval {
+	if len(approvals) <= limit {
+		return approvals
+	}
+	sort.SliceStable(approvals, func(i, j int) bool {
+		return approvals[i].State == korpv1alpha1.ApprovalPending && approvals[j].State != korpv1alpha1.ApprovalPending
+	})
+	return approvals[:limit]
+}
+
+// reclaimedMessage describes the capacity released by a cleanup run
+func reclaimedMessage(summary *korpv1alpha1.CleanupSummary) string {
+	var parts []string
+	if summary.ReclaimedStorage != "" {
+		parts = append(parts, "storage "+summary.ReclaimedStorage)
+	}
+	if summary.FreedCPU != "" {
+		parts = append(parts, "CPU "+summary.FreedCPU)
+	}
+	if summary.FreedMemory != "" {
+		parts = append(parts, "memory "+summary.FreedMemory)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	if summary.DryRun {
+		return "would reclaim " + strings.Join(parts, ", ")
+	}
+	return "reclaimed " + strings.Join(parts, ", ")
 }
 
 // updateCondition updates or adds a condition to the KorpScan status
@@ -293,29 +795,178 @@ func (r *KorpScanReconciler) updateCondition(korpScan *korpv1alpha1.KorpScan,
 	})
 }
 
-// performCleanup executes the cleanup operation
-func (r *KorpScanReconciler) performCleanup(
+// startCleanup checks cleanup permissions and starts a cleanup run.
+// Resource types the operator may not clean are left out of the run.
+func (r *KorpScanReconciler) startCleanup(
 	ctx context.Context,
 	korpScan *korpv1alpha1.KorpScan,
-	scanResult *scan.ScanResult,
-) (*cleanup.CleanupResult, error) {
+	policies *policy.Set,
+) (*cleanup.Run, error) {
 	log := log.FromContext(ctx)
 
 	if r.Cleaner == nil {
 		return nil, fmt.Errorf("cleaner not initialized")
 	}
 
+	// Check delete permissions up front so missing RBAC is reported by rule
+	// instead of as generic forbidden errors at deletion time. A dry run deletes nothing and needs none.
+	spec := korpScan.Spec.Cleanup
+	namespace := korpScan.Spec.TargetNamespace
+	if namespace == "*" {
+		namespace = ""
+	}
+	if !spec.IsDryRun() && !cleanupHalted(korpScan) {
+		missing, err := r.Cleaner.MissingPermissions(ctx, spec, namespace)
+		if err != nil {
+			log.Error(err, "Failed to check cleanup permissions")
+		} else if len(missing) > 0 {
+			var rules, types []string
+			for _, p := range missing {
+				rules = append(rules, p.String())
+				types = append(types, p.ResourceType)
+			}
+			r.updateCondition(korpScan, "CleanupPermitted", metav1.ConditionFalse, "MissingRBAC",
+				fmt.Sprintf("Operator lacks RBAC rule(s) %s", strings.Join(rules, ", ")))
+			r.Reporter.CreateEvent(korpScan, "Warning", "CleanupForbidden",
+				fmt.Sprintf("Skipping cleanup of %s: operator lacks RBAC rule(s) %s (group/resource/verb)",
+					strings.Join(types, ", "), strings.Join(rules, ", ")))
+
+			spec = cleanup.WithoutResourceTypes(spec, missing)
+			if spec == nil {
+				// Nothing may be cleaned; the run stays empty
+				spec = &korpv1alpha1.CleanupSpec{DryRun: korpScan.Spec.Cleanup.DryRun}
+			}
+		} else {
+			r.updateCondition(korpScan, "CleanupPermitted", metav1.ConditionTrue, "Permitted",
+				"Operator may delete all resource types enabled for cleanup")
+		}
+	}
+
+	// After an anomaly cleanup only records what it would do until a human resumes it
+	if cleanupHalted(korpScan) {
+		spec = observedSpec(spec)
+		log.Info("Cleanup halted by an anomaly, running as a dry run", "detectedAt", korpScan.Status.LastCleanupAnomaly.DetectedAt)
+	}
+
+	// Until the observation period is over cleanup only records what it would do
+	if days := korpScan.Spec.Cleanup.ObservationPeriodDays; days > 0 {
+		if end, observing := observationEnd(korpScan, time.Now()); observing {
+			spec = observedSpec(spec)
+			message := fmt.Sprintf("Cleanup runs as a dry run until %d days after the first completed scan", days)
+			if !end.IsZero() {
+				message = "Cleanup runs as a dry run until " + end.UTC().Format(time.RFC3339)
+			}
+			r.updateCondition(korpScan, "ObservationComplete", metav1.ConditionFalse, "Observing", message)
+		} else {
+			r.updateCondition(korpScan, "ObservationComplete", metav1.ConditionTrue, "PeriodElapsed",
+				fmt.Sprintf("The %d-day observation period ended %s", days, end.UTC().Format(time.RFC3339)))
+		}
+	}
+
 	log.Info("Starting cleanup operation",
-		"dryRun", korpScan.Spec.Cleanup.IsDryRun(),
-		"minAgeDays", korpScan.Spec.Cleanup.MinAgeDays,
-		"eligibleFindings", len(scanResult.Details))
+		"dryRun", spec.IsDryRun(),
+		"minAgeDays", spec.MinAgeDays,
+		"mode", spec.Mode)
+
+	run := r.Cleaner.Start(spec, policies)
+
+	// Manifests are backed up only when something is actually deleted
+	if backupSpec := korpScan.Spec.Cleanup.Backup; backupSpec != nil && !spec.IsDryRun() {
+		runID := backup.RunID(time.Now())
+		store, err := backup.NewStore(ctx, r.Clientset, backupSpec, korpScan, runID)
+		if err != nil {
+			return nil, fmt.Errorf("setting up cleanup backups: %w", err)
+		}
+		run.BackUpTo(store, runID)
+		log.Info("Backing up manifests before deletion", "run", runID)
+	}
+	return run, nil
+}
 
-	return r.Cleaner.Clean(ctx, scanResult.Details, korpScan.Spec.Cleanup)
+// performCleanup executes the cleanup operation. A pipelined run has already cleaned the
+// namespaces in cleaned while they were scanned, so only the remaining findings are cleaned.
+func (r *KorpScanReconciler) performCleanup(
+	ctx context.Context,
+	korpScan *korpv1alpha1.KorpScan,
+	scanResult *scan.ScanResult,
+	run *cleanup.Run,
+	cleaned map[string]bool,
+) (*cleanup.CleanupResult, error) {
+	log := log.FromContext(ctx)
+
+	var findings []korpv1alpha1.Finding
+	for _, f := range scanResult.Details {
+		if !cleaned[f.Namespace] {
+			findings = append(findings, f)
+		}
+	}
+
+	if run == nil {
+		var err error
+		run, err = r.startCleanup(ctx, korpScan, scanResult.Policies)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("Cleaning findings",
+		"eligibleFindings", len(findings),
+		"pipelinedNamespaces", len(cleaned))
+
+	run.Clean(ctx, findings)
+	return run.Result(), nil
+}
+
+// recordCleanup stores a cleanup result in the status and reports it as metrics and an event
+func (r *KorpScanReconciler) recordCleanup(korpScan *korpv1alpha1.KorpScan, cleanupResult *cleanup.CleanupResult) {
+	cleanupTime := metav1.Now()
+	resultType := "Success"
+	if cleanupResult.Summary.DryRun {
+		resultType = "DryRun"
+	}
+	if cleanupResult.Summary.TotalFailed > 0 {
+		resultType = "PartialFailure"
+	}
+
+	metrics.RecordCleanup(korpScan, cleanupResult.DeletedResources, cleanupResult.FailedDeletions,
+		cleanupResult.Summary.DryRun)
+	var previous []korpv1alpha1.CleanupApproval
+	if korpScan.Status.CleanupStatus != nil {
+		previous = korpScan.Status.CleanupStatus.Approvals
+	}
+	korpScan.Status.CleanupStatus = &korpv1alpha1.CleanupStatus{
+		LastCleanupTime:   &cleanupTime,
+		LastCleanupResult: resultType,
+		Summary:           cleanupResult.Summary,
+		DeletedResources:  cleanupResult.DeletedResources,
+		FailedDeletions:   cleanupResult.FailedDeletions,
+		WouldRemediate:    cleanupResult.WouldRemediate,
+		Approvals:         capApprovals(keepApprovalRequests(previous, cleanupResult.Approvals), maxApprovalsInStatus),
+		BackupRun:         cleanupResult.BackupRun,
+	}
+
+	// Create cleanup event
+	eventMsg := cleanupMessage(cleanupResult.Summary)
+	if reclaimed := reclaimedMessage(cleanupResult.Summary); reclaimed != "" {
+		eventMsg += "; " + reclaimed
+	}
+	if cleanupResult.Summary.DryRun {
+		eventMsg = "[DRY-RUN] " + eventMsg
+	}
+	r.Reporter.CreateEvent(korpScan, "Normal", "CleanupCompleted", eventMsg)
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *KorpScanReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Watch-mode KorpScans share informers, whose changes wake up the KorpScans they concern
+	r.changes = newChangeTracker()
+	r.informers = k8sutil.NewInformers(r.Clientset, r.Metadata, r.changes.changed)
+	if err := mgr.Add(r.informers); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&korpv1alpha1.KorpScan{}).
+		WatchesRawSource(source.Channel(r.changes.events, &handler.EnqueueRequestForObject{})).
 		Complete(r)
 }
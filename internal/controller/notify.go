@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/metrics"
+	"github.com/kamilbabayev/korp/pkg/notifier"
+)
+
+// notify sends the payload through specs at once and records each delivery on its own: reporting.webhook,
+// reporting.slack and reporting.pagerDuty in webhookStatus, slackStatus and pagerDutyStatus, and every
+// notifier of reporting.notifiers in notifierStatuses
+func (r *KorpScanReconciler) notify(ctx context.Context, korpScan *korpv1alpha1.KorpScan, specs []korpv1alpha1.NotifierSpec, payload notifier.WebhookPayload) {
+	log := log.FromContext(ctx)
+	if len(specs) == 0 {
+		return
+	}
+	errs := notifier.FanOut(ctx, r.Clientset, korpScan.Namespace, specs, payload, log)
+
+	previous := make(map[string]*korpv1alpha1.WebhookStatus)
+	for i := range korpScan.Status.NotifierStatuses {
+		previous[korpScan.Status.NotifierStatuses[i].Name] = &korpScan.Status.NotifierStatuses[i].WebhookStatus
+	}
+	var statuses []korpv1alpha1.NotifierStatus
+	recorded := make(map[string]bool)
+	notified := make(map[string]bool)
+	for i, spec := range specs {
+		err := errs[i]
+		if err != nil {
+			log.Error(err, "Failed to send notification", "type", spec.Type, "notifier", spec.Name)
+			if spec.Type == korpv1alpha1.NotifierTypeWebhook {
+				metrics.RecordWebhookFailure(korpScan.Namespace, korpScan.Name)
+			}
+			reason, message := deliveryFailure(spec, false, err)
+			r.Reporter.CreateEvent(korpScan, "Warning", reason, message)
+		} else {
+			log.V(1).Info("Notification sent successfully", "type", spec.Type, "notifier", spec.Name)
+		}
+
+		switch {
+		case spec.Name != "":
+			if recorded[spec.Name] {
+				continue
+			}
+			recorded[spec.Name] = true
+			notified[spec.Name] = true
+			statuses = append(statuses, korpv1alpha1.NotifierStatus{
+				Name:          spec.Name,
+				Type:          spec.Type,
+				WebhookStatus: *notificationStatus(previous[spec.Name], err),
+			})
+		case spec.Type == korpv1alpha1.NotifierTypeWebhook:
+			korpScan.Status.WebhookStatus = notificationStatus(korpScan.Status.WebhookStatus, err)
+		case spec.Type == korpv1alpha1.NotifierTypeSlack:
+			korpScan.Status.SlackStatus = notificationStatus(korpScan.Status.SlackStatus, err)
+		case spec.Type == korpv1alpha1.NotifierTypePagerDuty:
+			// The alert stays as it was when the event failed
+			status := &korpv1alpha1.PagerDutyStatus{Triggered: notifier.PagerDutyBreached(*spec.PagerDuty, payload.Summary)}
+			var previousDelivery *korpv1alpha1.WebhookStatus
+			if previous := korpScan.Status.PagerDutyStatus; previous != nil {
+				previousDelivery = &previous.WebhookStatus
+				if err != nil {
+					status.Triggered = previous.Triggered
+				}
+			}
+			status.WebhookStatus = *notificationStatus(previousDelivery, err)
+			korpScan.Status.PagerDutyStatus = status
+		}
+	}
+	// Statuses of notifiers no longer configured are dropped; those skipped this time are kept
+	for _, status := range korpScan.Status.NotifierStatuses {
+		if !notified[status.Name] && configured(korpScan.Spec.Reporting.Notifiers, status.Name) {
+			statuses = append(statuses, status)
+		}
+	}
+	korpScan.Status.NotifierStatuses = statuses
+
+	// Update status with the delivery results (non-blocking)
+	if err := r.applyStatus(ctx, korpScan); err != nil {
+		log.Error(err, "Failed to update notification status")
+	}
+}
+
+// alertSpecs returns the notifiers of type pagerduty among specs, which are evaluated after every scan
+func alertSpecs(specs []korpv1alpha1.NotifierSpec) []korpv1alpha1.NotifierSpec {
+	var alerts []korpv1alpha1.NotifierSpec
+	for _, spec := range specs {
+		if spec.Type == korpv1alpha1.NotifierTypePagerDuty {
+			alerts = append(alerts, spec)
+		}
+	}
+	return alerts
+}
+
+// configured reports whether a notifier of reporting.notifiers is named name
+func configured(specs []korpv1alpha1.NotifierSpec, name string) bool {
+	for _, spec := range specs {
+		if spec.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// deliveryFailure returns the event reason and message for a delivery through spec that failed with err
+func deliveryFailure(spec korpv1alpha1.NotifierSpec, anomaly bool, err error) (string, string) {
+	what := "scan results"
+	if anomaly {
+		what = "cleanup anomaly"
+	}
+	name := ""
+	if spec.Name != "" {
+		name = fmt.Sprintf(" (notifier %s)", spec.Name)
+	}
+
+	switch spec.Type {
+	case korpv1alpha1.NotifierTypeWebhook:
+		return "WebhookFailed", fmt.Sprintf("Failed to send %s to %s%s: %v", what, notifier.Target(spec), name, err)
+	case korpv1alpha1.NotifierTypeSlack:
+		return "SlackFailed", fmt.Sprintf("Failed to post %s to Slack%s: %v", what, name, err)
+	case korpv1alpha1.NotifierTypePagerDuty:
+		return "PagerDutyFailed", fmt.Sprintf("Failed to send PagerDuty event%s: %v", name, err)
+	}
+	return "NotificationFailed", fmt.Sprintf("Failed to send %s through %s%s: %v", what, spec.Type, name, err)
+}
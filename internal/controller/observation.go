@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// firstScanTime returns when the first scan of a KorpScan completed. KorpScans that scanned before
+// FirstScanTime was recorded start from their oldest history entry, else from now.
+func firstScanTime(history []korpv1alpha1.HistoryEntry, now metav1.Time) *metav1.Time {
+	first := now
+	for _, entry := range history {
+		if entry.ScanTime.Before(&first) {
+			first = entry.ScanTime
+		}
+	}
+	return &first
+}
+
+// observationEnd returns when the cleanup observation period of korpScan ends and whether it is
+// still running at now. Before the first scan has completed the end is unknown and zero.
+func observationEnd(korpScan *korpv1alpha1.KorpScan, now time.Time) (time.Time, bool) {
+	days := korpScan.Spec.Cleanup.ObservationPeriodDays
+	first := korpScan.Status.FirstScanTime
+	if days <= 0 {
+		return time.Time{}, false
+	}
+	if first == nil {
+		return time.Time{}, true
+	}
+	end := first.Add(time.Duration(days) * 24 * time.Hour)
+	return end, now.Before(end)
+}
+
+// observedSpec returns a copy of spec forced into dry run
+func observedSpec(spec *korpv1alpha1.CleanupSpec) *korpv1alpha1.CleanupSpec {
+	observed := spec.DeepCopy()
+	dryRun := true
+	observed.DryRun = &dryRun
+	return observed
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/scan"
+)
+
+// historyLimit returns how many scans a KorpScan keeps in status history and as KorpReports
+func historyLimit(korpScan *korpv1alpha1.KorpScan) int {
+	if limit := korpScan.Spec.Reporting.HistoryLimit; limit > 0 {
+		return limit
+	}
+	return 5
+}
+
+// listReports returns the KorpReports of korpScan, newest first.
+// Reports are not cached by the manager, so this reads from the API server.
+func (r *KorpScanReconciler) listReports(ctx context.Context, korpScan *korpv1alpha1.KorpScan) ([]korpv1alpha1.KorpReport, error) {
+	var reports korpv1alpha1.KorpReportList
+	if err := r.List(ctx, &reports, client.InNamespace(korpScan.Namespace),
+		client.MatchingLabels{korpv1alpha1.ReportScanLabel: korpScan.Name}); err != nil {
+		return nil, err
+	}
+	items := reports.Items
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].Spec.ScanTime.Equal(&items[j].Spec.ScanTime) {
+			return items[j].Spec.ScanTime.Before(&items[i].Spec.ScanTime)
+		}
+		return items[i].Name > items[j].Name
+	})
+	return items, nil
+}
+
+// previousFindings returns the findings of korpScan's last completed scan: those of its newest KorpReport,
+// or the deprecated status.findings of a KorpScan last scanned before findings moved to reports
+func (r *KorpScanReconciler) previousFindings(ctx context.Context, korpScan *korpv1alpha1.KorpScan) ([]korpv1alpha1.Finding, error) {
+	if korpScan.Status.LatestReport != "" {
+		var latest korpv1alpha1.KorpReport
+		err := r.Get(ctx, client.ObjectKey{Namespace: korpScan.Namespace, Name: korpScan.Status.LatestReport}, &latest)
+		if err == nil {
+			return trackedFindings(&latest), nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	// The latest report was deleted by hand; the newest remaining one is the best reference
+	reports, err := r.listReports(ctx, korpScan)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) > 0 {
+		return trackedFindings(&reports[0]), nil
+	}
+	return korpScan.Status.Findings, nil
+}
+
+// trackedFindings returns the findings of report, including those a truncated report only records the
+// detection of. The latter carry no reason or details, only what tracking them across scans needs.
+func trackedFindings(report *korpv1alpha1.KorpReport) []korpv1alpha1.Finding {
+	if len(report.Spec.Detections) == 0 {
+		return report.Spec.Findings
+	}
+	findings := make([]korpv1alpha1.Finding, 0, len(report.Spec.Findings)+len(report.Spec.Detections))
+	findings = append(findings, report.Spec.Findings...)
+	for _, d := range report.Spec.Detections {
+		findings = append(findings, korpv1alpha1.Finding{
+			ResourceType:     d.ResourceType,
+			Namespace:        d.Namespace,
+			Name:             d.Name,
+			DetectedAt:       d.DetectedAt,
+			ConsecutiveScans: d.ConsecutiveScans,
+		})
+	}
+	return findings
+}
+
+// detections returns the detection records of findings
+func detections(findings []korpv1alpha1.Finding) []korpv1alpha1.FindingDetection {
+	if len(findings) == 0 {
+		return nil
+	}
+	records := make([]korpv1alpha1.FindingDetection, 0, len(findings))
+	for _, f := range findings {
+		records = append(records, korpv1alpha1.FindingDetection{
+			ResourceType:     f.ResourceType,
+			Namespace:        f.Namespace,
+			Name:             f.Name,
+			DetectedAt:       f.DetectedAt,
+			ConsecutiveScans: f.ConsecutiveScans,
+		})
+	}
+	return records
+}
+
+// createReport stores the findings of a completed scan in a new KorpReport owned by korpScan.
+// When the report would exceed the API server's size limit the findings are halved until it fits,
+// so the record of the scan is never lost to its size. Findings left out keep a detection record, so
+// the next scan does not take them for new ones; only when even those do not fit are they dropped.
+// It returns the created report.
+func (r *KorpScanReconciler) createReport(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *scan.ScanResult,
+	scanTime metav1.Time, duration time.Duration) (*korpv1alpha1.KorpReport, error) {
+	findings := result.Details
+	keepDetections := true
+	for n := len(findings); ; n /= 2 {
+		report := &korpv1alpha1.KorpReport{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: korpScan.Name + "-",
+				Namespace:    korpScan.Namespace,
+				Labels:       map[string]string{korpv1alpha1.ReportScanLabel: korpScan.Name},
+			},
+			Spec: korpv1alpha1.KorpReportSpec{
+				ScanName:      korpScan.Name,
+				ScanTime:      scanTime,
+				Duration:      duration.String(),
+				Summary:       result.Summary,
+				TotalFindings: len(findings),
+				Findings:      findings[:n],
+			},
+		}
+		if keepDetections {
+			report.Spec.Detections = detections(findings[n:])
+		}
+		report.Spec.Summary.OrphanCount = result.Summary.TotalOrphans()
+		if err := controllerutil.SetControllerReference(korpScan, report, r.Scheme); err != nil {
+			return nil, err
+		}
+
+		err := r.Create(ctx, report)
+		if err == nil || !errors.IsRequestEntityTooLargeError(err) {
+			return report, err
+		}
+		if n == 0 {
+			if !keepDetections {
+				return report, err
+			}
+			// Not even the detection records fit; store the summary alone
+			keepDetections = false
+			n = 1
+		}
+	}
+}
+
+// pruneReports deletes the KorpReports of korpScan beyond its history limit, oldest first
+func (r *KorpScanReconciler) pruneReports(ctx context.Context, korpScan *korpv1alpha1.KorpScan) error {
+	reports, err := r.listReports(ctx, korpScan)
+	if err != nil {
+		return err
+	}
+	limit := historyLimit(korpScan)
+	for i := len(reports) - 1; i >= limit; i-- {
+		if err := r.Delete(ctx, &reports[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearStatusFindings removes the deprecated status.findings written by earlier versions, which
+// could grow past the object size limit; findings now live in KorpReports
+func (r *KorpScanReconciler) clearStatusFindings(ctx context.Context, korpScan *korpv1alpha1.KorpScan) error {
+	if len(korpScan.Status.Findings) == 0 {
+		return nil
+	}
+	if err := r.applyStatusFields(ctx, korpScan, findingsFieldManager, map[string]interface{}{}); err != nil {
+		return err
+	}
+	korpScan.Status.Findings = nil
+	return nil
+}
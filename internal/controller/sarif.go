@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+const (
+	// sarifKey is the ConfigMap key holding the SARIF log
+	sarifKey = "korp.sarif"
+
+	// sarifFieldManager owns the SARIF ConfigMap
+	sarifFieldManager = "korp-sarif"
+
+	// maxSARIFBytes keeps the SARIF log within the 1MiB ConfigMap limit
+	maxSARIFBytes = 1000 * 1000
+)
+
+// sarifConfigMapName returns the ConfigMap the SARIF log of korpScan is written to
+func sarifConfigMapName(korpScan *korpv1alpha1.KorpScan) string {
+	if name := korpScan.Spec.Reporting.SARIF.ConfigMapName; name != "" {
+		return name
+	}
+	return korpScan.Name + "-sarif"
+}
+
+// writeSARIF server-side applies the SARIF log of findings to the KorpScan's ConfigMap.
+// Findings are halved until the log fits in a ConfigMap; a cut log records the total in its run properties.
+func (r *KorpScanReconciler) writeSARIF(ctx context.Context, korpScan *korpv1alpha1.KorpScan, findings []korpv1alpha1.Finding) error {
+	var data []byte
+	for n := len(findings); ; n /= 2 {
+		sarifLog := report.NewSARIF(findings[:n], "")
+		if n < len(findings) {
+			sarifLog.Runs[0].Props = map[string]interface{}{"totalFindings": len(findings), "truncated": true}
+		}
+		var err error
+		data, err = json.Marshal(sarifLog)
+		if err != nil {
+			return err
+		}
+		if len(data) <= maxSARIFBytes || n == 0 {
+			break
+		}
+	}
+
+	gvk := korpv1alpha1.GroupVersion.WithKind("KorpScan")
+	owner := metav1ac.OwnerReference().
+		WithAPIVersion(gvk.GroupVersion().String()).
+		WithKind(gvk.Kind).
+		WithName(korpScan.Name).
+		WithUID(korpScan.UID).
+		WithController(true)
+	cm := corev1ac.ConfigMap(sarifConfigMapName(korpScan), korpScan.Namespace).
+		WithLabels(map[string]string{"app.kubernetes.io/managed-by": "korp"}).
+		WithOwnerReferences(owner).
+		WithData(map[string]string{sarifKey: string(data)})
+	_, err := r.Clientset.CoreV1().ConfigMaps(korpScan.Namespace).Apply(ctx, cm,
+		metav1.ApplyOptions{FieldManager: sarifFieldManager, Force: true})
+	return err
+}
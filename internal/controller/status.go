@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+const (
+	// statusFieldManager owns every status field except findings
+	statusFieldManager = "korp-status"
+
+	// findingsFieldManager owned status.findings, written separately because it could be large.
+	// Findings are now stored in KorpReports; the manager is kept to clear the field.
+	findingsFieldManager = "korp-findings"
+)
+
+// retriableStatusError reports whether a failed status write is worth repeating
+func retriableStatusError(err error) bool {
+	return errors.IsConflict(err) || errors.IsServerTimeout(err) || errors.IsTimeout(err) || errors.IsTooManyRequests(err)
+}
+
+// applyStatus server-side applies all status fields except findings.
+// The apply carries no resourceVersion, so writes to other fields of the KorpScan do not conflict with it;
+// conflicts and transient server errors are retried with backoff.
+func (r *KorpScanReconciler) applyStatus(ctx context.Context, korpScan *korpv1alpha1.KorpScan) error {
+	status := korpScan.Status.DeepCopy()
+	status.Findings = nil
+	fields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return err
+	}
+	return r.applyStatusFields(ctx, korpScan, statusFieldManager, fields)
+}
+
+// applyStatusFields applies the given status fields of a KorpScan as fieldManager
+func (r *KorpScanReconciler) applyStatusFields(ctx context.Context, korpScan *korpv1alpha1.KorpScan,
+	fieldManager string, fields map[string]interface{}) error {
+	return retry.OnError(retry.DefaultBackoff, retriableStatusError, func() error {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{"status": runtime.DeepCopyJSON(fields)}}
+		obj.SetGroupVersionKind(korpv1alpha1.GroupVersion.WithKind("KorpScan"))
+		obj.SetNamespace(korpScan.Namespace)
+		obj.SetName(korpScan.Name)
+		return r.Status().Patch(ctx, obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
+	})
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// changeTracker collects the namespaces whose resources changed for each watch-mode KorpScan
+type changeTracker struct {
+	// events wake up a KorpScan when the first change since its last scan comes in
+	events chan event.GenericEvent
+
+	mu    sync.Mutex
+	scans map[types.NamespacedName]*watchedScan
+}
+
+// watchedScan is the change state of one watch-mode KorpScan
+type watchedScan struct {
+	// target is the KorpScan's target namespace, "*" for all
+	target string
+
+	// generation is the KorpScan generation of the last full scan since the operator started, 0 before.
+	// Rescans carry over findings, which are only valid for the spec they were found with.
+	generation int64
+
+	// changed holds the namespaces that changed since the last scan, "" for cluster-scoped changes
+	changed map[string]bool
+
+	// since is when the first of those changes came in
+	since time.Time
+}
+
+func newChangeTracker() *changeTracker {
+	return &changeTracker{
+		events: make(chan event.GenericEvent, 128),
+		scans:  make(map[types.NamespacedName]*watchedScan),
+	}
+}
+
+// watch tracks the changes relevant to a KorpScan that scans target
+func (t *changeTracker) watch(key types.NamespacedName, target string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if scan, ok := t.scans[key]; ok {
+		scan.target = target
+		return
+	}
+	t.scans[key] = &watchedScan{target: target, changed: make(map[string]bool)}
+}
+
+// forget stops tracking the changes of a KorpScan
+func (t *changeTracker) forget(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.scans, key)
+}
+
+// changed records a change in namespace ns, or a cluster-scoped change when ns is "", for every
+// KorpScan it is relevant to, and wakes up those that had no changes pending
+func (t *changeTracker) changed(ns string) {
+	var wake []types.NamespacedName
+	t.mu.Lock()
+	for key, scan := range t.scans {
+		if ns != "" && scan.target != "*" && scan.target != ns {
+			continue
+		}
+		if len(scan.changed) == 0 {
+			scan.since = time.Now()
+			wake = append(wake, key)
+		}
+		scan.changed[ns] = true
+	}
+	t.mu.Unlock()
+
+	for _, key := range wake {
+		t.events <- event.GenericEvent{Object: &korpv1alpha1.KorpScan{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		}}
+	}
+}
+
+// pending returns when the first pending change of a KorpScan came in. It returns false when no
+// changes are pending or a rescan could not use them because no full scan of generation ran yet.
+func (t *changeTracker) pending(key types.NamespacedName, generation int64) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	scan, ok := t.scans[key]
+	if !ok || len(scan.changed) == 0 || scan.generation != generation {
+		return time.Time{}, false
+	}
+	return scan.since, true
+}
+
+// take returns the changed namespaces of a KorpScan, sorted, and clears them
+func (t *changeTracker) take(key types.NamespacedName) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	scan, ok := t.scans[key]
+	if !ok {
+		return nil
+	}
+	namespaces := make([]string, 0, len(scan.changed))
+	for ns := range scan.changed {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	scan.changed = make(map[string]bool)
+	return namespaces
+}
+
+// restore records namespaces as changed again after their rescan failed
+func (t *changeTracker) restore(key types.NamespacedName, namespaces []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	scan, ok := t.scans[key]
+	if !ok {
+		return
+	}
+	if len(scan.changed) == 0 {
+		scan.since = time.Now()
+	}
+	for _, ns := range namespaces {
+		scan.changed[ns] = true
+	}
+}
+
+// scanned records a complete full scan of generation
+func (t *changeTracker) scanned(key types.NamespacedName, generation int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if scan, ok := t.scans[key]; ok {
+		scan.generation = generation
+	}
+}
+
+// dueRescan returns the changed namespaces of a watch-mode KorpScan once its debounce has passed since
+// the first change and since its last scan, or else how long to wait for it
+func (r *KorpScanReconciler) dueRescan(korpScan *korpv1alpha1.KorpScan) ([]string, time.Duration) {
+	key := types.NamespacedName{Namespace: korpScan.Namespace, Name: korpScan.Name}
+	since, ok := r.changes.pending(key, korpScan.Generation)
+	// Rescans carry over the findings of the last scan, so its report must hold all of them
+	if !ok || !meta.IsStatusConditionTrue(korpScan.Status.Conditions, "FindingsStored") {
+		return nil, 0
+	}
+	if last := korpScan.Status.LastScanTime; last != nil && last.After(since) {
+		since = last.Time
+	}
+	if wait := time.Until(since.Add(korpScan.Spec.Watch.Debounce())); wait > 0 {
+		return nil, wait
+	}
+	return r.changes.take(key), 0
+}
+
+// lastFullScanTime returns when the last complete full scan finished; watch-mode rescans do not
+// postpone the next full scan
+func lastFullScanTime(korpScan *korpv1alpha1.KorpScan) time.Time {
+	for _, entry := range korpScan.Status.History {
+		if !entry.Partial && !entry.Incremental {
+			return entry.ScanTime.Time
+		}
+	}
+	return korpScan.Status.LastScanTime.Time
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package backup stores the manifests of resources cleanup deletes, so deletions can be undone
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// RunIDLayout formats the start time of a cleanup run (UTC) as its run ID
+const RunIDLayout = "20060102-150405"
+
+// Store keeps the manifests of one cleanup run
+type Store interface {
+	// Put stores the manifest of a resource and returns its location
+	Put(ctx context.Context, resourceType, namespace, name string, manifest []byte) (string, error)
+}
+
+// RunID returns the run ID of a cleanup run started at t
+func RunID(t time.Time) string {
+	return t.UTC().Format(RunIDLayout)
+}
+
+// NewStore returns the store spec selects for a cleanup run of korpScan.
+// Bucket credentials are read from Secrets in the KorpScan namespace.
+func NewStore(
+	ctx context.Context,
+	client kubernetes.Interface,
+	spec *korpv1alpha1.BackupSpec,
+	korpScan *korpv1alpha1.KorpScan,
+	run string,
+) (Store, error) {
+	if err := validate(spec); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case spec.ConfigMap:
+		return newConfigMapStore(client, korpScan, run), nil
+	case spec.Path != "":
+		return &fileStore{dir: filepath.Join(spec.Path, korpScan.Namespace, korpScan.Name, run)}, nil
+	}
+	return newBucketStore(ctx, client, spec.Bucket, korpScan.Namespace,
+		strings.Join([]string{korpScan.Namespace, korpScan.Name, run}, "/"))
+}
+
+// validate checks that spec selects exactly one destination
+func validate(spec *korpv1alpha1.BackupSpec) error {
+	set := 0
+	if spec.ConfigMap {
+		set++
+	}
+	if spec.Path != "" {
+		set++
+	}
+	if spec.Bucket != nil {
+		set++
+	}
+	if set != 1 {
+		return errors.New("backup must set exactly one of configMap, path and bucket")
+	}
+	return nil
+}
+
+// Key names the manifest of a resource within a run, e.g. "ConfigMap.default.app-config.yaml"
+func Key(resourceType, namespace, name string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s.%s.yaml", resourceType, name)
+	}
+	return fmt.Sprintf("%s.%s.%s.yaml", resourceType, namespace, name)
+}
+
+// Manifest renders an object as YAML. Managed fields are dropped; everything else is kept as read.
+func Manifest(obj *unstructured.Unstructured) ([]byte, error) {
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+	return yaml.Marshal(obj.Object)
+}
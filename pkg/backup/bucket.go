@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// defaultBucketRegion is the region requests are signed for when none is set
+const defaultBucketRegion = "us-east-1"
+
+// bucketStore keeps manifests in an S3-compatible bucket, signing requests with AWS Signature Version 4
+type bucketStore struct {
+	endpoint    *url.URL
+	region      string
+	bucket      string
+	prefix      string
+	credentials aws.Credentials
+	signer      *v4.Signer
+	http        *http.Client
+}
+
+// newBucketStore reads the bucket credentials from Secrets in namespace. Objects are kept under the bucket
+// prefix followed by base: "<namespace>/<korpscan>/<run>" to store a run, "<namespace>/<korpscan>" to read runs back.
+func newBucketStore(
+	ctx context.Context,
+	client kubernetes.Interface,
+	spec *korpv1alpha1.BackupBucket,
+	namespace, base string,
+) (*bucketStore, error) {
+	endpoint, err := url.Parse(spec.Endpoint)
+	if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+		return nil, fmt.Errorf("backup bucket endpoint %q is not an http(s) URL", spec.Endpoint)
+	}
+
+	s := &bucketStore{
+		endpoint: endpoint,
+		region:   spec.Region,
+		bucket:   spec.Name,
+		prefix:   strings.Trim(path.Join(spec.Prefix, base), "/"),
+		// S3 signs object keys as sent rather than escaping them a second time
+		signer: v4.NewSigner(func(o *v4.SignerOptions) { o.DisableURIPathEscaping = true }),
+		http:   &http.Client{Timeout: 30 * time.Second},
+	}
+	if s.region == "" {
+		s.region = defaultBucketRegion
+	}
+	if s.credentials.AccessKeyID, err = secretValue(ctx, client, namespace, spec.AccessKeyIDSecretRef); err != nil {
+		return nil, err
+	}
+	if s.credentials.SecretAccessKey, err = secretValue(ctx, client, namespace, spec.SecretAccessKeySecretRef); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// secretValue reads the value a SecretKeyRef selects
+func secretValue(ctx context.Context, client kubernetes.Interface, namespace string, ref korpv1alpha1.SecretKeyRef) (string, error) {
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("reading backup Secret %s: %w", ref.Name, err)
+	}
+	value := strings.TrimSpace(string(secret.Data[ref.Key]))
+	if value == "" {
+		return "", fmt.Errorf("backup Secret %s has no %s key", ref.Name, ref.Key)
+	}
+	return value, nil
+}
+
+// Put uploads the manifest as an object of its own
+func (s *bucketStore) Put(ctx context.Context, resourceType, namespace, name string, manifest []byte) (string, error) {
+	key := s.prefix + "/" + Key(resourceType, namespace, name)
+	resp, err := s.do(ctx, http.MethodPut, key, nil, manifest)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("uploading %s to bucket %s: %s: %s", key, s.bucket, resp.Status, bytes.TrimSpace(body))
+	}
+	return fmt.Sprintf("s3://%s/%s", s.bucket, key), nil
+}
+
+// Run lists the manifests a cleanup run uploaded
+func (s *bucketStore) Run(ctx context.Context, run string) ([]string, error) {
+	query := url.Values{"list-type": {"2"}, "prefix": {s.prefix + "/" + run + "/"}}
+	var locations []string
+	for {
+		resp, err := s.do(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Keys                  []string `xml:"Contents>Key"`
+			IsTruncated           bool     `xml:"IsTruncated"`
+			NextContinuationToken string   `xml:"NextContinuationToken"`
+		}
+		err = s.decode(resp, &page)
+		if err != nil {
+			return nil, fmt.Errorf("listing bucket %s: %w", s.bucket, err)
+		}
+		for _, key := range page.Keys {
+			locations = append(locations, fmt.Sprintf("s3://%s/%s", s.bucket, key))
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return locations, nil
+		}
+		query.Set("continuation-token", page.NextContinuationToken)
+	}
+}
+
+// Get downloads the manifest at an s3:// location of this KorpScan's backups
+func (s *bucketStore) Get(ctx context.Context, location string) ([]byte, error) {
+	key, ok := strings.CutPrefix(location, "s3://"+s.bucket+"/")
+	if !ok || !strings.HasPrefix(key, s.prefix+"/") {
+		return nil, fmt.Errorf("%s is not a backup of this KorpScan", location)
+	}
+	resp, err := s.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("downloading %s: %s: %s", location, resp.Status, bytes.TrimSpace(body))
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+}
+
+// decode reads an XML response of the bucket into v
+func (s *bucketStore) decode(resp *http.Response, v any) error {
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return xml.NewDecoder(resp.Body).Decode(v)
+}
+
+// do sends a signed path-style request for an object key of the bucket
+func (s *bucketStore) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+	u.RawPath = ""
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/yaml")
+	}
+	if err := s.sign(ctx, req, body, time.Now()); err != nil {
+		return nil, fmt.Errorf("signing bucket request: %w", err)
+	}
+	return s.http.Do(req)
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req
+func (s *bucketStore) sign(ctx context.Context, req *http.Request, body []byte, now time.Time) error {
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	return s.signer.SignHTTP(ctx, s.credentials, req, payloadHash, "s3", s.region, now)
+}
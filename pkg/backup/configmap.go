@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// RunLabel names the cleanup run whose manifests a backup ConfigMap or Secret holds
+const RunLabel = "korp.io/backup-run"
+
+// maxObjectSize is the size of the manifests one backup ConfigMap or Secret holds before the run continues in
+// the next one, leaving room for metadata below the 1 MiB the API server accepts
+const maxObjectSize = 900 << 10
+
+// configMapStore keeps the manifests of a run in ConfigMaps, and those of Secrets in Secrets, starting
+// another object whenever the current one is full: "<korpscan>-backup-<run>", then "<korpscan>-backup-<run>-1" and so on.
+// All are owned by the KorpScan, so scans do not report them and they are deleted with it.
+type configMapStore struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	meta      metav1.ObjectMeta
+
+	mu         sync.Mutex
+	configMaps objectParts
+	secrets    objectParts
+}
+
+// objectParts tracks the object of a run that manifests are currently added to
+type objectParts struct {
+	part int
+	size int
+}
+
+// next returns the part a manifest of size bytes goes to, moving on to a new part when the current one is full
+func (p *objectParts) next(size int) int {
+	if p.size > 0 && p.size+size > maxObjectSize {
+		p.part++
+		p.size = 0
+	}
+	p.size += size
+	return p.part
+}
+
+func newConfigMapStore(client kubernetes.Interface, korpScan *korpv1alpha1.KorpScan, run string) *configMapStore {
+	name := korpScan.Name + "-backup-" + run
+	return &configMapStore{
+		client:    client,
+		namespace: korpScan.Namespace,
+		name:      name,
+		meta: metav1.ObjectMeta{
+			Namespace: korpScan.Namespace,
+			Labels: map[string]string{
+				korpv1alpha1.ReportScanLabel: korpScan.Name,
+				RunLabel:                     run,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(korpScan, korpv1alpha1.GroupVersion.WithKind("KorpScan")),
+			},
+		},
+	}
+}
+
+// objectMeta returns the metadata of a part of the run
+func (s *configMapStore) objectMeta(part int) metav1.ObjectMeta {
+	meta := *s.meta.DeepCopy()
+	meta.Name = s.name
+	if part > 0 {
+		meta.Name = fmt.Sprintf("%s-%d", s.name, part)
+	}
+	return meta
+}
+
+// Put adds the manifest to the run's current ConfigMap, or Secret for Secrets, creating it on first use.
+// Manifests larger than a ConfigMap holds are rejected; path and bucket backups have no such limit.
+func (s *configMapStore) Put(ctx context.Context, resourceType, namespace, name string, manifest []byte) (string, error) {
+	key := Key(resourceType, namespace, name)
+	if len(manifest) > maxObjectSize {
+		return "", fmt.Errorf("manifest of %s %s is %d bytes, more than a backup ConfigMap or Secret holds (%d); back up to a path or bucket instead",
+			resourceType, name, len(manifest), maxObjectSize)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if resourceType == "Secret" {
+		return s.putSecret(ctx, s.objectMeta(s.secrets.next(len(manifest))), key, manifest)
+	}
+
+	meta := s.objectMeta(s.configMaps.next(len(manifest)))
+	location := fmt.Sprintf("configmap://%s/%s/%s", s.namespace, meta.Name, key)
+	_, err := s.client.CoreV1().ConfigMaps(s.namespace).Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: meta,
+		Data:       map[string]string{key: string(manifest)},
+	}, metav1.CreateOptions{})
+	if err == nil {
+		return location, nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	patch, err := json.Marshal(map[string]any{"data": map[string]string{key: string(manifest)}})
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.client.CoreV1().ConfigMaps(s.namespace).Patch(ctx, meta.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return "", err
+	}
+	return location, nil
+}
+
+// putSecret adds a Secret manifest to a Secret of the run, so Secret data is never copied into a ConfigMap
+func (s *configMapStore) putSecret(ctx context.Context, meta metav1.ObjectMeta, key string, manifest []byte) (string, error) {
+	location := fmt.Sprintf("secret://%s/%s/%s", s.namespace, meta.Name, key)
+	_, err := s.client.CoreV1().Secrets(s.namespace).Create(ctx, &corev1.Secret{
+		ObjectMeta: meta,
+		Data:       map[string][]byte{key: manifest},
+	}, metav1.CreateOptions{})
+	if err == nil {
+		return location, nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	patch, err := json.Marshal(map[string]any{"data": map[string][]byte{key: manifest}})
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.client.CoreV1().Secrets(s.namespace).Patch(ctx, meta.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return "", err
+	}
+	return location, nil
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+)
+
+// fileStore writes the manifests of a run to a directory, typically on a mounted PersistentVolumeClaim
+type fileStore struct {
+	dir string
+}
+
+// Put writes the manifest to a file of its own in the run's directory
+func (s *fileStore) Put(_ context.Context, resourceType, namespace, name string, manifest []byte) (string, error) {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(s.dir, Key(resourceType, namespace, name))
+	if err := os.WriteFile(path, manifest, 0o600); err != nil {
+		return "", err
+	}
+	return "file://" + path, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// Ref names a backed-up resource: its finding resource type, namespace (empty for cluster-scoped types) and name
+type Ref struct {
+	ResourceType string
+	Namespace    string
+	Name         string
+}
+
+// ParseRef parses "<Type>/<namespace>/<name>", or "<Type>/<name>" for cluster-scoped types.
+// The type is matched case-insensitively against finding resource types, e.g. configmap or ConfigMap.
+func ParseRef(s string) (Ref, error) {
+	parts := strings.Split(s, "/")
+	var ref Ref
+	switch len(parts) {
+	case 2:
+		ref = Ref{ResourceType: parts[0], Name: parts[1]}
+	case 3:
+		ref = Ref{ResourceType: parts[0], Namespace: parts[1], Name: parts[2]}
+	default:
+		return ref, fmt.Errorf("%q is not <type>/<namespace>/<name> or <type>/<name>", s)
+	}
+	for resourceType := range k8sutil.ResourceGVRs {
+		if strings.EqualFold(resourceType, ref.ResourceType) {
+			ref.ResourceType = resourceType
+			return ref, nil
+		}
+	}
+	return ref, fmt.Errorf("unknown resource type %q", ref.ResourceType)
+}
+
+// String formats r as ParseRef reads it
+func (r Ref) String() string {
+	if r.Namespace == "" {
+		return r.ResourceType + "/" + r.Name
+	}
+	return r.ResourceType + "/" + r.Namespace + "/" + r.Name
+}
+
+// Select returns the backup locations to restore. With a run ID, the manifests of that run are selected,
+// or only those of refs when given; without one, the backups of refs recorded by the last cleanup in deleted.
+func Select(ctx context.Context, src Source, deleted []korpv1alpha1.DeletedResource, run string, refs []Ref) ([]string, error) {
+	if run == "" {
+		var locations []string
+		for _, ref := range refs {
+			location := ""
+			for _, d := range deleted {
+				if d.ResourceType == ref.ResourceType && d.Namespace == ref.Namespace && d.Name == ref.Name && d.Backup != "" {
+					location = d.Backup
+				}
+			}
+			if location == "" {
+				return nil, fmt.Errorf("the last cleanup has no backup of %s; select the run that deleted it", ref)
+			}
+			locations = append(locations, location)
+		}
+		return locations, nil
+	}
+
+	if _, err := time.Parse(RunIDLayout, run); err != nil {
+		return nil, fmt.Errorf("%q is not a run ID (%s)", run, RunIDLayout)
+	}
+	all, err := src.Run(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("no backups of run %s", run)
+	}
+	if len(refs) == 0 {
+		return all, nil
+	}
+
+	var locations []string
+	for _, ref := range refs {
+		key := "/" + Key(ref.ResourceType, ref.Namespace, ref.Name)
+		location := ""
+		for _, l := range all {
+			if strings.HasSuffix(l, key) {
+				location = l
+			}
+		}
+		if location == "" {
+			return nil, fmt.Errorf("run %s has no backup of %s", run, ref)
+		}
+		locations = append(locations, location)
+	}
+	return locations, nil
+}
+
+// Decode parses a backed-up manifest and prepares it to be created again: fields the API server sets
+// are dropped, as are korp's orphan markers and the cluster IP of Services, which may be taken by now
+func Decode(manifest []byte) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(manifest, &obj.Object); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	if _, ok := k8sutil.ResourceGVRs[obj.GetKind()]; !ok {
+		return nil, fmt.Errorf("manifest of unknown kind %q", obj.GetKind())
+	}
+
+	for _, field := range []string{"resourceVersion", "uid", "creationTimestamp", "generation", "selfLink",
+		"deletionTimestamp", "deletionGracePeriodSeconds", "managedFields"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj.Object, "status")
+	if obj.GetKind() == "Service" {
+		unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+	}
+
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		delete(annotations, k8sutil.OrphanedSinceAnnotation)
+		delete(annotations, k8sutil.OrphanReasonAnnotation)
+		obj.SetAnnotations(annotations)
+	}
+	if labels := obj.GetLabels(); labels != nil {
+		delete(labels, k8sutil.OrphanedLabel)
+		obj.SetLabels(labels)
+	}
+	return obj, nil
+}
+
+// Restore creates a decoded resource again. It fails if a resource of the same name exists.
+func Restore(ctx context.Context, dyn dynamic.Interface, obj *unstructured.Unstructured) error {
+	gvr := k8sutil.ResourceGVRs[obj.GetKind()]
+	_, err := dyn.Resource(gvr).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{})
+	return err
+}
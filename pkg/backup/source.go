@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// maxManifestSize bounds a manifest read back from a backup
+const maxManifestSize = 16 << 20
+
+// Source reads back the manifests the cleanup runs of a KorpScan stored
+type Source interface {
+	// Run returns the locations of the manifests a cleanup run stored
+	Run(ctx context.Context, run string) ([]string, error)
+
+	// Get reads the manifest stored at a location
+	Get(ctx context.Context, location string) ([]byte, error)
+}
+
+// NewSource returns the source of the backups spec configures for korpScan
+func NewSource(
+	ctx context.Context,
+	client kubernetes.Interface,
+	spec *korpv1alpha1.BackupSpec,
+	korpScan *korpv1alpha1.KorpScan,
+) (Source, error) {
+	if err := validate(spec); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case spec.ConfigMap:
+		return &configMapSource{client: client, namespace: korpScan.Namespace, scan: korpScan.Name, prefix: korpScan.Name + "-backup-"}, nil
+	case spec.Path != "":
+		return &fileSource{root: filepath.Join(spec.Path, korpScan.Namespace, korpScan.Name)}, nil
+	}
+	return newBucketStore(ctx, client, spec.Bucket, korpScan.Namespace, korpScan.Namespace+"/"+korpScan.Name)
+}
+
+// configMapSource reads the run ConfigMaps and Secrets of a configMapStore
+type configMapSource struct {
+	client    kubernetes.Interface
+	namespace string
+	scan      string
+	prefix    string
+}
+
+// Run lists the keys of the run's ConfigMaps and Secrets
+func (s *configMapSource) Run(ctx context.Context, run string) ([]string, error) {
+	selector := labels.SelectorFromSet(labels.Set{korpv1alpha1.ReportScanLabel: s.scan, RunLabel: run}).String()
+	var locations []string
+	configMaps, err := s.client.CoreV1().ConfigMaps(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	for _, cm := range configMaps.Items {
+		for key := range cm.Data {
+			locations = append(locations, fmt.Sprintf("configmap://%s/%s/%s", s.namespace, cm.Name, key))
+		}
+	}
+	secrets, err := s.client.CoreV1().Secrets(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets.Items {
+		for key := range secret.Data {
+			locations = append(locations, fmt.Sprintf("secret://%s/%s/%s", s.namespace, secret.Name, key))
+		}
+	}
+	sort.Strings(locations)
+	return locations, nil
+}
+
+// Get reads a configmap:// or secret:// location of this KorpScan's backups
+func (s *configMapSource) Get(ctx context.Context, location string) ([]byte, error) {
+	scheme, rest, _ := strings.Cut(location, "://")
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) != 3 || parts[0] != s.namespace || !strings.HasPrefix(parts[1], s.prefix) {
+		return nil, fmt.Errorf("%s is not a backup of this KorpScan", location)
+	}
+	name, key := parts[1], parts[2]
+
+	switch scheme {
+	case "configmap":
+		cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if data, ok := cm.Data[key]; ok {
+			return []byte(data), nil
+		}
+	case "secret":
+		secret, err := s.client.CoreV1().Secrets(s.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if data, ok := secret.Data[key]; ok {
+			return data, nil
+		}
+	default:
+		return nil, fmt.Errorf("%s is not a backup of this KorpScan", location)
+	}
+	return nil, fmt.Errorf("backup %s/%s has no %s", s.namespace, name, key)
+}
+
+// fileSource reads the run directories of a fileStore
+type fileSource struct {
+	root string
+}
+
+// Run lists the manifest files in the run's directory
+func (s *fileSource) Run(_ context.Context, run string) ([]string, error) {
+	dir := filepath.Join(s.root, filepath.Base(run))
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var locations []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".yaml") {
+			locations = append(locations, "file://"+filepath.Join(dir, entry.Name()))
+		}
+	}
+	return locations, nil
+}
+
+// Get reads a file:// location. Only its run directory and file name are used, so backups copied to
+// another directory, e.g. out of the operator's volume, are read from there.
+func (s *fileSource) Get(_ context.Context, location string) ([]byte, error) {
+	path, ok := strings.CutPrefix(location, "file://")
+	if !ok {
+		return nil, fmt.Errorf("%s is not a backup of this KorpScan", location)
+	}
+	run, file := filepath.Base(filepath.Dir(path)), filepath.Base(path)
+	return os.ReadFile(filepath.Join(s.root, run, file))
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// remediate applies a remediation action to the resource of a finding
+func (c *Cleaner) remediate(ctx context.Context, finding korpv1alpha1.Finding, action string) error {
+	switch action {
+	case korpv1alpha1.ActionDelete:
+		return c.deleteResource(ctx, finding)
+	case korpv1alpha1.ActionScaleToZero:
+		return c.scaleToZero(ctx, finding)
+	case korpv1alpha1.ActionPatch:
+		return c.patchResource(ctx, finding)
+	case korpv1alpha1.ActionLabel:
+		return k8sutil.LabelOrphaned(ctx, c.dynamic, finding.ResourceType, finding.Namespace, finding.Name)
+	}
+	return fmt.Errorf("unknown remediation action %q", action)
+}
+
+// scaleToZero sets the replicas of a Deployment or StatefulSet to zero
+func (c *Cleaner) scaleToZero(ctx context.Context, finding korpv1alpha1.Finding) error {
+	patch := []byte(`{"spec":{"replicas":0}}`)
+	switch finding.ResourceType {
+	case "Deployment":
+		_, err := c.client.AppsV1().Deployments(finding.Namespace).Patch(ctx, finding.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	case "StatefulSet":
+		_, err := c.client.AppsV1().StatefulSets(finding.Namespace).Patch(ctx, finding.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	}
+	return fmt.Errorf("%s cannot be scaled", finding.ResourceType)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+const (
+	// ApprovalAnnotation on a cleanup candidate records a human decision: approved or rejected
+	ApprovalAnnotation = "korp.io/cleanup-approval"
+
+	// ApproverAnnotation optionally names who made the decision
+	ApproverAnnotation = "korp.io/cleanup-approver"
+)
+
+// approval reads the approval state of a finding from its resource's annotations. Who decided and when
+// come from ApproverAnnotation and from the managed fields entry that last wrote ApprovalAnnotation.
+func (c *Cleaner) approval(ctx context.Context, finding korpv1alpha1.Finding, action string) (korpv1alpha1.CleanupApproval, error) {
+	approval := korpv1alpha1.CleanupApproval{
+		ResourceType: finding.ResourceType,
+		Namespace:    finding.Namespace,
+		Name:         finding.Name,
+		Action:       action,
+		State:        korpv1alpha1.ApprovalPending,
+		RequestedAt:  metav1.Now(),
+	}
+
+	gvr, ok := k8sutil.ResourceGVRs[finding.ResourceType]
+	if !ok {
+		return approval, fmt.Errorf("unknown resource type %q", finding.ResourceType)
+	}
+	obj, err := c.dynamic.Resource(gvr).Namespace(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+	if err != nil {
+		return approval, err
+	}
+
+	switch strings.ToLower(obj.GetAnnotations()[ApprovalAnnotation]) {
+	case korpv1alpha1.ApprovalApproved:
+		approval.State = korpv1alpha1.ApprovalApproved
+	case korpv1alpha1.ApprovalRejected:
+		approval.State = korpv1alpha1.ApprovalRejected
+	default:
+		return approval, nil
+	}
+
+	field := []byte(`"f:` + ApprovalAnnotation + `"`)
+	for _, entry := range obj.GetManagedFields() {
+		if entry.FieldsV1 == nil || entry.Time == nil || !bytes.Contains(entry.FieldsV1.Raw, field) {
+			continue
+		}
+		if approval.DecidedAt == nil || approval.DecidedAt.Before(entry.Time) {
+			approval.DecidedAt = entry.Time
+			approval.Approver = entry.Manager
+		}
+	}
+	if approver := obj.GetAnnotations()[ApproverAnnotation]; approver != "" {
+		approval.Approver = approver
+	}
+	return approval, nil
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/backup"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// backUp stores the manifest of the resource of a finding and returns where it was stored
+func (r *Run) backUp(ctx context.Context, finding korpv1alpha1.Finding) (string, error) {
+	gvr, ok := k8sutil.ResourceGVRs[finding.ResourceType]
+	if !ok {
+		return "", fmt.Errorf("unknown resource type %q", finding.ResourceType)
+	}
+	obj, err := r.cleaner.dynamic.Resource(gvr).Namespace(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	manifest, err := backup.Manifest(obj)
+	if err != nil {
+		return "", err
+	}
+	return r.backup.Put(ctx, finding.ResourceType, finding.Namespace, finding.Name, manifest)
+}
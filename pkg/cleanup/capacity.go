@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// capacity is the storage and compute held by a resource
+type capacity struct {
+	storage resource.Quantity
+	cpu     resource.Quantity
+	memory  resource.Quantity
+}
+
+// add accumulates another capacity
+func (c *capacity) add(other capacity) {
+	c.storage.Add(other.storage)
+	c.cpu.Add(other.cpu)
+	c.memory.Add(other.memory)
+}
+
+// podRequests returns the CPU/memory requests of a pod template times replicas
+func podRequests(spec corev1.PodSpec, replicas int32) capacity {
+	var held capacity
+	for _, container := range spec.Containers {
+		if q, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+			held.cpu.Add(q)
+		}
+		if q, ok := container.Resources.Requests[corev1.ResourceMemory]; ok {
+			held.memory.Add(q)
+		}
+	}
+	held.cpu = *resource.NewMilliQuantity(held.cpu.MilliValue()*int64(replicas), resource.DecimalSI)
+	held.memory = *resource.NewQuantity(held.memory.Value()*int64(replicas), resource.BinarySI)
+	return held
+}
+
+// replicasOrOne returns the desired replica count, defaulting to one
+func replicasOrOne(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// resourceCapacity returns the storage or compute requests a resource holds.
+// Types without reclaimable capacity return zero.
+func (c *Cleaner) resourceCapacity(ctx context.Context, finding korpv1alpha1.Finding) (capacity, error) {
+	var held capacity
+	switch finding.ResourceType {
+	case "PersistentVolumeClaim":
+		obj, err := c.client.CoreV1().PersistentVolumeClaims(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return held, err
+		}
+		if q, ok := obj.Status.Capacity[corev1.ResourceStorage]; ok {
+			held.storage = q
+		} else if q, ok := obj.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+			held.storage = q
+		}
+	case "PersistentVolume":
+		obj, err := c.client.CoreV1().PersistentVolumes().Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return held, err
+		}
+		if q, ok := obj.Spec.Capacity[corev1.ResourceStorage]; ok {
+			held.storage = q
+		}
+	case "Deployment":
+		obj, err := c.client.AppsV1().Deployments(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return held, err
+		}
+		held = podRequests(obj.Spec.Template.Spec, replicasOrOne(obj.Spec.Replicas))
+	case "StatefulSet":
+		obj, err := c.client.AppsV1().StatefulSets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return held, err
+		}
+		held = podRequests(obj.Spec.Template.Spec, replicasOrOne(obj.Spec.Replicas))
+	case "ReplicaSet":
+		obj, err := c.client.AppsV1().ReplicaSets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return held, err
+		}
+		held = podRequests(obj.Spec.Template.Spec, replicasOrOne(obj.Spec.Replicas))
+	case "DaemonSet":
+		obj, err := c.client.AppsV1().DaemonSets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return held, err
+		}
+		held = podRequests(obj.Spec.Template.Spec, obj.Status.CurrentNumberScheduled)
+	}
+	return held, nil
+}
@@ -12,23 +12,36 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/backup"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/policy"
 )
 
 // Cleaner performs cleanup of orphaned resources
 type Cleaner struct {
-	client *kubernetes.Clientset
-	logger logr.Logger
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+	logger  logr.Logger
+
+	// permissions caches the answers of delete permission checks
+	permissions *permissionCache
 }
 
 // NewCleaner creates a new Cleaner instance
-func NewCleaner(client *kubernetes.Clientset, logger logr.Logger) *Cleaner {
+func NewCleaner(client kubernetes.Interface, dynamicClient dynamic.Interface, logger logr.Logger) *Cleaner {
 	return &Cleaner{
-		client: client,
-		logger: logger,
+		client:      client,
+		dynamic:     dynamicClient,
+		logger:      logger,
+		permissions: newPermissionCache(),
 	}
 }
 
@@ -37,21 +50,49 @@ type CleanupResult struct {
 	Summary          *korpv1alpha1.CleanupSummary
 	DeletedResources []korpv1alpha1.DeletedResource
 	FailedDeletions  []korpv1alpha1.FailedDeletion
+
+	// WouldRemediate lists what a dry run would have remediated
+	WouldRemediate []korpv1alpha1.PlannedRemediation
+
+	// Approvals lists the candidates of an approval-gated cleanup and their approval state
+	Approvals []korpv1alpha1.CleanupApproval
+
+	// BackupRun is the run ID manifests were backed up under, if any
+	BackupRun string
 }
 
-// Clean performs cleanup based on findings and cleanup spec
-func (c *Cleaner) Clean(ctx context.Context, findings []korpv1alpha1.Finding, spec *korpv1alpha1.CleanupSpec) (*CleanupResult, error) {
-	result := &CleanupResult{
-		Summary: &korpv1alpha1.CleanupSummary{
-			DryRun: spec.IsDryRun(),
-		},
-	}
+// Cleanup modes select when cleanup runs relative to the scan
+const (
+	// ModeAfterScan cleans once the whole scan is done
+	ModeAfterScan = "after-scan"
 
-	if !spec.Enabled {
-		c.logger.Info("Cleanup is disabled, skipping")
-		return result, nil
-	}
+	// ModePipeline cleans each namespace as soon as it is scanned
+	ModePipeline = "pipeline"
+)
+
+// Run is one cleanup run. Findings can be cleaned in batches, e.g. namespace by namespace as a
+// scan progresses; the deletion budget and the counts in the result span all batches.
+type Run struct {
+	cleaner      *Cleaner
+	spec         *korpv1alpha1.CleanupSpec
+	policies     *policy.Set
+	minAge       time.Duration
+	allowedTypes map[string]bool
+	result       *CleanupResult
 
+	// Capacity held by deleted resources
+	reclaimed capacity
+
+	// remediated counts remediations against the deletion budget
+	remediated int
+
+	// backup stores manifests before deletion; nil when cleanup does not back up
+	backup backup.Store
+}
+
+// Start begins a cleanup run with the cleanup spec.
+// Findings a KorpPolicy in policies protects or forbids deleting are never remediated.
+func (c *Cleaner) Start(spec *korpv1alpha1.CleanupSpec, policies *policy.Set) *Run {
 	minAge := time.Duration(spec.MinAgeDays) * 24 * time.Hour
 	if spec.MinAgeDays == 0 {
 		minAge = 7 * 24 * time.Hour // Default 7 days
@@ -65,14 +106,96 @@ func (c *Cleaner) Clean(ctx context.Context, findings []korpv1alpha1.Finding, sp
 		}
 	}
 
+	if !spec.Enabled {
+		c.logger.Info("Cleanup is disabled, skipping")
+	}
+
+	return &Run{
+		cleaner:      c,
+		spec:         spec,
+		policies:     policies,
+		minAge:       minAge,
+		allowedTypes: allowedTypes,
+		result: &CleanupResult{
+			Summary: &korpv1alpha1.CleanupSummary{
+				DryRun: spec.IsDryRun(),
+			},
+		},
+	}
+}
+
+// BackUpTo makes the run store the manifest of each resource in store, under the run ID run, before
+// deleting it. A resource whose manifest cannot be stored is not deleted.
+func (r *Run) BackUpTo(store backup.Store, run string) {
+	r.backup = store
+	r.result.BackupRun = run
+}
+
+// Clean performs cleanup based on findings and cleanup spec.
+// Findings a KorpPolicy in policies protects or forbids deleting are never remediated.
+func (c *Cleaner) Clean(ctx context.Context, findings []korpv1alpha1.Finding, spec *korpv1alpha1.CleanupSpec, policies *policy.Set) (*CleanupResult, error) {
+	run := c.Start(spec, policies)
+	run.Clean(ctx, findings)
+	return run.Result(), nil
+}
+
+// Clean remediates a batch of findings
+func (r *Run) Clean(ctx context.Context, findings []korpv1alpha1.Finding) {
+	c, spec, result := r.cleaner, r.spec, r.result
+	if !spec.Enabled {
+		return
+	}
+
 	for _, finding := range findings {
 		// Check if resource type is allowed for cleanup
-		if len(allowedTypes) > 0 && !c.isResourceTypeAllowed(finding.ResourceType, allowedTypes) {
+		if len(r.allowedTypes) > 0 && !c.isResourceTypeAllowed(finding.ResourceType, r.allowedTypes) {
 			continue
 		}
 
+		// Paused or progressing rollouts are not eligible unless explicitly included
+		if finding.Reason == k8sutil.PausedRolloutReason && !spec.IncludePausedRollouts {
+			continue
+		}
+
+		// A type policy may exclude its type, or override the age, dry run and action for it
+		typePolicy := spec.TypePolicy(k8sutil.SpecResourceTypes[finding.ResourceType])
+		if typePolicy != nil && typePolicy.Action == korpv1alpha1.ActionNone {
+			continue
+		}
+		minAge := r.minAge
+		if typePolicy != nil && typePolicy.MinAgeDays != nil {
+			minAge = time.Duration(*typePolicy.MinAgeDays) * 24 * time.Hour
+		}
+		dryRun := spec.IsDryRun() || (typePolicy != nil && typePolicy.DryRun)
+
 		result.Summary.TotalEligible++
 
+		// KorpPolicies are hard rules and win over every cleanup setting
+		name, err := c.forbiddingPolicy(ctx, finding, r.policies)
+		if err != nil {
+			c.logger.Error(err, "Failed to evaluate KorpPolicies, skipping resource",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name)
+			result.Summary.TotalFailed++
+			result.FailedDeletions = append(result.FailedDeletions, korpv1alpha1.FailedDeletion{
+				ResourceType: finding.ResourceType,
+				Namespace:    finding.Namespace,
+				Name:         finding.Name,
+				Error:        fmt.Sprintf("evaluating KorpPolicies: %v", err),
+			})
+			continue
+		}
+		if name != "" {
+			result.Summary.TotalSkippedPolicy++
+			c.logger.Info("Skipping resource protected by KorpPolicy",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name,
+				"policy", name)
+			continue
+		}
+
 		// Check age threshold
 		age := time.Since(finding.DetectedAt.Time)
 		if age < minAge {
@@ -86,6 +209,30 @@ func (c *Cleaner) Clean(ctx context.Context, findings []korpv1alpha1.Finding, sp
 			continue
 		}
 
+		// A resource must have been reported by enough successive scans, so a transient state is never cleaned
+		if n := spec.RequiredConsecutiveScans; n > 1 && finding.ConsecutiveScans < n {
+			result.Summary.TotalSkippedScans++
+			c.logger.V(1).Info("Skipping resource not yet reported by enough consecutive scans",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name,
+				"scans", finding.ConsecutiveScans,
+				"required", n)
+			continue
+		}
+
+		// Findings below the cleanup minimums stay reported but are left alone
+		if !finding.Meets(spec.MinSeverity, spec.MinConfidence) {
+			result.Summary.TotalSkippedSeverity++
+			c.logger.V(1).Info("Skipping resource below the minimum severity or confidence",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name,
+				"severity", finding.Severity,
+				"confidence", finding.Confidence)
+			continue
+		}
+
 		// Check preservation labels
 		if c.hasPreservationLabel(ctx, finding, spec.PreservationLabels) {
 			result.Summary.TotalSkippedPreserved++
@@ -96,24 +243,37 @@ func (c *Cleaner) Clean(ctx context.Context, findings []korpv1alpha1.Finding, sp
 			continue
 		}
 
-		// Perform deletion (or dry-run)
-		if spec.IsDryRun() {
-			c.logger.Info("[DRY-RUN] Would delete resource",
-				"type", finding.ResourceType,
-				"namespace", finding.Namespace,
-				"name", finding.Name,
-				"reason", finding.Reason)
-			result.Summary.TotalDeleted++
-			result.DeletedResources = append(result.DeletedResources, korpv1alpha1.DeletedResource{
-				ResourceType: finding.ResourceType,
-				Namespace:    finding.Namespace,
-				Name:         finding.Name,
-				DeletedAt:    metav1.Now(),
-			})
-		} else {
-			err := c.deleteResource(ctx, finding)
+		// Apply the suggested remediation, falling back to labeling when it is not allowed
+		action := finding.SuggestedAction
+		if action == "" {
+			action = korpv1alpha1.ActionDelete
+		}
+		// A type policy only replaces deletes: patch and label findings are on resources still in use
+		if action == korpv1alpha1.ActionDelete && typePolicy != nil && typePolicy.Action != "" {
+			action = typePolicy.Action
+		}
+		// A GitOps controller would re-create or revert anything else, so its resources are only labeled
+		if finding.GitOpsOwner != "" {
+			action = korpv1alpha1.ActionLabel
+		}
+		if !spec.IsActionAllowed(action) {
+			if !spec.IsActionAllowed(korpv1alpha1.ActionLabel) {
+				result.Summary.TotalSkippedAction++
+				c.logger.V(1).Info("Skipping resource because its action is not allowed",
+					"type", finding.ResourceType,
+					"namespace", finding.Namespace,
+					"name", finding.Name,
+					"action", action)
+				continue
+			}
+			action = korpv1alpha1.ActionLabel
+		}
+
+		// With approval required, only resources a human approved are remediated
+		if spec.RequireApproval {
+			approval, err := c.approval(ctx, finding, action)
 			if err != nil {
-				c.logger.Error(err, "Failed to delete resource",
+				c.logger.Error(err, "Failed to read cleanup approval, skipping resource",
 					"type", finding.ResourceType,
 					"namespace", finding.Namespace,
 					"name", finding.Name)
@@ -122,56 +282,171 @@ func (c *Cleaner) Clean(ctx context.Context, findings []korpv1alpha1.Finding, sp
 					ResourceType: finding.ResourceType,
 					Namespace:    finding.Namespace,
 					Name:         finding.Name,
-					Error:        err.Error(),
+					Error:        fmt.Sprintf("reading approval: %v", err),
+					Action:       action,
 				})
-			} else {
-				c.logger.Info("Deleted resource",
+				continue
+			}
+			result.Approvals = append(result.Approvals, approval)
+			switch approval.State {
+			case korpv1alpha1.ApprovalPending:
+				result.Summary.TotalPendingApproval++
+				continue
+			case korpv1alpha1.ApprovalRejected:
+				result.Summary.TotalRejected++
+				c.logger.V(1).Info("Skipping resource whose cleanup was rejected",
 					"type", finding.ResourceType,
 					"namespace", finding.Namespace,
-					"name", finding.Name)
-				result.Summary.TotalDeleted++
-				result.DeletedResources = append(result.DeletedResources, korpv1alpha1.DeletedResource{
+					"name", finding.Name,
+					"approver", approval.Approver)
+				continue
+			}
+		}
+
+		// The deletion budget bounds how many resources one run may change
+		if spec.DeletionBudget > 0 && r.remediated >= spec.DeletionBudget {
+			result.Summary.TotalSkippedBudget++
+			c.logger.V(1).Info("Skipping resource because the deletion budget is used up",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name,
+				"budget", spec.DeletionBudget)
+			continue
+		}
+		r.remediated++
+
+		// Measure capacity before the resource is gone or scaled down
+		var held capacity
+		if action == korpv1alpha1.ActionDelete || action == korpv1alpha1.ActionScaleToZero {
+			var err error
+			held, err = c.resourceCapacity(ctx, finding)
+			if err != nil {
+				c.logger.V(1).Info("Failed to read resource capacity",
+					"type", finding.ResourceType,
+					"namespace", finding.Namespace,
+					"name", finding.Name,
+					"error", err.Error())
+			}
+			if action == korpv1alpha1.ActionScaleToZero {
+				// Volume claims stay bound while the workload is scaled down
+				held.storage = resource.Quantity{}
+			}
+		}
+
+		// Perform the action (or dry-run)
+		var location string
+		if dryRun {
+			c.logger.Info("[DRY-RUN] Would remediate resource",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name,
+				"reason", finding.Reason,
+				"action", action)
+		} else {
+			if action == korpv1alpha1.ActionDelete && r.backup != nil {
+				var err error
+				if location, err = r.backUp(ctx, finding); err != nil {
+					c.logger.Error(err, "Failed to back up resource, not deleting it",
+						"type", finding.ResourceType,
+						"namespace", finding.Namespace,
+						"name", finding.Name)
+					result.Summary.TotalFailed++
+					result.FailedDeletions = append(result.FailedDeletions, korpv1alpha1.FailedDeletion{
+						ResourceType: finding.ResourceType,
+						Namespace:    finding.Namespace,
+						Name:         finding.Name,
+						Error:        fmt.Sprintf("backing up manifest: %v", err),
+						Action:       action,
+					})
+					continue
+				}
+			}
+			if err := c.remediate(ctx, finding, action); err != nil {
+				c.logger.Error(err, "Failed to remediate resource",
+					"type", finding.ResourceType,
+					"namespace", finding.Namespace,
+					"name", finding.Name,
+					"action", action)
+				result.Summary.TotalFailed++
+				result.FailedDeletions = append(result.FailedDeletions, korpv1alpha1.FailedDeletion{
 					ResourceType: finding.ResourceType,
 					Namespace:    finding.Namespace,
 					Name:         finding.Name,
-					DeletedAt:    metav1.Now(),
+					Error:        err.Error(),
+					Action:       action,
 				})
+				continue
+			}
+			c.logger.Info("Remediated resource",
+				"type", finding.ResourceType,
+				"namespace", finding.Namespace,
+				"name", finding.Name,
+				"action", action)
+		}
+
+		r.reclaimed.add(held)
+
+		// A dry run changes nothing, so it is reported apart from real remediations
+		if dryRun {
+			switch action {
+			case korpv1alpha1.ActionDelete:
+				result.Summary.TotalWouldDelete++
+			case korpv1alpha1.ActionScaleToZero:
+				result.Summary.TotalWouldScaleToZero++
+			case korpv1alpha1.ActionPatch:
+				result.Summary.TotalWouldPatch++
+			case korpv1alpha1.ActionLabel:
+				result.Summary.TotalWouldLabel++
 			}
+			result.WouldRemediate = append(result.WouldRemediate, korpv1alpha1.PlannedRemediation{
+				ResourceType: finding.ResourceType,
+				Namespace:    finding.Namespace,
+				Name:         finding.Name,
+				Action:       action,
+			})
+			continue
+		}
+
+		switch action {
+		case korpv1alpha1.ActionDelete:
+			result.Summary.TotalDeleted++
+		case korpv1alpha1.ActionScaleToZero:
+			result.Summary.TotalScaledToZero++
+		case korpv1alpha1.ActionPatch:
+			result.Summary.TotalPatched++
+		case korpv1alpha1.ActionLabel:
+			result.Summary.TotalLabeled++
 		}
+		result.DeletedResources = append(result.DeletedResources, korpv1alpha1.DeletedResource{
+			ResourceType: finding.ResourceType,
+			Namespace:    finding.Namespace,
+			Name:         finding.Name,
+			DeletedAt:    metav1.Now(),
+			Action:       action,
+			Backup:       location,
+		})
 	}
+}
 
-	return result, nil
+// Result returns the result of all batches cleaned so far
+func (r *Run) Result() *CleanupResult {
+	summary := r.result.Summary
+	if !r.reclaimed.storage.IsZero() {
+		summary.ReclaimedStorage = r.reclaimed.storage.String()
+	}
+	if !r.reclaimed.cpu.IsZero() {
+		summary.FreedCPU = r.reclaimed.cpu.String()
+	}
+	if !r.reclaimed.memory.IsZero() {
+		summary.FreedMemory = r.reclaimed.memory.String()
+	}
+	return r.result
 }
 
 // isResourceTypeAllowed checks if a resource type is in the allowed list
 func (c *Cleaner) isResourceTypeAllowed(resourceType string, allowedTypes map[string]bool) bool {
 	// Map Finding.ResourceType to spec resource type names
-	typeMapping := map[string]string{
-		"ConfigMap":               "configmaps",
-		"Secret":                  "secrets",
-		"PersistentVolumeClaim":   "pvcs",
-		"Service":                 "services",
-		"Deployment":              "deployments",
-		"StatefulSet":             "statefulsets",
-		"DaemonSet":               "daemonsets",
-		"Job":                     "jobs",
-		"CronJob":                 "cronjobs",
-		"ReplicaSet":              "replicasets",
-		"ServiceAccount":          "serviceaccounts",
-		"Ingress":                 "ingresses",
-		"Role":                    "roles",
-		"ClusterRole":             "clusterroles",
-		"RoleBinding":             "rolebindings",
-		"ClusterRoleBinding":      "clusterrolebindings",
-		"NetworkPolicy":           "networkpolicies",
-		"PodDisruptionBudget":     "poddisruptionbudgets",
-		"HorizontalPodAutoscaler": "hpas",
-		"PersistentVolume":        "pvs",
-		"Endpoints":               "endpoints",
-		"ResourceQuota":           "resourcequotas",
-	}
-
-	specType, ok := typeMapping[resourceType]
+	specType, ok := k8sutil.SpecResourceTypes[resourceType]
 	if !ok {
 		return false
 	}
@@ -322,18 +597,66 @@ func (c *Cleaner) getResourceLabels(ctx context.Context, finding korpv1alpha1.Fi
 			return nil, err
 		}
 		return obj.Labels, nil
+	case "FlowSchema":
+		obj, err := c.client.FlowcontrolV1().FlowSchemas().Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Labels, nil
+	case "PriorityLevelConfiguration":
+		obj, err := c.client.FlowcontrolV1().PriorityLevelConfigurations().Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Labels, nil
+	case "MutatingWebhookConfiguration":
+		obj, err := c.client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Labels, nil
+	case "ValidatingWebhookConfiguration":
+		obj, err := c.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Labels, nil
 	case "Endpoints":
 		obj, err := c.client.CoreV1().Endpoints(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return obj.Labels, nil
+	case "EndpointSlice":
+		obj, err := c.client.DiscoveryV1().EndpointSlices(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Labels, nil
 	case "ResourceQuota":
 		obj, err := c.client.CoreV1().ResourceQuotas(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 		return obj.Labels, nil
+	case "LimitRange":
+		obj, err := c.client.CoreV1().LimitRanges(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.Labels, nil
+	case "SecretProviderClass":
+		obj, err := c.dynamic.Resource(k8sutil.SecretProviderClassGVR).Namespace(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.GetLabels(), nil
+	case "ExternalSecret":
+		obj, err := c.dynamic.Resource(c.externalSecretGVR()).Namespace(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return obj.GetLabels(), nil
 	default:
 		return nil, fmt.Errorf("unsupported resource type: %s", finding.ResourceType)
 	}
@@ -384,11 +707,39 @@ func (c *Cleaner) deleteResource(ctx context.Context, finding korpv1alpha1.Findi
 		return c.client.AutoscalingV2().HorizontalPodAutoscalers(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
 	case "PersistentVolume":
 		return c.client.CoreV1().PersistentVolumes().Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "FlowSchema":
+		return c.client.FlowcontrolV1().FlowSchemas().Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "PriorityLevelConfiguration":
+		return c.client.FlowcontrolV1().PriorityLevelConfigurations().Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "MutatingWebhookConfiguration":
+		return c.client.AdmissionregistrationV1().MutatingWebhookConfigurations().Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "ValidatingWebhookConfiguration":
+		return c.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
 	case "Endpoints":
 		return c.client.CoreV1().Endpoints(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "EndpointSlice":
+		return c.client.DiscoveryV1().EndpointSlices(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
 	case "ResourceQuota":
 		return c.client.CoreV1().ResourceQuotas(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "LimitRange":
+		return c.client.CoreV1().LimitRanges(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "SecretProviderClass":
+		return c.dynamic.Resource(k8sutil.SecretProviderClassGVR).Namespace(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "ExternalSecret":
+		return c.dynamic.Resource(c.externalSecretGVR()).Namespace(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{PropagationPolicy: &deletePolicy})
+	case "Event":
+		// Events expire on their own, so one that is already gone counts as deleted
+		err := c.client.CoreV1().Events(finding.Namespace).Delete(ctx, finding.Name, metav1.DeleteOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
 	default:
 		return fmt.Errorf("unsupported resource type for deletion: %s", finding.ResourceType)
 	}
 }
+
+// externalSecretGVR returns the ExternalSecret API version the cluster serves
+func (c *Cleaner) externalSecretGVR() schema.GroupVersionResource {
+	return k8sutil.NewAPIVersions(c.client.Discovery()).GVR("ExternalSecret")
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// patchResource removes the dangling references a patch-remediated finding was reported for
+func (c *Cleaner) patchResource(ctx context.Context, finding korpv1alpha1.Finding) error {
+	switch finding.Reason {
+	case k8sutil.DanglingImagePullSecretReason, k8sutil.DanglingSecretReason:
+		return c.removeDanglingSecrets(ctx, finding.Namespace, finding.Name)
+	}
+	return fmt.Errorf("no patch remediation for reason %s", finding.Reason)
+}
+
+// removeDanglingSecrets drops the imagePullSecrets and secrets entries of a ServiceAccount whose Secret
+// no longer exists. Secrets are re-checked at cleanup time, and the patch carries the resourceVersion
+// so concurrent edits fail.
+func (c *Cleaner) removeDanglingSecrets(ctx context.Context, ns, name string) error {
+	sa, err := c.client.CoreV1().ServiceAccounts(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Each named Secret is looked up once, however many entries name it
+	found := make(map[string]bool)
+	exists := func(secret string) (bool, error) {
+		if ok, checked := found[secret]; checked {
+			return ok, nil
+		}
+		_, err := c.client.CoreV1().Secrets(ns).Get(ctx, secret, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+		found[secret] = err == nil
+		return found[secret], nil
+	}
+
+	pullSecrets := []corev1.LocalObjectReference{}
+	for _, ref := range sa.ImagePullSecrets {
+		ok, err := exists(ref.Name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			pullSecrets = append(pullSecrets, ref)
+		}
+	}
+	secrets := []corev1.ObjectReference{}
+	for _, ref := range sa.Secrets {
+		ok, err := exists(ref.Name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			secrets = append(secrets, ref)
+		}
+	}
+	if len(pullSecrets) == len(sa.ImagePullSecrets) && len(secrets) == len(sa.Secrets) {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata":         map[string]interface{}{"resourceVersion": sa.ResourceVersion},
+		"imagePullSecrets": pullSecrets,
+		"secrets":          secrets,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.client.CoreV1().ServiceAccounts(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
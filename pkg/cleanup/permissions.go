@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// cleanupResource is the API resource behind a cleanup resource type
+type cleanupResource struct {
+	group         string
+	resource      string
+	clusterScoped bool
+}
+
+// cleanupResources maps cleanup resource types to their API resources
+var cleanupResources = map[string]cleanupResource{
+	"configmaps":                      {group: "", resource: "configmaps"},
+	"secrets":                         {group: "", resource: "secrets"},
+	"pvcs":                            {group: "", resource: "persistentvolumeclaims"},
+	"services":                        {group: "", resource: "services"},
+	"serviceaccounts":                 {group: "", resource: "serviceaccounts"},
+	"endpoints":                       {group: "", resource: "endpoints"},
+	"endpointslices":                  {group: "discovery.k8s.io", resource: "endpointslices"},
+	"resourcequotas":                  {group: "", resource: "resourcequotas"},
+	"limitranges":                     {group: "", resource: "limitranges"},
+	"pvs":                             {group: "", resource: "persistentvolumes", clusterScoped: true},
+	"deployments":                     {group: "apps", resource: "deployments"},
+	"statefulsets":                    {group: "apps", resource: "statefulsets"},
+	"daemonsets":                      {group: "apps", resource: "daemonsets"},
+	"replicasets":                     {group: "apps", resource: "replicasets"},
+	"jobs":                            {group: "batch", resource: "jobs"},
+	"cronjobs":                        {group: "batch", resource: "cronjobs"},
+	"ingresses":                       {group: "networking.k8s.io", resource: "ingresses"},
+	"networkpolicies":                 {group: "networking.k8s.io", resource: "networkpolicies"},
+	"poddisruptionbudgets":            {group: "policy", resource: "poddisruptionbudgets"},
+	"hpas":                            {group: "autoscaling", resource: "horizontalpodautoscalers"},
+	"roles":                           {group: "rbac.authorization.k8s.io", resource: "roles"},
+	"rolebindings":                    {group: "rbac.authorization.k8s.io", resource: "rolebindings"},
+	"clusterroles":                    {group: "rbac.authorization.k8s.io", resource: "clusterroles", clusterScoped: true},
+	"clusterrolebindings":             {group: "rbac.authorization.k8s.io", resource: "clusterrolebindings", clusterScoped: true},
+	"secretproviderclasses":           {group: "secrets-store.csi.x-k8s.io", resource: "secretproviderclasses"},
+	"externalsecrets":                 {group: "external-secrets.io", resource: "externalsecrets"},
+	"flowschemas":                     {group: "flowcontrol.apiserver.k8s.io", resource: "flowschemas", clusterScoped: true},
+	"prioritylevelconfigurations":     {group: "flowcontrol.apiserver.k8s.io", resource: "prioritylevelconfigurations", clusterScoped: true},
+	"mutatingwebhookconfigurations":   {group: "admissionregistration.k8s.io", resource: "mutatingwebhookconfigurations", clusterScoped: true},
+	"validatingwebhookconfigurations": {group: "admissionregistration.k8s.io", resource: "validatingwebhookconfigurations", clusterScoped: true},
+}
+
+// MissingPermission is an RBAC rule the operator lacks for cleanup
+type MissingPermission struct {
+	// ResourceType is the cleanup resource type (e.g. "pvcs")
+	ResourceType string
+
+	Group    string
+	Resource string
+	Verb     string
+}
+
+// String renders the missing rule as group/resource/verb
+func (p MissingPermission) String() string {
+	group := p.Group
+	if group == "" {
+		group = "core"
+	}
+	return fmt.Sprintf("%s/%s/%s", group, p.Resource, p.Verb)
+}
+
+// permissionCacheTTL is how long the answer of a delete permission check is reused. RBAC changes
+// are picked up within this time.
+const permissionCacheTTL = 10 * time.Minute
+
+// permissionCache holds the answers of SelfSubjectAccessReviews, keyed by namespace/group/resource
+type permissionCache struct {
+	mu      sync.Mutex
+	entries map[string]permissionEntry
+}
+
+// permissionEntry is a cached permission check answer
+type permissionEntry struct {
+	allowed bool
+	checked time.Time
+}
+
+func newPermissionCache() *permissionCache {
+	return &permissionCache{entries: make(map[string]permissionEntry)}
+}
+
+// get returns the cached answer for key, if one younger than permissionCacheTTL exists
+func (c *permissionCache) get(key string) (allowed, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.checked) > permissionCacheTTL {
+		return false, false
+	}
+	return entry.allowed, true
+}
+
+func (c *permissionCache) put(key string, allowed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = permissionEntry{allowed: allowed, checked: time.Now()}
+}
+
+// MissingPermissions checks, via SelfSubjectAccessReview, whether the operator may
+// delete every resource type cleanup is enabled for in the target namespace ("" for all).
+// Answers are cached for permissionCacheTTL, so reconciles do not send a review per type each time.
+func (c *Cleaner) MissingPermissions(ctx context.Context, spec *korpv1alpha1.CleanupSpec, namespace string) ([]MissingPermission, error) {
+	types := spec.ResourceTypes
+	if len(types) == 0 {
+		for rt := range cleanupResources {
+			types = append(types, rt)
+		}
+		sort.Strings(types)
+	}
+
+	var missing []MissingPermission
+	for _, rt := range types {
+		res, ok := cleanupResources[rt]
+		if !ok {
+			continue
+		}
+
+		ns := namespace
+		if res.clusterScoped {
+			ns = ""
+		}
+
+		allowed, err := c.mayDelete(ctx, ns, res)
+		if err != nil {
+			return nil, fmt.Errorf("checking delete permission for %s: %w", rt, err)
+		}
+		if !allowed {
+			missing = append(missing, MissingPermission{
+				ResourceType: rt,
+				Group:        res.group,
+				Resource:     res.resource,
+				Verb:         "delete",
+			})
+		}
+	}
+
+	return missing, nil
+}
+
+// mayDelete reports whether the operator may delete res in ns, from the cache when it can
+func (c *Cleaner) mayDelete(ctx context.Context, ns string, res cleanupResource) (bool, error) {
+	key := ns + "/" + res.group + "/" + res.resource
+	if allowed, ok := c.permissions.get(key); ok {
+		return allowed, nil
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: ns,
+				Verb:      "delete",
+				Group:     res.group,
+				Resource:  res.resource,
+			},
+		},
+	}
+	resp, err := c.client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	c.permissions.put(key, resp.Status.Allowed)
+	return resp.Status.Allowed, nil
+}
+
+// WithoutResourceTypes returns a copy of the spec that does not clean up the types
+// of the given permissions, or nil when no resource type is left
+func WithoutResourceTypes(spec *korpv1alpha1.CleanupSpec, exclude []MissingPermission) *korpv1alpha1.CleanupSpec {
+	skip := make(map[string]bool, len(exclude))
+	for _, p := range exclude {
+		skip[p.ResourceType] = true
+	}
+
+	types := spec.ResourceTypes
+	if len(types) == 0 {
+		for rt := range cleanupResources {
+			types = append(types, rt)
+		}
+		sort.Strings(types)
+	}
+
+	out := spec.DeepCopy()
+	out.ResourceTypes = nil
+	for _, rt := range types {
+		if !skip[rt] {
+			out.ResourceTypes = append(out.ResourceTypes, rt)
+		}
+	}
+	if len(out.ResourceTypes) == 0 {
+		return nil
+	}
+	return out
+}
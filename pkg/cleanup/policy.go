@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package cleanup
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/policy"
+)
+
+// forbiddingPolicy returns the name of the KorpPolicy that forbids remediating finding, or "" if none does.
+// Label and annotation protections are checked against the live object, which may have changed since the scan.
+func (c *Cleaner) forbiddingPolicy(ctx context.Context, finding korpv1alpha1.Finding, policies *policy.Set) (string, error) {
+	if name := policies.ForbidsRemediation(finding); name != "" {
+		return name, nil
+	}
+	if !policies.InspectsObjects() {
+		return "", nil
+	}
+	gvr, ok := k8sutil.ResourceGVRs[finding.ResourceType]
+	if !ok {
+		return "", nil
+	}
+
+	obj, err := c.dynamic.Resource(gvr).Namespace(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return policies.Protects(obj), nil
+}
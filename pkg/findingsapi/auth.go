@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package findingsapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/authenticatorfactory"
+	"k8s.io/apiserver/pkg/authentication/request/headerrequest"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+	"k8s.io/client-go/kubernetes"
+)
+
+// requestHeaderConfigMap is where the API server publishes how it forwards authenticated users to aggregated APIs
+const (
+	requestHeaderConfigMapNamespace = "kube-system"
+	requestHeaderConfigMapName      = "extension-apiserver-authentication"
+)
+
+// Authorization decisions are cached as an aggregated API server caches them by default
+const (
+	allowCacheTTL = 10 * time.Second
+	denyCacheTTL  = 10 * time.Second
+)
+
+// delegatedAuth authenticates requests proxied by the API server and authorizes them with
+// SubjectAccessReviews, the same way an aggregated API server built on k8s.io/apiserver does
+type delegatedAuth struct {
+	requestHeader *authenticatorfactory.RequestHeaderConfig
+	authenticator authenticator.Request
+	authorizer    authorizer.Authorizer
+}
+
+// newDelegatedAuth reads the front-proxy settings from kube-system/extension-apiserver-authentication
+// and keeps them up to date until ctx ends
+func newDelegatedAuth(ctx context.Context, client kubernetes.Interface) (*delegatedAuth, error) {
+	clientCA, err := dynamiccertificates.NewDynamicCAFromConfigMapController("front-proxy-ca",
+		requestHeaderConfigMapNamespace, requestHeaderConfigMapName, "requestheader-client-ca-file", client)
+	if err != nil {
+		return nil, err
+	}
+	headers := headerrequest.NewRequestHeaderAuthRequestController(requestHeaderConfigMapName, requestHeaderConfigMapNamespace, client,
+		"requestheader-username-headers",
+		"requestheader-uid-headers",
+		"requestheader-group-headers",
+		"requestheader-extra-headers-prefix",
+		"requestheader-allowed-names")
+	if err := clientCA.RunOnce(ctx); err != nil {
+		return nil, fmt.Errorf("reading the front-proxy client CA: %w", err)
+	}
+	if err := headers.RunOnce(ctx); err != nil {
+		return nil, fmt.Errorf("reading the front-proxy request headers: %w", err)
+	}
+	go clientCA.Run(ctx, 1)
+	go headers.Run(ctx, 1)
+
+	requestHeader := &authenticatorfactory.RequestHeaderConfig{
+		UsernameHeaders:     headerrequest.StringSliceProviderFunc(headers.UsernameHeaders),
+		UIDHeaders:          headerrequest.StringSliceProviderFunc(headers.UIDHeaders),
+		GroupHeaders:        headerrequest.StringSliceProviderFunc(headers.GroupHeaders),
+		ExtraHeaderPrefixes: headerrequest.StringSliceProviderFunc(headers.ExtraHeaderPrefixes),
+		AllowedClientNames:  headerrequest.StringSliceProviderFunc(headers.AllowedClientNames),
+		CAContentProvider:   clientCA,
+	}
+	// Without a TokenReview client only proxied requests are authenticated
+	authn, _, err := authenticatorfactory.DelegatingAuthenticatorConfig{RequestHeaderConfig: requestHeader}.New()
+	if err != nil {
+		return nil, err
+	}
+	authz, err := authorizerfactory.DelegatingAuthorizerConfig{
+		SubjectAccessReviewClient: client.AuthorizationV1(),
+		AllowCacheTTL:             allowCacheTTL,
+		DenyCacheTTL:              denyCacheTTL,
+		WebhookRetryBackoff:       &wait.Backoff{Duration: 500 * time.Millisecond, Factor: 1.5, Jitter: 0.2, Steps: 5},
+	}.New()
+	if err != nil {
+		return nil, err
+	}
+	return &delegatedAuth{requestHeader: requestHeader, authenticator: authn, authorizer: authz}, nil
+}
@@ -0,0 +1,464 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package findingsapi
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/filters"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// Server serves the findings of the latest KorpReport of every KorpScan behind an APIService.
+// Every request is authorized with a SubjectAccessReview for the requesting user, so a RoleBinding
+// granting list on findings.korp.io/findings in a namespace is enough to see that namespace's findings.
+type Server struct {
+	addr    string
+	certDir string
+	cache   cache.Cache
+	reader  client.Reader
+	client  kubernetes.Interface
+	log     logr.Logger
+
+	// authorizer checks requests with cached SubjectAccessReviews; it is set by Start
+	authorizer authorizer.Authorizer
+
+	// generation counts changes to the latest reports of KorpScans seen by the informer
+	generation atomic.Int64
+
+	// mu guards the findings index, rebuilt from the informer cache once per change rather than per request.
+	// reports caches the findings of KorpReports, which are never changed once created.
+	// Reports that are no longer the latest of a KorpScan are dropped.
+	mu          sync.Mutex
+	built       int64
+	all         []Finding
+	byNamespace map[string][]Finding
+	reports     map[types.NamespacedName][]korpv1alpha1.Finding
+}
+
+// NewServer creates a Server listening on addr with the serving certificate in certDir (tls.crt and tls.key).
+// KorpScans are read from and watched through cache; KorpReports are read through reader.
+func NewServer(addr, certDir string, cache cache.Cache, reader client.Reader, clientset kubernetes.Interface, log logr.Logger) *Server {
+	return &Server{
+		addr:    addr,
+		certDir: certDir,
+		cache:   cache,
+		reader:  reader,
+		client:  clientset,
+		log:     log,
+		built:   -1,
+		reports: make(map[types.NamespacedName][]korpv1alpha1.Finding),
+	}
+}
+
+// NeedLeaderElection lets every operator replica serve, since the APIService's Service spreads requests over them
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the API until ctx ends
+func (s *Server) Start(ctx context.Context) error {
+	if s.certDir == "" {
+		return errors.New("the findings API needs a serving certificate the APIService's caBundle verifies")
+	}
+	cert, err := tls.LoadX509KeyPair(filepath.Join(s.certDir, "tls.crt"), filepath.Join(s.certDir, "tls.key"))
+	if err != nil {
+		return fmt.Errorf("loading findings API serving certificate: %w", err)
+	}
+	auth, err := newDelegatedAuth(ctx, s.client)
+	if err != nil {
+		return fmt.Errorf("configuring findings API authentication: %w", err)
+	}
+	s.authorizer = auth.authorizer
+
+	informer, err := s.cache.GetInformer(ctx, &korpv1alpha1.KorpScan{})
+	if err != nil {
+		return fmt.Errorf("watching KorpScans: %w", err)
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { s.generation.Add(1) },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldScan, ok1 := oldObj.(*korpv1alpha1.KorpScan)
+			newScan, ok2 := newObj.(*korpv1alpha1.KorpScan)
+			if !ok1 || !ok2 || oldScan.Status.LatestReport != newScan.Status.LatestReport {
+				s.generation.Add(1)
+			}
+		},
+		DeleteFunc: func(interface{}) { s.generation.Add(1) },
+	}); err != nil {
+		return fmt.Errorf("watching KorpScans: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /apis", s.handleGroupList)
+	mux.HandleFunc("GET /apis/"+Group, s.handleGroup)
+	mux.HandleFunc("GET /apis/"+GroupVersion, s.handleResourceList)
+	mux.HandleFunc("GET /apis/"+GroupVersion+"/"+Resource, s.handleList)
+	mux.HandleFunc("GET /apis/"+GroupVersion+"/namespaces/{namespace}/"+Resource, s.handleList)
+	mux.HandleFunc("GET /apis/"+GroupVersion+"/namespaces/{namespace}/"+Resource+"/{name}", s.handleGet)
+
+	unauthorized := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.log.V(1).Info("Rejected findings API request", "path", req.URL.Path)
+		writeStatus(w, apierrors.NewUnauthorized("requests must be proxied by the Kubernetes API server"))
+	})
+	server := &http.Server{
+		Addr:    s.addr,
+		Handler: filters.WithAuthentication(mux, auth.authenticator, unauthorized, nil, auth.requestHeader),
+		// The front-proxy client certificate is verified by the authenticator, against the CA it keeps up to date
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			ClientAuth:   tls.RequestClientCert,
+			MinVersion:   tls.VersionTLS12,
+		},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	s.log.Info("Serving findings API", "address", s.addr, "apiVersion", GroupVersion)
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// authorized checks the requesting user's access, writing the error response if access is denied
+func (s *Server) authorized(w http.ResponseWriter, req *http.Request, verb, namespace, name string) bool {
+	u, _ := genericapirequest.UserFrom(req.Context())
+	decision, _, err := s.authorizer.Authorize(req.Context(), authorizer.AttributesRecord{
+		User:            u,
+		Verb:            verb,
+		Namespace:       namespace,
+		APIGroup:        Group,
+		APIVersion:      Version,
+		Resource:        Resource,
+		Name:            name,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		s.log.Error(err, "Failed to authorize findings API request", "user", u.GetName())
+		writeStatus(w, apierrors.NewInternalError(errors.New("authorization failed")))
+		return false
+	}
+	if decision != authorizer.DecisionAllow {
+		message := fmt.Sprintf("user %q cannot %s %s", u.GetName(), verb, groupResource)
+		if namespace != "" {
+			message += fmt.Sprintf(" in namespace %q", namespace)
+		}
+		writeStatus(w, apierrors.NewForbidden(groupResource, name, errors.New(message)))
+		return false
+	}
+	return true
+}
+
+// handleGroupList serves discovery of the API groups this server provides
+func (s *Server) handleGroupList(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, http.StatusOK, &metav1.APIGroupList{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "APIGroupList"},
+		Groups:   []metav1.APIGroup{apiGroup()},
+	})
+}
+
+// handleGroup serves discovery of the findings group
+func (s *Server) handleGroup(w http.ResponseWriter, req *http.Request) {
+	group := apiGroup()
+	group.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "APIGroup"}
+	writeJSON(w, http.StatusOK, &group)
+}
+
+// handleResourceList serves discovery of the resources of findings.korp.io/v1alpha1
+func (s *Server) handleResourceList(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, http.StatusOK, &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{APIVersion: "v1", Kind: "APIResourceList"},
+		GroupVersion: GroupVersion,
+		APIResources: []metav1.APIResource{{
+			Name:         Resource,
+			SingularName: "finding",
+			Namespaced:   true,
+			Kind:         Kind,
+			Verbs:        metav1.Verbs{"get", "list"},
+		}},
+	})
+}
+
+// apiGroup describes the findings group and its only version
+func apiGroup() metav1.APIGroup {
+	version := metav1.GroupVersionForDiscovery{GroupVersion: GroupVersion, Version: Version}
+	return metav1.APIGroup{
+		Name:             Group,
+		Versions:         []metav1.GroupVersionForDiscovery{version},
+		PreferredVersion: version,
+	}
+}
+
+// handleList serves the findings of one namespace, or of all namespaces, matching the request's selectors
+func (s *Server) handleList(w http.ResponseWriter, req *http.Request) {
+	namespace := req.PathValue("namespace")
+	query := req.URL.Query()
+	if watch := query.Get("watch"); watch == "true" || watch == "1" {
+		writeStatus(w, apierrors.NewMethodNotSupported(groupResource, "watch"))
+		return
+	}
+	if !s.authorized(w, req, "list", namespace, "") {
+		return
+	}
+
+	fieldSelector, err := fields.ParseSelector(query.Get("fieldSelector"))
+	if err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	supported := (&Finding{}).fieldSet()
+	for _, r := range fieldSelector.Requirements() {
+		if _, ok := supported[r.Field]; !ok {
+			writeStatus(w, apierrors.NewBadRequest(fmt.Sprintf("field label not supported: %s", r.Field)))
+			return
+		}
+	}
+	labelSelector, err := labels.Parse(query.Get("labelSelector"))
+	if err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	all, err := s.findings(req.Context(), namespace)
+	if err != nil {
+		s.log.Error(err, "Failed to read findings")
+		writeStatus(w, apierrors.NewInternalError(err))
+		return
+	}
+	items := []Finding{}
+	for _, f := range all {
+		if fieldSelector.Matches(f.fieldSet()) && labelSelector.Matches(labels.Set(f.Labels)) {
+			items = append(items, f)
+		}
+	}
+
+	if wantsTable(req) {
+		writeJSON(w, http.StatusOK, table(items))
+		return
+	}
+	writeJSON(w, http.StatusOK, &FindingList{
+		TypeMeta: metav1.TypeMeta{APIVersion: GroupVersion, Kind: Kind + "List"},
+		Items:    items,
+	})
+}
+
+// handleGet serves a single finding
+func (s *Server) handleGet(w http.ResponseWriter, req *http.Request) {
+	namespace, name := req.PathValue("namespace"), req.PathValue("name")
+	if !s.authorized(w, req, "get", namespace, name) {
+		return
+	}
+
+	all, err := s.findings(req.Context(), namespace)
+	if err != nil {
+		s.log.Error(err, "Failed to read findings")
+		writeStatus(w, apierrors.NewInternalError(err))
+		return
+	}
+	for _, f := range all {
+		if f.Name != name {
+			continue
+		}
+		if wantsTable(req) {
+			writeJSON(w, http.StatusOK, table([]Finding{f}))
+			return
+		}
+		writeJSON(w, http.StatusOK, &f)
+		return
+	}
+	writeStatus(w, apierrors.NewNotFound(groupResource, name))
+}
+
+// findings returns the findings of the latest scan of every KorpScan in namespace (all namespaces if empty),
+// sorted by namespace and name. The returned slice is shared and must not be modified.
+func (s *Server) findings(ctx context.Context, namespace string) ([]Finding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generation := s.generation.Load(); generation != s.built {
+		if err := s.rebuild(ctx); err != nil {
+			return nil, err
+		}
+		s.built = generation
+	}
+	if namespace == "" {
+		return s.all, nil
+	}
+	return s.byNamespace[namespace], nil
+}
+
+// rebuild indexes the findings of the latest report of every cached KorpScan. Findings of cluster-scoped
+// resources are not served. A resource reported by several KorpScans is served once, with its earliest detection.
+func (s *Server) rebuild(ctx context.Context) error {
+	var scans korpv1alpha1.KorpScanList
+	if err := s.cache.List(ctx, &scans); err != nil {
+		return err
+	}
+	sort.Slice(scans.Items, func(i, j int) bool {
+		if scans.Items[i].Namespace != scans.Items[j].Namespace {
+			return scans.Items[i].Namespace < scans.Items[j].Namespace
+		}
+		return scans.Items[i].Name < scans.Items[j].Name
+	})
+
+	var items []Finding
+	index := make(map[types.NamespacedName]int)
+	latest := make(map[types.NamespacedName]bool)
+	for i := range scans.Items {
+		scan := &scans.Items[i]
+		if scan.Status.LatestReport == "" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: scan.Namespace, Name: scan.Status.LatestReport}
+		latest[key] = true
+		reported, err := s.reportFindings(ctx, key)
+		if err != nil {
+			return err
+		}
+		for _, f := range reported {
+			if f.Namespace == "" {
+				continue
+			}
+			item := newFinding(f, scan, key.Name)
+			id := types.NamespacedName{Namespace: item.Namespace, Name: item.Name}
+			if at, ok := index[id]; ok {
+				if item.DetectedAt.Before(&items[at].DetectedAt) {
+					items[at] = item
+				}
+				continue
+			}
+			index[id] = len(items)
+			items = append(items, item)
+		}
+	}
+	for key := range s.reports {
+		if !latest[key] {
+			delete(s.reports, key)
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Namespace != items[j].Namespace {
+			return items[i].Namespace < items[j].Namespace
+		}
+		return items[i].Name < items[j].Name
+	})
+	s.all = items
+	s.byNamespace = make(map[string][]Finding)
+	for start := 0; start < len(items); {
+		end := start + 1
+		for end < len(items) && items[end].Namespace == items[start].Namespace {
+			end++
+		}
+		s.byNamespace[items[start].Namespace] = items[start:end:end]
+		start = end
+	}
+	return nil
+}
+
+// reportFindings returns the findings of a KorpReport, reading it only the first time. A report
+// deleted since its KorpScan's status was written has no findings. s.mu must be held.
+func (s *Server) reportFindings(ctx context.Context, key types.NamespacedName) ([]korpv1alpha1.Finding, error) {
+	if findings, ok := s.reports[key]; ok {
+		return findings, nil
+	}
+
+	var report korpv1alpha1.KorpReport
+	if err := s.reader.Get(ctx, key, &report); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	s.reports[key] = report.Spec.Findings
+	return report.Spec.Findings, nil
+}
+
+// wantsTable reports whether the client asked for server-side printing, as kubectl get does
+func wantsTable(req *http.Request) bool {
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		if strings.Contains(accept, "as=Table") {
+			return true
+		}
+	}
+	return false
+}
+
+// table renders findings for kubectl get
+func table(items []Finding) *metav1.Table {
+	t := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "Table"},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: "Lowercase resource type and resource name"},
+			{Name: "Type", Type: "string", Description: "Kind of the flagged resource"},
+			{Name: "Reason", Type: "string", Description: "Why the resource is considered orphaned"},
+			{Name: "Action", Type: "string", Description: "Remediation cleanup applies"},
+			{Name: "Severity", Type: "string", Description: "How much the finding matters"},
+			{Name: "Confidence", Type: "string", Priority: 1, Description: "Whether the finding is definite or heuristic"},
+			{Name: "KorpScan", Type: "string", Priority: 1, Description: "KorpScan that reported the resource"},
+			{Name: "Age", Type: "string", Description: "Time since the resource was first reported"},
+		},
+		Rows: []metav1.TableRow{},
+	}
+	for i := range items {
+		f := &items[i]
+		meta, _ := json.Marshal(&metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "PartialObjectMetadata"},
+			ObjectMeta: f.ObjectMeta,
+		})
+		t.Rows = append(t.Rows, metav1.TableRow{
+			Cells:  []interface{}{f.Name, f.ResourceType, f.Reason, f.SuggestedAction, f.Severity, f.Confidence, f.KorpScan, duration.HumanDuration(time.Since(f.DetectedAt.Time))},
+			Object: runtime.RawExtension{Raw: meta},
+		})
+	}
+	return t
+}
+
+// writeJSON writes obj as the JSON response body
+func writeJSON(w http.ResponseWriter, code int, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+// writeStatus writes an API error as a Status object, as the API server does
+func writeStatus(w http.ResponseWriter, err *apierrors.StatusError) {
+	status := err.Status()
+	status.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}
+	writeJSON(w, int(status.Code), &status)
+}
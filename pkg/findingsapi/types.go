@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package findingsapi serves the findings of the latest scans as the aggregated API findings.korp.io/v1alpha1,
+// so namespace owners can `kubectl get findings` without read access to KorpScans or KorpReports
+package findingsapi
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// The group korp.io itself is served by korp's CRDs; an APIService for it would hide them,
+// so findings are served under a group of their own, as metrics.k8s.io is next to the core API
+const (
+	// Group is the API group of the findings API
+	Group = "findings.korp.io"
+
+	// Version is the API version of the findings API
+	Version = "v1alpha1"
+
+	// Resource is the plural resource name of Finding
+	Resource = "findings"
+
+	// Kind is the kind of a single finding
+	Kind = "Finding"
+)
+
+// GroupVersion is the apiVersion of served objects
+const GroupVersion = Group + "/" + Version
+
+// groupResource identifies findings in API errors
+var groupResource = schema.GroupResource{Group: Group, Resource: Resource}
+
+// Finding is one finding of the latest scan of a KorpScan, in the namespace of the flagged resource.
+// Its name is the lowercase resource type and the resource name, e.g. "configmap.app-config".
+type Finding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ResourceType is the kind of the flagged resource (ConfigMap, Secret, Service, etc.)
+	ResourceType string `json:"resourceType"`
+
+	// ResourceName is the name of the flagged resource
+	ResourceName string `json:"resourceName"`
+
+	// Reason explains why the resource is considered orphaned
+	Reason string `json:"reason"`
+
+	// SuggestedAction is the remediation cleanup applies: delete, scaleToZero, patch or label
+	SuggestedAction string `json:"suggestedAction,omitempty"`
+
+	// Severity is how much the finding matters: Low, Medium or High
+	Severity string `json:"severity,omitempty"`
+
+	// Confidence is how sure the detector is of the finding: definite or heuristic
+	Confidence string `json:"confidence,omitempty"`
+
+	// DetectedAt is when the resource was first reported
+	DetectedAt metav1.Time `json:"detectedAt"`
+
+	// KorpScan is the KorpScan that reported the resource, as "<namespace>/<name>"
+	KorpScan string `json:"korpScan"`
+
+	// Report is the KorpReport, in the KorpScan namespace, the finding is read from
+	Report string `json:"report"`
+}
+
+// FindingList is a list of findings
+type FindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Finding `json:"items"`
+}
+
+// newFinding converts a finding of a KorpReport of scan
+func newFinding(f korpv1alpha1.Finding, scan *korpv1alpha1.KorpScan, report string) Finding {
+	return Finding{
+		TypeMeta: metav1.TypeMeta{APIVersion: GroupVersion, Kind: Kind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fmt.Sprintf("%s.%s", strings.ToLower(f.ResourceType), f.Name),
+			Namespace:         f.Namespace,
+			CreationTimestamp: f.DetectedAt,
+			Labels:            map[string]string{korpv1alpha1.ReportScanLabel: scan.Name},
+		},
+		ResourceType:    f.ResourceType,
+		ResourceName:    f.Name,
+		Reason:          f.Reason,
+		SuggestedAction: f.SuggestedAction,
+		Severity:        f.Severity,
+		Confidence:      f.Confidence,
+		DetectedAt:      f.DetectedAt,
+		KorpScan:        scan.Namespace + "/" + scan.Name,
+		Report:          report,
+	}
+}
+
+// fieldSet returns the values field selectors match against; its keys are the fields that can be selected
+func (f *Finding) fieldSet() fields.Set {
+	return fields.Set{
+		"metadata.name":      f.Name,
+		"metadata.namespace": f.Namespace,
+		"resourceType":       f.ResourceType,
+		"resourceName":       f.ResourceName,
+		"reason":             f.Reason,
+		"suggestedAction":    f.SuggestedAction,
+		"severity":           f.Severity,
+		"confidence":         f.Confidence,
+		"korpScan":           f.KorpScan,
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultReferenceAnnotations are annotation keys used by common controllers to
+// reference ConfigMaps or Secrets by name
+var DefaultReferenceAnnotations = []string{
+	"nginx.ingress.kubernetes.io/auth-secret",
+	"nginx.ingress.kubernetes.io/auth-tls-secret",
+	"nginx.ingress.kubernetes.io/proxy-ssl-secret",
+	"nginx.ingress.kubernetes.io/custom-headers",
+	"configmap.reloader.stakater.com/reload",
+	"secret.reloader.stakater.com/reload",
+}
+
+// AnnotationReferences returns names of ConfigMaps/Secrets in a namespace that are
+// referenced through the given annotation keys on pods, workloads, services or ingresses.
+// Values may be comma-separated and may use the "namespace/name" form.
+func AnnotationReferences(ctx context.Context, client kubernetes.Interface, ns string, keys []string) (map[string]bool, error) {
+	refs := make(map[string]bool)
+	if len(keys) == 0 {
+		return refs, nil
+	}
+
+	var annotationSets []map[string]string
+
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		annotationSets = append(annotationSets, pod.Annotations)
+	}
+
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, dep := range deployments.Items {
+		annotationSets = append(annotationSets, dep.Annotations, dep.Spec.Template.Annotations)
+	}
+
+	statefulsets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, sts := range statefulsets.Items {
+		annotationSets = append(annotationSets, sts.Annotations, sts.Spec.Template.Annotations)
+	}
+
+	daemonsets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ds := range daemonsets.Items {
+		annotationSets = append(annotationSets, ds.Annotations, ds.Spec.Template.Annotations)
+	}
+
+	services, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range services.Items {
+		annotationSets = append(annotationSets, svc.Annotations)
+	}
+
+	ingresses, err := client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ing := range ingresses.Items {
+		annotationSets = append(annotationSets, ing.Annotations)
+	}
+
+	for _, annotations := range annotationSets {
+		for _, key := range keys {
+			value, ok := annotations[key]
+			if !ok {
+				continue
+			}
+			for _, ref := range strings.Split(value, ",") {
+				ref = strings.TrimSpace(ref)
+				if ref == "" {
+					continue
+				}
+				// "namespace/name" references only count for their own namespace
+				if refNs, name, found := strings.Cut(ref, "/"); found {
+					if ns != "" && refNs != ns {
+						continue
+					}
+					ref = name
+				}
+				refs[ref] = true
+			}
+		}
+	}
+
+	return refs, nil
+}
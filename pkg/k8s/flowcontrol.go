@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// isAPIServerManagedFlowControl reports whether the API server maintains an APF object itself:
+// the mandatory "exempt" and "catch-all" objects and suggested objects with auto-update enabled.
+// Deleting these is pointless because the API server recreates them.
+func isAPIServerManagedFlowControl(meta metav1.ObjectMeta) bool {
+	if meta.Name == flowcontrolv1.FlowSchemaNameExempt || meta.Name == flowcontrolv1.FlowSchemaNameCatchAll {
+		return true
+	}
+	return meta.Annotations[flowcontrolv1.AutoUpdateAnnotationKey] == "true"
+}
+
+// FlowSchemasWithMissingPriorityLevel returns the FlowSchemas whose PriorityLevelConfiguration does not exist
+func FlowSchemasWithMissingPriorityLevel(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
+	flowSchemas, err := client.FlowcontrolV1().FlowSchemas().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	priorityLevels, err := client.FlowcontrolV1().PriorityLevelConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool)
+	for _, plc := range priorityLevels.Items {
+		existing[plc.Name] = true
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, fs := range flowSchemas.Items {
+		if isAPIServerManagedFlowControl(fs.ObjectMeta) {
+			continue
+		}
+		if !existing[fs.Spec.PriorityLevelConfiguration.Name] {
+			orphans = append(orphans, fs.ObjectMeta)
+		}
+	}
+	return orphans, nil
+}
+
+// FlowSchemasWithDeletedSubjects returns the FlowSchemas whose subjects are all
+// ServiceAccounts in namespaces that no longer exist, so the schema matches no requests
+func FlowSchemasWithDeletedSubjects(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
+	flowSchemas, err := client.FlowcontrolV1().FlowSchemas().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool)
+	for _, ns := range namespaces.Items {
+		existing[ns.Name] = true
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, fs := range flowSchemas.Items {
+		if isAPIServerManagedFlowControl(fs.ObjectMeta) {
+			continue
+		}
+
+		subjects := 0
+		live := false
+		for _, rule := range fs.Spec.Rules {
+			for _, subject := range rule.Subjects {
+				subjects++
+				if subject.Kind != flowcontrolv1.SubjectKindServiceAccount || subject.ServiceAccount == nil ||
+					subject.ServiceAccount.Namespace == flowcontrolv1.NamespaceEvery || existing[subject.ServiceAccount.Namespace] {
+					live = true
+				}
+			}
+		}
+		if subjects > 0 && !live {
+			orphans = append(orphans, fs.ObjectMeta)
+		}
+	}
+	return orphans, nil
+}
+
+// OrphanPriorityLevelConfigurations returns the PriorityLevelConfigurations not referenced by any FlowSchema
+func OrphanPriorityLevelConfigurations(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
+	priorityLevels, err := client.FlowcontrolV1().PriorityLevelConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	flowSchemas, err := client.FlowcontrolV1().FlowSchemas().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, fs := range flowSchemas.Items {
+		referenced[fs.Spec.PriorityLevelConfiguration.Name] = true
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, plc := range priorityLevels.Items {
+		if isAPIServerManagedFlowControl(plc.ObjectMeta) {
+			continue
+		}
+		if !referenced[plc.Name] {
+			orphans = append(orphans, plc.ObjectMeta)
+		}
+	}
+	return orphans, nil
+}
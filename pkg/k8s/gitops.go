@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GitOpsManagedButUnusedReason marks findings whose resource a GitOps controller deploys, so deleting it
+// would only make the controller create it again
+const GitOpsManagedButUnusedReason = "GitOpsManagedButUnused"
+
+const (
+	// argoCDTrackingIDAnnotation is set by Argo CD's annotation tracking to "<app>:<group>/<kind>:<namespace>/<name>"
+	argoCDTrackingIDAnnotation = "argocd.argoproj.io/tracking-id"
+
+	// argoCDInstanceLabel is the tracking label Argo CD installations commonly configure instead of
+	// app.kubernetes.io/instance, which Helm charts set as well
+	argoCDInstanceLabel = "argocd.argoproj.io/instance"
+
+	// Flux's kustomize-controller and helm-controller label every object they apply with their source object
+	fluxKustomizationNameLabel      = "kustomize.toolkit.fluxcd.io/name"
+	fluxKustomizationNamespaceLabel = "kustomize.toolkit.fluxcd.io/namespace"
+	fluxHelmReleaseNameLabel        = "helm.toolkit.fluxcd.io/name"
+	fluxHelmReleaseNamespaceLabel   = "helm.toolkit.fluxcd.io/namespace"
+)
+
+// GitOpsOwner returns the GitOps object that deploys obj: "argocd:<application>" for Argo CD, or
+// "flux:Kustomization/<namespace>/<name>" and "flux:HelmRelease/<namespace>/<name>" for Flux.
+// It returns "" for objects no GitOps controller tracks.
+func GitOpsOwner(obj metav1.Object) string {
+	if id := obj.GetAnnotations()[argoCDTrackingIDAnnotation]; id != "" {
+		app, _, _ := strings.Cut(id, ":")
+		return "argocd:" + app
+	}
+	labels := obj.GetLabels()
+	if app := labels[argoCDInstanceLabel]; app != "" {
+		return "argocd:" + app
+	}
+	if name := labels[fluxKustomizationNameLabel]; name != "" {
+		return "flux:Kustomization/" + labels[fluxKustomizationNamespaceLabel] + "/" + name
+	}
+	if name := labels[fluxHelmReleaseNameLabel]; name != "" {
+		return "flux:HelmRelease/" + labels[fluxHelmReleaseNamespaceLabel] + "/" + name
+	}
+	return ""
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// informerSyncTimeout bounds how long the first List of a resource waits for its cache to fill
+// before it is sent to the API server instead, e.g. when korp may not watch the resource
+const informerSyncTimeout = 2 * time.Minute
+
+// informedResource is a resource Informers can cache, with the typed list its Lists return
+type informedResource struct {
+	gvr  schema.GroupVersionResource
+	list func(objs []runtime.Object) runtime.Object
+}
+
+// informedResources are the resources SnapshotClient snapshots, keyed by the same names.
+// Secrets and ConfigMaps are cached as metadata only: the detectors that read their data list them
+// from the API server, so no payload is kept in memory for the whole cluster.
+var informedResources = map[string]informedResource{
+	"pods": {corev1.SchemeGroupVersion.WithResource("pods"), func(objs []runtime.Object) runtime.Object {
+		return &corev1.PodList{Items: items[corev1.Pod](objs)}
+	}},
+	"services": {corev1.SchemeGroupVersion.WithResource("services"), func(objs []runtime.Object) runtime.Object {
+		return &corev1.ServiceList{Items: items[corev1.Service](objs)}
+	}},
+	"serviceaccounts": {corev1.SchemeGroupVersion.WithResource("serviceaccounts"), func(objs []runtime.Object) runtime.Object {
+		return &corev1.ServiceAccountList{Items: items[corev1.ServiceAccount](objs)}
+	}},
+	"secrets.metadata":    {secretsResource, metadataItems},
+	"configmaps.metadata": {configMapsResource, metadataItems},
+	"persistentvolumeclaims": {corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims"), func(objs []runtime.Object) runtime.Object {
+		return &corev1.PersistentVolumeClaimList{Items: items[corev1.PersistentVolumeClaim](objs)}
+	}},
+	"persistentvolumes": {corev1.SchemeGroupVersion.WithResource("persistentvolumes"), func(objs []runtime.Object) runtime.Object {
+		return &corev1.PersistentVolumeList{Items: items[corev1.PersistentVolume](objs)}
+	}},
+	"deployments": {appsv1.SchemeGroupVersion.WithResource("deployments"), func(objs []runtime.Object) runtime.Object {
+		return &appsv1.DeploymentList{Items: items[appsv1.Deployment](objs)}
+	}},
+	"statefulsets": {appsv1.SchemeGroupVersion.WithResource("statefulsets"), func(objs []runtime.Object) runtime.Object {
+		return &appsv1.StatefulSetList{Items: items[appsv1.StatefulSet](objs)}
+	}},
+	"daemonsets": {appsv1.SchemeGroupVersion.WithResource("daemonsets"), func(objs []runtime.Object) runtime.Object {
+		return &appsv1.DaemonSetList{Items: items[appsv1.DaemonSet](objs)}
+	}},
+	"replicasets": {appsv1.SchemeGroupVersion.WithResource("replicasets"), func(objs []runtime.Object) runtime.Object {
+		return &appsv1.ReplicaSetList{Items: items[appsv1.ReplicaSet](objs)}
+	}},
+	"jobs": {batchv1.SchemeGroupVersion.WithResource("jobs"), func(objs []runtime.Object) runtime.Object {
+		return &batchv1.JobList{Items: items[batchv1.Job](objs)}
+	}},
+	"cronjobs": {batchv1.SchemeGroupVersion.WithResource("cronjobs"), func(objs []runtime.Object) runtime.Object {
+		return &batchv1.CronJobList{Items: items[batchv1.CronJob](objs)}
+	}},
+	"roles": {rbacv1.SchemeGroupVersion.WithResource("roles"), func(objs []runtime.Object) runtime.Object {
+		return &rbacv1.RoleList{Items: items[rbacv1.Role](objs)}
+	}},
+	"rolebindings": {rbacv1.SchemeGroupVersion.WithResource("rolebindings"), func(objs []runtime.Object) runtime.Object {
+		return &rbacv1.RoleBindingList{Items: items[rbacv1.RoleBinding](objs)}
+	}},
+	"clusterroles": {rbacv1.SchemeGroupVersion.WithResource("clusterroles"), func(objs []runtime.Object) runtime.Object {
+		return &rbacv1.ClusterRoleList{Items: items[rbacv1.ClusterRole](objs)}
+	}},
+	"clusterrolebindings": {rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings"), func(objs []runtime.Object) runtime.Object {
+		return &rbacv1.ClusterRoleBindingList{Items: items[rbacv1.ClusterRoleBinding](objs)}
+	}},
+	"ingresses": {networkingv1.SchemeGroupVersion.WithResource("ingresses"), func(objs []runtime.Object) runtime.Object {
+		return &networkingv1.IngressList{Items: items[networkingv1.Ingress](objs)}
+	}},
+}
+
+// metadataResources are the informedResources cached through the metadata API
+var metadataResources = map[string]bool{"secrets.metadata": true, "configmaps.metadata": true}
+
+// metadataItems returns the cached object metadata as a metadata list
+func metadataItems(objs []runtime.Object) runtime.Object {
+	return &metav1.PartialObjectMetadataList{Items: items[metav1.PartialObjectMetadata](objs)}
+}
+
+// items copies the cached objects into the items of a typed list. The copies share their maps and
+// slices with the cache, so the list must be deep copied before it is handed out.
+func items[T any](objs []runtime.Object) []T {
+	out := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		if item, ok := any(obj).(*T); ok {
+			out = append(out, *item)
+		}
+	}
+	return out
+}
+
+// Informers keeps informer caches of the resources SnapshotClient snapshots, shared by all watch-mode
+// scans, and calls onChange with the namespace of every object added, changed or deleted after its
+// cache synced ("" for cluster-scoped objects). An informer is started the first time its resource is
+// listed, so resources no scan lists are never watched.
+type Informers struct {
+	factory         informers.SharedInformerFactory
+	metadataFactory metadatainformer.SharedInformerFactory
+	onChange        func(ns string)
+	stop            chan struct{}
+
+	mu      sync.Mutex
+	started map[string]informers.GenericInformer
+	waited  map[string]bool
+}
+
+// NewInformers creates Informers that watch through client, and through meta for resources cached
+// as metadata only. They run once Start is called.
+func NewInformers(client kubernetes.Interface, meta metadata.Interface, onChange func(ns string)) *Informers {
+	return &Informers{
+		factory:         informers.NewSharedInformerFactory(client, 0),
+		metadataFactory: metadatainformer.NewSharedInformerFactory(meta, 0),
+		onChange:        onChange,
+		stop:            make(chan struct{}),
+		started:         make(map[string]informers.GenericInformer),
+		waited:          make(map[string]bool),
+	}
+}
+
+// Start runs the informers until ctx ends, so Informers can be added to a controller manager
+func (i *Informers) Start(ctx context.Context) error {
+	<-ctx.Done()
+	close(i.stop)
+	i.factory.Shutdown()
+	i.metadataFactory.Shutdown()
+	return nil
+}
+
+// list returns the cached list of resource in ns, or of all namespaces when ns is "". It returns
+// false for resources it does not cache and while the cache of resource has not synced.
+func (i *Informers) list(ctx context.Context, resource, ns string) (runtime.Object, bool) {
+	if i == nil {
+		return nil, false
+	}
+	res, ok := informedResources[resource]
+	if !ok {
+		return nil, false
+	}
+	informer, err := i.informer(resource, res)
+	if err != nil || !i.synced(ctx, resource, informer) {
+		return nil, false
+	}
+
+	var objs []runtime.Object
+	if ns == "" {
+		objs, err = informer.Lister().List(labels.Everything())
+	} else {
+		objs, err = informer.Lister().ByNamespace(ns).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, false
+	}
+	// The API server lists in name order; detectors report in the order they list
+	sort.Slice(objs, func(a, b int) bool {
+		ma, mb := objs[a].(metav1.Object), objs[b].(metav1.Object)
+		if ma.GetNamespace() != mb.GetNamespace() {
+			return ma.GetNamespace() < mb.GetNamespace()
+		}
+		return ma.GetName() < mb.GetName()
+	})
+	return res.list(objs), true
+}
+
+// informer returns the informer of resource, starting it the first time
+func (i *Informers) informer(resource string, res informedResource) (informers.GenericInformer, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if informer, ok := i.started[resource]; ok {
+		return informer, nil
+	}
+
+	var informer informers.GenericInformer
+	if metadataResources[resource] {
+		informer = i.metadataFactory.ForResource(res.gvr)
+	} else {
+		var err error
+		if informer, err = i.factory.ForResource(res.gvr); err != nil {
+			return nil, err
+		}
+	}
+	// Managed fields are never read from listed objects and are a large part of their size
+	if err := informer.Informer().SetTransform(dropManagedFields); err != nil {
+		return nil, err
+	}
+	if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				i.changed(obj)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Resyncs deliver objects that did not change
+			if o, ok := oldObj.(metav1.Object); ok && o.GetResourceVersion() == newObj.(metav1.Object).GetResourceVersion() {
+				return
+			}
+			i.changed(newObj)
+		},
+		DeleteFunc: i.changed,
+	}); err != nil {
+		return nil, err
+	}
+	i.factory.Start(i.stop)
+	i.metadataFactory.Start(i.stop)
+	i.started[resource] = informer
+	return informer, nil
+}
+
+// synced reports whether the cache of resource has synced. Only the first List of a resource waits
+// for it; later ones use the API server until the cache catches up.
+func (i *Informers) synced(ctx context.Context, resource string, informer informers.GenericInformer) bool {
+	if informer.Informer().HasSynced() {
+		return true
+	}
+	i.mu.Lock()
+	waited := i.waited[resource]
+	i.waited[resource] = true
+	i.mu.Unlock()
+	if waited {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, informerSyncTimeout)
+	defer cancel()
+	return cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced)
+}
+
+// changed reports the namespace of a changed object
+func (i *Informers) changed(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if o, ok := obj.(metav1.Object); ok {
+		i.onChange(o.GetNamespace())
+	}
+}
+
+// dropManagedFields removes the managed fields of an object before it is cached
+func dropManagedFields(obj interface{}) (interface{}, error) {
+	if o, err := meta.Accessor(obj); err == nil {
+		o.SetManagedFields(nil)
+	}
+	return obj, nil
+}
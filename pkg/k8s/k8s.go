@@ -2,89 +2,104 @@ package k8s
 
 import (
 	"context"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 )
 
-// OrphanConfigMaps returns names of ConfigMaps without ownerReferences and not used by any pods.
-func OrphanConfigMaps(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
-	cms, err := client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
+// OrphanConfigMaps returns the ConfigMaps without ownerReferences and not used by any pods or workload pod templates.
+func OrphanConfigMaps(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	// Only metadata is needed, so the ConfigMaps' data is not listed
+	cms, err := ListMetadata(ctx, client, configMapsResource, ns, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	// Get all pods in the namespace
-	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	// Get all pods and workload pod templates in the namespace
+	specs, err := podSpecs(ctx, client, ns)
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, cm := range cms.Items {
 		// Skip if it has owner references
 		if len(cm.OwnerReferences) > 0 {
 			continue
 		}
 
-		// Check if any pod is using this ConfigMap
+		// Check if any pod or workload template is using this ConfigMap
 		isUsed := false
-		for _, pod := range pods.Items {
-			if isConfigMapUsedByPod(pod, cm.Name) {
+		for _, spec := range specs {
+			if isConfigMapUsedByPodSpec(spec, cm.Name) {
 				isUsed = true
 				break
 			}
 		}
 
-		// Only report as orphan if not used by any pod
+		// Only report as orphan if not used by any pod or workload
 		if !isUsed {
-			names = append(names, cm.Name)
+			orphans = append(orphans, cm.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanSecrets returns names of Secrets without ownerReferences and not used by any pods.
-func OrphanSecrets(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
-	items, err := client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{})
+// OrphanSecrets returns the Secrets without ownerReferences, not used by any pods or workload pod templates,
+// and not referenced by Ingress TLS or ServiceAccounts.
+func OrphanSecrets(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	// Only metadata is needed, so the Secrets' data is not listed
+	items, err := ListMetadata(ctx, client, secretsResource, ns, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	// Get all pods in the namespace
-	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	// Get all pods and workload pod templates in the namespace
+	specs, err := podSpecs(ctx, client, ns)
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	// Ingress TLS, ServiceAccount and token references
+	referenced, err := namespaceSecretReferences(ctx, client, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []metav1.ObjectMeta
 	for _, s := range items.Items {
-		// Skip if it has owner references
-		if len(s.OwnerReferences) > 0 {
+		// Skip if it has owner references or is referenced by a non-pod object
+		if len(s.OwnerReferences) > 0 || referenced[s.Name] {
 			continue
 		}
 
-		// Check if any pod is using this Secret
+		// Check if any pod or workload template is using this Secret
 		isUsed := false
-		for _, pod := range pods.Items {
-			if isSecretUsedByPod(pod, s.Name) {
+		for _, spec := range specs {
+			if isSecretUsedByPodSpec(spec, s.Name) {
 				isUsed = true
 				break
 			}
 		}
 
-		// Only report as orphan if not used by any pod
+		// Only report as orphan if not used by any pod or workload
 		if !isUsed {
-			names = append(names, s.Name)
+			orphans = append(orphans, s.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanPVCs returns names of PersistentVolumeClaims without ownerReferences and not used by any pods.
-func OrphanPVCs(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanPVCs returns the PersistentVolumeClaims without ownerReferences and not used by any pods.
+func OrphanPVCs(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	items, err := client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -96,7 +111,7 @@ func OrphanPVCs(ctx context.Context, client *kubernetes.Clientset, ns string) ([
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, p := range items.Items {
 		// Skip if it has owner references
 		if len(p.OwnerReferences) > 0 {
@@ -114,42 +129,49 @@ func OrphanPVCs(ctx context.Context, client *kubernetes.Clientset, ns string) ([
 
 		// Only report as orphan if not used by any pod
 		if !isUsed {
-			names = append(names, p.Name)
+			orphans = append(orphans, p.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// ServicesWithoutEndpoints returns service names that currently have no endpoints.
-func ServicesWithoutEndpoints(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// ServicesWithoutEndpoints returns the Services that currently have no endpoints.
+// EndpointSlices are listed once and matched by their service-name label. ExternalName services
+// and services without a selector have no managed endpoints and are never reported.
+func ServicesWithoutEndpoints(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	svcs, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
-	var names []string
+	slices, err := client.DiscoveryV1().EndpointSlices(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Count endpoints per service, keyed by namespace/name
+	endpoints := make(map[string]int)
+	for _, slice := range slices.Items {
+		if svc := slice.Labels[discoveryv1.LabelServiceName]; svc != "" {
+			endpoints[slice.Namespace+"/"+svc] += len(slice.Endpoints)
+		}
+	}
+
+	var orphans []metav1.ObjectMeta
 	for _, svc := range svcs.Items {
-		ep, err := client.CoreV1().Endpoints(svc.Namespace).Get(ctx, svc.Name, metav1.GetOptions{})
-		if err != nil {
-			// missing endpoints resource — treat as no endpoints
-			names = append(names, svc.Name)
+		if svc.Spec.Type == corev1.ServiceTypeExternalName || len(svc.Spec.Selector) == 0 {
 			continue
 		}
-		total := 0
-		for _, subset := range ep.Subsets {
-			total += len(subset.Addresses)
-			total += len(subset.NotReadyAddresses)
-		}
-		if total == 0 {
-			names = append(names, svc.Name)
+		if endpoints[svc.Namespace+"/"+svc.Name] == 0 {
+			orphans = append(orphans, svc.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// isConfigMapUsedByPod checks if a ConfigMap is referenced by a pod
-func isConfigMapUsedByPod(pod corev1.Pod, configMapName string) bool {
+// isConfigMapUsedByPodSpec checks if a ConfigMap is referenced by a pod spec
+func isConfigMapUsedByPodSpec(spec corev1.PodSpec, configMapName string) bool {
 	// Check volumes
-	for _, vol := range pod.Spec.Volumes {
+	for _, vol := range spec.Volumes {
 		if vol.ConfigMap != nil && vol.ConfigMap.Name == configMapName {
 			return true
 		}
@@ -163,9 +185,9 @@ func isConfigMapUsedByPod(pod corev1.Pod, configMapName string) bool {
 	}
 
 	// Check all containers (including init and ephemeral)
-	allContainers := append([]corev1.Container{}, pod.Spec.InitContainers...)
-	allContainers = append(allContainers, pod.Spec.Containers...)
-	for _, ec := range pod.Spec.EphemeralContainers {
+	allContainers := append([]corev1.Container{}, spec.InitContainers...)
+	allContainers = append(allContainers, spec.Containers...)
+	for _, ec := range spec.EphemeralContainers {
 		allContainers = append(allContainers, corev1.Container{
 			Env:     ec.Env,
 			EnvFrom: ec.EnvFrom,
@@ -192,10 +214,10 @@ func isConfigMapUsedByPod(pod corev1.Pod, configMapName string) bool {
 	return false
 }
 
-// isSecretUsedByPod checks if a Secret is referenced by a pod
-func isSecretUsedByPod(pod corev1.Pod, secretName string) bool {
+// isSecretUsedByPodSpec checks if a Secret is referenced by a pod spec
+func isSecretUsedByPodSpec(spec corev1.PodSpec, secretName string) bool {
 	// Check volumes
-	for _, vol := range pod.Spec.Volumes {
+	for _, vol := range spec.Volumes {
 		if vol.Secret != nil && vol.Secret.SecretName == secretName {
 			return true
 		}
@@ -206,19 +228,23 @@ func isSecretUsedByPod(pod corev1.Pod, secretName string) bool {
 				}
 			}
 		}
+		// CSI volumes (e.g. secrets-store) may reference a credentials Secret
+		if vol.CSI != nil && vol.CSI.NodePublishSecretRef != nil && vol.CSI.NodePublishSecretRef.Name == secretName {
+			return true
+		}
 	}
 
 	// Check imagePullSecrets
-	for _, ips := range pod.Spec.ImagePullSecrets {
+	for _, ips := range spec.ImagePullSecrets {
 		if ips.Name == secretName {
 			return true
 		}
 	}
 
 	// Check all containers (including init and ephemeral)
-	allContainers := append([]corev1.Container{}, pod.Spec.InitContainers...)
-	allContainers = append(allContainers, pod.Spec.Containers...)
-	for _, ec := range pod.Spec.EphemeralContainers {
+	allContainers := append([]corev1.Container{}, spec.InitContainers...)
+	allContainers = append(allContainers, spec.Containers...)
+	for _, ec := range spec.EphemeralContainers {
 		allContainers = append(allContainers, corev1.Container{
 			Env:     ec.Env,
 			EnvFrom: ec.EnvFrom,
@@ -255,37 +281,37 @@ func isPVCUsedByPod(pod corev1.Pod, pvcName string) bool {
 	return false
 }
 
-// OrphanDeployments returns names of Deployments with 0 replicas or no running pods
-func OrphanDeployments(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanDeployments returns the Deployments with 0 replicas or no running pods
+func OrphanDeployments(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, dep := range deployments.Items {
 		// Check if deployment has 0 replicas
 		if dep.Spec.Replicas != nil && *dep.Spec.Replicas == 0 {
-			names = append(names, dep.Name)
+			orphans = append(orphans, dep.ObjectMeta)
 			continue
 		}
 
 		// Check if deployment has no ready replicas
 		if dep.Status.ReadyReplicas == 0 && dep.Status.Replicas == 0 {
-			names = append(names, dep.Name)
+			orphans = append(orphans, dep.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanJobs returns names of completed Jobs older than 7 days
-func OrphanJobs(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanJobs returns the completed Jobs older than 7 days
+func OrphanJobs(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	jobs, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, job := range jobs.Items {
 		// Skip if it has owner references (managed by CronJob, etc)
 		if len(job.OwnerReferences) > 0 {
@@ -296,17 +322,23 @@ func OrphanJobs(ctx context.Context, client *kubernetes.Clientset, ns string) ([
 		if job.Status.Succeeded > 0 || job.Status.Failed > 0 {
 			if job.Status.CompletionTime != nil {
 				age := metav1.Now().Sub(job.Status.CompletionTime.Time)
+
+				// The TTL controller deletes finished Jobs itself; wait until their TTL has passed
+				if ttl := job.Spec.TTLSecondsAfterFinished; ttl != nil && age < time.Duration(*ttl)*time.Second {
+					continue
+				}
+
 				if age.Hours() > 168 { // 7 days
-					names = append(names, job.Name)
+					orphans = append(orphans, job.ObjectMeta)
 				}
 			}
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanIngresses returns names of Ingresses pointing to non-existent services
-func OrphanIngresses(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanIngresses returns the Ingresses pointing to non-existent services
+func OrphanIngresses(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	ingresses, err := client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -323,7 +355,7 @@ func OrphanIngresses(ctx context.Context, client *kubernetes.Clientset, ns strin
 		serviceMap[svc.Name] = true
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, ing := range ingresses.Items {
 		hasValidBackend := false
 
@@ -353,104 +385,135 @@ func OrphanIngresses(ctx context.Context, client *kubernetes.Clientset, ns strin
 
 		// If no valid backend service exists, consider it orphaned
 		if !hasValidBackend {
-			names = append(names, ing.Name)
+			orphans = append(orphans, ing.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanStatefulSets returns names of StatefulSets with 0 replicas or no ready pods
-func OrphanStatefulSets(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanStatefulSets returns the StatefulSets with 0 replicas or no ready pods
+func OrphanStatefulSets(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	statefulsets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, sts := range statefulsets.Items {
 		// Check if statefulset has 0 replicas
 		if sts.Spec.Replicas != nil && *sts.Spec.Replicas == 0 {
-			names = append(names, sts.Name)
+			orphans = append(orphans, sts.ObjectMeta)
 			continue
 		}
 
 		// Check if statefulset has no ready replicas
 		if sts.Status.ReadyReplicas == 0 && sts.Status.Replicas == 0 {
-			names = append(names, sts.Name)
+			orphans = append(orphans, sts.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanDaemonSets returns names of DaemonSets with no scheduled pods
-func OrphanDaemonSets(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanDaemonSets returns the DaemonSets with no scheduled pods
+func OrphanDaemonSets(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	daemonsets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, ds := range daemonsets.Items {
 		// Check if daemonset has no scheduled or ready pods
 		if ds.Status.DesiredNumberScheduled == 0 || ds.Status.NumberReady == 0 {
-			names = append(names, ds.Name)
+			orphans = append(orphans, ds.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanCronJobs returns names of CronJobs that are suspended with no recent successful jobs
-func OrphanCronJobs(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanCronJobs returns the CronJobs that are suspended with no recent successful jobs
+func OrphanCronJobs(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	cronjobs, err := client.BatchV1().CronJobs(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, cj := range cronjobs.Items {
 		// Check if cronjob is suspended
 		if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
 			// Check if no recent successful job (no last schedule time or very old)
 			if cj.Status.LastSuccessfulTime == nil {
-				names = append(names, cj.Name)
+				orphans = append(orphans, cj.ObjectMeta)
 				continue
 			}
 
 			// Consider orphaned if last success was more than 30 days ago
 			age := metav1.Now().Sub(cj.Status.LastSuccessfulTime.Time)
 			if age.Hours() > 720 { // 30 days
-				names = append(names, cj.Name)
+				orphans = append(orphans, cj.ObjectMeta)
 			}
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanReplicaSets returns names of ReplicaSets orphaned from deleted Deployments
-func OrphanReplicaSets(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanReplicaSets returns the ReplicaSets orphaned from deleted Deployments
+func OrphanReplicaSets(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	replicasets, err := client.AppsV1().ReplicaSets(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	// Deployments are used to recognise ReplicaSets whose ownerReferences were stripped
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var selectors []labels.Selector
+	for _, dep := range deployments.Items {
+		if dep.Spec.Selector == nil || len(dep.Spec.Selector.MatchLabels)+len(dep.Spec.Selector.MatchExpressions) == 0 {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(dep.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		selectors = append(selectors, selector)
+	}
+
+	var orphans []metav1.ObjectMeta
 	for _, rs := range replicasets.Items {
 		// Skip if it has owner references (managed by Deployment)
 		if len(rs.OwnerReferences) > 0 {
 			continue
 		}
 
+		// Skip if a Deployment still selects its pods by pod-template-hash labels
+		if _, ok := rs.Spec.Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok {
+			matched := false
+			for _, selector := range selectors {
+				if selector.Matches(labels.Set(rs.Spec.Template.Labels)) {
+					matched = true
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+		}
+
 		// Orphaned ReplicaSet - no owner and either 0 replicas or no ready pods
 		if (rs.Spec.Replicas != nil && *rs.Spec.Replicas == 0) ||
 			(rs.Status.ReadyReplicas == 0 && rs.Status.Replicas == 0) {
-			names = append(names, rs.Name)
+			orphans = append(orphans, rs.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanServiceAccounts returns names of ServiceAccounts not used by any pod
-func OrphanServiceAccounts(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanServiceAccounts returns the ServiceAccounts not used by any pod
+func OrphanServiceAccounts(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	serviceaccounts, err := client.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -471,7 +534,7 @@ func OrphanServiceAccounts(ctx context.Context, client *kubernetes.Clientset, ns
 		usedServiceAccounts[saName] = true
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, sa := range serviceaccounts.Items {
 		// Skip default service account
 		if sa.Name == "default" {
@@ -480,14 +543,81 @@ func OrphanServiceAccounts(ctx context.Context, client *kubernetes.Clientset, ns
 
 		// Check if used by any pod
 		if !usedServiceAccounts[sa.Name] {
-			names = append(names, sa.Name)
+			orphans = append(orphans, sa.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanRoles returns names of Roles not referenced by any RoleBinding
-func OrphanRoles(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// DanglingImagePullSecretReason is the finding reason for ServiceAccounts whose imagePullSecrets name missing Secrets
+const DanglingImagePullSecretReason = "DanglingImagePullSecret"
+
+// ServiceAccountsWithDanglingPullSecrets returns the ServiceAccounts with imagePullSecrets
+// entries pointing to Secrets that no longer exist
+func ServiceAccountsWithDanglingPullSecrets(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	serviceaccounts, err := client.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := ListMetadata(ctx, client, secretsResource, ns, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool)
+	for _, secret := range secrets.Items {
+		existing[secret.Name] = true
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, sa := range serviceaccounts.Items {
+		for _, ref := range sa.ImagePullSecrets {
+			if !existing[ref.Name] {
+				orphans = append(orphans, sa.ObjectMeta)
+				break
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// DanglingSecretReason is the finding reason for ServiceAccounts whose secrets list names missing Secrets
+const DanglingSecretReason = "DanglingSecret"
+
+// ServiceAccountsWithDanglingSecrets returns the ServiceAccounts whose secrets list has entries
+// for Secrets that no longer exist. Clusters upgraded past 1.24 keep these entries after their
+// legacy token Secrets are cleaned up.
+func ServiceAccountsWithDanglingSecrets(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	serviceaccounts, err := client.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := ListMetadata(ctx, client, secretsResource, ns, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool)
+	for _, secret := range secrets.Items {
+		existing[secret.Name] = true
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, sa := range serviceaccounts.Items {
+		for _, ref := range sa.Secrets {
+			if !existing[ref.Name] {
+				orphans = append(orphans, sa.ObjectMeta)
+				break
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// OrphanRoles returns the Roles not referenced by any RoleBinding
+func OrphanRoles(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	roles, err := client.RbacV1().Roles(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -506,7 +636,7 @@ func OrphanRoles(ctx context.Context, client *kubernetes.Clientset, ns string) (
 		}
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, role := range roles.Items {
 		// Skip system roles (prefixed with system:)
 		if len(role.Name) > 7 && role.Name[:7] == "system:" {
@@ -514,14 +644,14 @@ func OrphanRoles(ctx context.Context, client *kubernetes.Clientset, ns string) (
 		}
 
 		if !referencedRoles[role.Name] {
-			names = append(names, role.Name)
+			orphans = append(orphans, role.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanClusterRoles returns names of ClusterRoles not referenced by any ClusterRoleBinding or RoleBinding
-func OrphanClusterRoles(ctx context.Context, client *kubernetes.Clientset) ([]string, error) {
+// OrphanClusterRoles returns the ClusterRoles not referenced by any ClusterRoleBinding or RoleBinding
+func OrphanClusterRoles(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
 	clusterRoles, err := client.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -552,7 +682,7 @@ func OrphanClusterRoles(ctx context.Context, client *kubernetes.Clientset) ([]st
 		}
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, cr := range clusterRoles.Items {
 		// Skip system cluster roles
 		if len(cr.Name) > 7 && cr.Name[:7] == "system:" {
@@ -568,14 +698,14 @@ func OrphanClusterRoles(ctx context.Context, client *kubernetes.Clientset) ([]st
 		}
 
 		if !referencedClusterRoles[cr.Name] {
-			names = append(names, cr.Name)
+			orphans = append(orphans, cr.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanRoleBindings returns names of RoleBindings that reference non-existent Roles or ServiceAccounts
-func OrphanRoleBindings(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanRoleBindings returns the RoleBindings that reference non-existent Roles or ServiceAccounts
+func OrphanRoleBindings(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	roleBindings, err := client.RbacV1().RoleBindings(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -602,7 +732,7 @@ func OrphanRoleBindings(ctx context.Context, client *kubernetes.Clientset, ns st
 		existingClusterRoles[cr.Name] = true
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, rb := range roleBindings.Items {
 		isOrphan := false
 
@@ -637,14 +767,14 @@ func OrphanRoleBindings(ctx context.Context, client *kubernetes.Clientset, ns st
 		}
 
 		if isOrphan {
-			names = append(names, rb.Name)
+			orphans = append(orphans, rb.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanClusterRoleBindings returns names of ClusterRoleBindings that reference non-existent ClusterRoles or ServiceAccounts
-func OrphanClusterRoleBindings(ctx context.Context, client *kubernetes.Clientset) ([]string, error) {
+// OrphanClusterRoleBindings returns the ClusterRoleBindings that reference non-existent ClusterRoles or ServiceAccounts
+func OrphanClusterRoleBindings(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
 	clusterRoleBindings, err := client.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -661,7 +791,7 @@ func OrphanClusterRoleBindings(ctx context.Context, client *kubernetes.Clientset
 		existingClusterRoles[cr.Name] = true
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, crb := range clusterRoleBindings.Items {
 		// Skip system cluster role bindings
 		if len(crb.Name) > 7 && crb.Name[:7] == "system:" {
@@ -689,10 +819,10 @@ func OrphanClusterRoleBindings(ctx context.Context, client *kubernetes.Clientset
 		}
 
 		if isOrphan {
-			names = append(names, crb.Name)
+			orphans = append(orphans, crb.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
 // isBuiltInClusterRole checks if a cluster role is a built-in Kubernetes role
@@ -711,8 +841,8 @@ func isBuiltInClusterRole(name string) bool {
 	return builtInRoles[name]
 }
 
-// OrphanNetworkPolicies returns names of NetworkPolicies whose podSelector matches no pods
-func OrphanNetworkPolicies(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+// OrphanNetworkPolicies returns the NetworkPolicies whose podSelector matches no pods
+func OrphanNetworkPolicies(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	policies, err := client.NetworkingV1().NetworkPolicies(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -723,7 +853,7 @@ func OrphanNetworkPolicies(ctx context.Context, client *kubernetes.Clientset, ns
 		return nil, err
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, policy := range policies.Items {
 		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
 		if err != nil {
@@ -744,15 +874,16 @@ func OrphanNetworkPolicies(ctx context.Context, client *kubernetes.Clientset, ns
 		}
 
 		if !hasMatchingPod {
-			names = append(names, policy.Name)
+			orphans = append(orphans, policy.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanPodDisruptionBudgets returns names of PDBs whose selector matches no pods
-func OrphanPodDisruptionBudgets(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
-	pdbs, err := client.PolicyV1().PodDisruptionBudgets(ns).List(ctx, metav1.ListOptions{})
+// OrphanPodDisruptionBudgets returns the PDBs whose selector matches no pods.
+// version is the policy API version to read, "v1" or "v1beta1" on clusters older than 1.21.
+func OrphanPodDisruptionBudgets(ctx context.Context, client kubernetes.Interface, ns, version string) ([]metav1.ObjectMeta, error) {
+	pdbs, err := podDisruptionBudgetSelectors(ctx, client, ns, version)
 	if err != nil {
 		return nil, err
 	}
@@ -762,13 +893,13 @@ func OrphanPodDisruptionBudgets(ctx context.Context, client *kubernetes.Clientse
 		return nil, err
 	}
 
-	var names []string
-	for _, pdb := range pdbs.Items {
-		if pdb.Spec.Selector == nil {
+	var orphans []metav1.ObjectMeta
+	for _, pdb := range pdbs {
+		if pdb.selector == nil {
 			continue
 		}
 
-		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		selector, err := metav1.LabelSelectorAsSelector(pdb.selector)
 		if err != nil {
 			continue
 		}
@@ -787,22 +918,53 @@ func OrphanPodDisruptionBudgets(ctx context.Context, client *kubernetes.Clientse
 		}
 
 		if !hasMatchingPod {
-			names = append(names, pdb.Name)
+			orphans = append(orphans, pdb.meta)
 		}
 	}
-	return names, nil
+	return orphans, nil
+}
+
+// namedSelector is the pod selector of a PodDisruptionBudget, in any API version
+type namedSelector struct {
+	meta     metav1.ObjectMeta
+	selector *metav1.LabelSelector
 }
 
-// OrphanHPAs returns names of HPAs targeting non-existent Deployments/StatefulSets
-func OrphanHPAs(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
-	hpas, err := client.AutoscalingV2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+// podDisruptionBudgetSelectors lists the selectors of the PDBs in ns through the given policy API version
+func podDisruptionBudgetSelectors(ctx context.Context, client kubernetes.Interface, ns, version string) ([]namedSelector, error) {
+	var selectors []namedSelector
+	switch version {
+	case "v1beta1":
+		pdbs, err := client.PolicyV1beta1().PodDisruptionBudgets(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pdb := range pdbs.Items {
+			selectors = append(selectors, namedSelector{meta: pdb.ObjectMeta, selector: pdb.Spec.Selector})
+		}
+	default:
+		pdbs, err := client.PolicyV1().PodDisruptionBudgets(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pdb := range pdbs.Items {
+			selectors = append(selectors, namedSelector{meta: pdb.ObjectMeta, selector: pdb.Spec.Selector})
+		}
+	}
+	return selectors, nil
+}
+
+// OrphanHPAs returns the HPAs targeting non-existent Deployments/StatefulSets.
+// version is the autoscaling API version to read: "v2", or "v2beta2" or "v1" on clusters older than 1.23.
+func OrphanHPAs(ctx context.Context, client kubernetes.Interface, ns, version string) ([]metav1.ObjectMeta, error) {
+	hpas, err := scaleTargets(ctx, client, ns, version)
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
-	for _, hpa := range hpas.Items {
-		targetRef := hpa.Spec.ScaleTargetRef
+	var orphans []metav1.ObjectMeta
+	for _, hpa := range hpas {
+		targetRef := hpa.target
 		targetExists := false
 
 		switch targetRef.Kind {
@@ -827,68 +989,330 @@ func OrphanHPAs(ctx context.Context, client *kubernetes.Clientset, ns string) ([
 		}
 
 		if !targetExists {
-			names = append(names, hpa.Name)
+			orphans = append(orphans, hpa.meta)
+		}
+	}
+	return orphans, nil
+}
+
+// scaleTarget is the scale target of a HorizontalPodAutoscaler, in any API version
+type scaleTarget struct {
+	meta   metav1.ObjectMeta
+	target autoscalingv2.CrossVersionObjectReference
+}
+
+// scaleTargets lists the scale targets of the HPAs in ns through the given autoscaling API version
+func scaleTargets(ctx context.Context, client kubernetes.Interface, ns, version string) ([]scaleTarget, error) {
+	var targets []scaleTarget
+	switch version {
+	case "v1":
+		hpas, err := client.AutoscalingV1().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, hpa := range hpas.Items {
+			ref := hpa.Spec.ScaleTargetRef
+			targets = append(targets, scaleTarget{meta: hpa.ObjectMeta, target: autoscalingv2.CrossVersionObjectReference{
+				Kind: ref.Kind, Name: ref.Name, APIVersion: ref.APIVersion}})
+		}
+	case "v2beta2":
+		hpas, err := client.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, hpa := range hpas.Items {
+			ref := hpa.Spec.ScaleTargetRef
+			targets = append(targets, scaleTarget{meta: hpa.ObjectMeta, target: autoscalingv2.CrossVersionObjectReference{
+				Kind: ref.Kind, Name: ref.Name, APIVersion: ref.APIVersion}})
+		}
+	default:
+		hpas, err := client.AutoscalingV2().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, hpa := range hpas.Items {
+			targets = append(targets, scaleTarget{meta: hpa.ObjectMeta, target: hpa.Spec.ScaleTargetRef})
 		}
 	}
-	return names, nil
+	return targets, nil
+}
+
+// Reasons a PersistentVolume is reported for
+const (
+	// PVClaimDeletedReason is for Released PVs whose bound claim no longer exists
+	PVClaimDeletedReason = "ClaimDeleted"
+
+	// PVLongAvailableReason is for PVs that have stayed Available without being claimed
+	PVLongAvailableReason = "LongAvailable"
+)
+
+// DefaultPVAvailableDays is how long a PV must have been Available to be reported
+const DefaultPVAvailableDays = 7
+
+// OrphanPV is a PersistentVolume no claim will use again
+type OrphanPV struct {
+	metav1.ObjectMeta
+
+	// Reason is PVClaimDeletedReason or PVLongAvailableReason
+	Reason string
+
+	// Claim is the deleted claim ("<namespace>/<name>") a Released PV was bound to
+	Claim string
 }
 
-// OrphanPersistentVolumes returns names of PVs that are not bound (Released or Available state)
-func OrphanPersistentVolumes(ctx context.Context, client *kubernetes.Clientset) ([]string, error) {
+// OrphanPVs returns the PersistentVolumes that are Released and whose bound claim no longer exists,
+// and those that have been Available for at least availableFor. A claim re-created under the same
+// name is a different claim and does not count. How long a PV has been Available is read from its
+// last phase transition (Kubernetes 1.29+), else from its creation.
+func OrphanPVs(ctx context.Context, client kubernetes.Interface, availableFor time.Duration) ([]OrphanPV, error) {
 	pvs, err := client.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []string
+	var orphans []OrphanPV
 	for _, pv := range pvs.Items {
-		// PV is orphaned if it's in Released or Available state (not bound)
-		if pv.Status.Phase == corev1.VolumeReleased || pv.Status.Phase == corev1.VolumeAvailable {
-			names = append(names, pv.Name)
+		switch pv.Status.Phase {
+		case corev1.VolumeReleased:
+			ref := pv.Spec.ClaimRef
+			if ref == nil {
+				orphans = append(orphans, OrphanPV{ObjectMeta: pv.ObjectMeta, Reason: PVClaimDeletedReason})
+				continue
+			}
+			claim, err := client.CoreV1().PersistentVolumeClaims(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			if err == nil && (ref.UID == "" || claim.UID == ref.UID) {
+				continue
+			}
+			orphans = append(orphans, OrphanPV{ObjectMeta: pv.ObjectMeta, Reason: PVClaimDeletedReason, Claim: ref.Namespace + "/" + ref.Name})
+
+		case corev1.VolumeAvailable:
+			since := pv.CreationTimestamp
+			if pv.Status.LastPhaseTransitionTime != nil {
+				since = *pv.Status.LastPhaseTransitionTime
+			}
+			if time.Since(since.Time) >= availableFor {
+				orphans = append(orphans, OrphanPV{ObjectMeta: pv.ObjectMeta, Reason: PVLongAvailableReason})
+			}
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
 
-// OrphanResourceQuotas returns names of ResourceQuotas in namespaces with no running pods
-// A ResourceQuota is considered orphaned if it exists but there are no pods to enforce limits on
-func OrphanResourceQuotas(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
-	quotas, err := client.CoreV1().ResourceQuotas(ns).List(ctx, metav1.ListOptions{})
+// OrphanEndpointSlices returns the EndpointSlices whose Service no longer exists. A slice belongs
+// to the Service named by its kubernetes.io/service-name label; slices without the label, and slices
+// owned by something other than a Service, are left to their controller.
+func OrphanEndpointSlices(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	slices, err := client.DiscoveryV1().EndpointSlices(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	services, err := client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
+	serviceUIDs := make(map[string]types.UID, len(services.Items))
+	for _, svc := range services.Items {
+		serviceUIDs[svc.Name] = svc.UID
+	}
 
-	// If there are running pods, no quotas are orphaned
-	hasRunningPods := false
-	for _, pod := range pods.Items {
-		if pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodPending {
-			hasRunningPods = true
-			break
+	var orphans []metav1.ObjectMeta
+	for _, slice := range slices.Items {
+		service := slice.Labels[discoveryv1.LabelServiceName]
+		if service == "" || ownedByNonService(slice.OwnerReferences) {
+			continue
+		}
+		uid, exists := serviceUIDs[service]
+		if !exists {
+			orphans = append(orphans, slice.ObjectMeta)
+			continue
+		}
+		// A Service re-created under the same name does not adopt the slices of the old one
+		for _, ref := range slice.OwnerReferences {
+			if ref.Kind == "Service" && ref.Name == service && ref.UID != uid {
+				orphans = append(orphans, slice.ObjectMeta)
+				break
+			}
 		}
 	}
+	return orphans, nil
+}
+
+// ownedByNonService reports whether refs name an owner other than a Service
+func ownedByNonService(refs []metav1.OwnerReference) bool {
+	for _, ref := range refs {
+		if ref.Kind != "Service" {
+			return true
+		}
+	}
+	return false
+}
+
+// Reasons a ResourceQuota or LimitRange is reported for
+const (
+	// NoWorkloadsReason is for quotas and limit ranges in namespaces without pods or workloads
+	NoWorkloadsReason = "NoWorkloadsInNamespace"
+
+	// QuotaUnusedReason is for quotas whose every constrained resource has zero usage
+	QuotaUnusedReason = "ConstrainedResourcesNotCreated"
+)
+
+// OrphanResourceQuota is a ResourceQuota with nothing to constrain
+type OrphanResourceQuota struct {
+	metav1.ObjectMeta
+
+	// Reason is NoWorkloadsReason or QuotaUnusedReason
+	Reason string
+}
 
-	if hasRunningPods {
+// OrphanResourceQuotas returns the ResourceQuotas in ns that constrain nothing: all of them when the
+// namespace has no workloads, else those whose every hard limit reports zero usage, i.e. that only
+// constrain resource types never created there. A hard limit of zero forbids a resource type and
+// keeps its quota; quotas whose usage the quota controller has not yet recorded are skipped.
+func OrphanResourceQuotas(ctx context.Context, client kubernetes.Interface, ns string) ([]OrphanResourceQuota, error) {
+	quotas, err := client.CoreV1().ResourceQuotas(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(quotas.Items) == 0 {
 		return nil, nil
 	}
 
-	// No running pods, all quotas are orphaned
-	var names []string
+	workloads, err := hasWorkloads(ctx, client, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []OrphanResourceQuota
 	for _, quota := range quotas.Items {
-		names = append(names, quota.Name)
+		if !workloads {
+			orphans = append(orphans, OrphanResourceQuota{ObjectMeta: quota.ObjectMeta, Reason: NoWorkloadsReason})
+			continue
+		}
+		if quotaUnused(quota) {
+			orphans = append(orphans, OrphanResourceQuota{ObjectMeta: quota.ObjectMeta, Reason: QuotaUnusedReason})
+		}
+	}
+	return orphans, nil
+}
+
+// quotaUnused reports whether every resource quota constrains has a nonzero limit and zero recorded usage
+func quotaUnused(quota corev1.ResourceQuota) bool {
+	if len(quota.Spec.Hard) == 0 {
+		return false
+	}
+	for name, hard := range quota.Spec.Hard {
+		if hard.IsZero() {
+			return false
+		}
+		used, ok := quota.Status.Used[name]
+		if !ok || !used.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// OrphanLimitRanges returns the LimitRanges in namespaces without pods or workloads,
+// where there is nothing to apply default requests and limits to
+func OrphanLimitRanges(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	limitRanges, err := client.CoreV1().LimitRanges(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(limitRanges.Items) == 0 {
+		return nil, nil
+	}
+
+	workloads, err := hasWorkloads(ctx, client, ns)
+	if err != nil || workloads {
+		return nil, err
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, lr := range limitRanges.Items {
+		orphans = append(orphans, lr.ObjectMeta)
+	}
+	return orphans, nil
+}
+
+// hasWorkloads reports whether ns has running or pending pods, or any Deployment, StatefulSet,
+// DaemonSet, ReplicaSet, Job or CronJob that may create pods. Finished pods do not count.
+func hasWorkloads(ctx context.Context, client kubernetes.Interface, ns string) (bool, error) {
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodPending {
+			return true, nil
+		}
+	}
+
+	counts := []func() (int, error){
+		func() (int, error) {
+			list, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		},
+		func() (int, error) {
+			list, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		},
+		func() (int, error) {
+			list, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		},
+		func() (int, error) {
+			list, err := client.AppsV1().ReplicaSets(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		},
+		func() (int, error) {
+			list, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		},
+		func() (int, error) {
+			list, err := client.BatchV1().CronJobs(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		},
+	}
+	for _, count := range counts {
+		n, err := count()
+		if err != nil {
+			return false, err
+		}
+		if n > 0 {
+			return true, nil
+		}
 	}
-	return names, nil
+	return false, nil
 }
 
-// OrphanEndpoints returns names of Endpoints without a corresponding Service
+// OrphanEndpoints returns the Endpoints without a corresponding Service
 // Kubernetes auto-creates Endpoints for Services, so orphan Endpoints are those
 // where the Service was deleted but the Endpoints object remains (manually created
 // or from a deleted headless service scenario)
-func OrphanEndpoints(ctx context.Context, client *kubernetes.Clientset, ns string) ([]string, error) {
+func OrphanEndpoints(ctx context.Context, client kubernetes.Interface, ns string) ([]metav1.ObjectMeta, error) {
 	endpoints, err := client.CoreV1().Endpoints(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -905,7 +1329,7 @@ func OrphanEndpoints(ctx context.Context, client *kubernetes.Clientset, ns strin
 		serviceNames[svc.Name] = true
 	}
 
-	var names []string
+	var orphans []metav1.ObjectMeta
 	for _, ep := range endpoints.Items {
 		// Skip if it has owner references (managed by something else)
 		if len(ep.OwnerReferences) > 0 {
@@ -914,8 +1338,8 @@ func OrphanEndpoints(ctx context.Context, client *kubernetes.Clientset, ns strin
 
 		// Endpoint is orphaned if no Service with the same name exists
 		if !serviceNames[ep.Name] {
-			names = append(names, ep.Name)
+			orphans = append(orphans, ep.ObjectMeta)
 		}
 	}
-	return names, nil
+	return orphans, nil
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceGVRs maps the resource type of a finding to its API resource
+var ResourceGVRs = map[string]schema.GroupVersionResource{
+	"ConfigMap":                      {Version: "v1", Resource: "configmaps"},
+	"Secret":                         {Version: "v1", Resource: "secrets"},
+	"PersistentVolumeClaim":          {Version: "v1", Resource: "persistentvolumeclaims"},
+	"PersistentVolume":               {Version: "v1", Resource: "persistentvolumes"},
+	"Service":                        {Version: "v1", Resource: "services"},
+	"ServiceAccount":                 {Version: "v1", Resource: "serviceaccounts"},
+	"Endpoints":                      {Version: "v1", Resource: "endpoints"},
+	"EndpointSlice":                  {Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices"},
+	"ResourceQuota":                  {Version: "v1", Resource: "resourcequotas"},
+	"LimitRange":                     {Version: "v1", Resource: "limitranges"},
+	"Deployment":                     {Group: "apps", Version: "v1", Resource: "deployments"},
+	"StatefulSet":                    {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"DaemonSet":                      {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"ReplicaSet":                     {Group: "apps", Version: "v1", Resource: "replicasets"},
+	"Job":                            {Group: "batch", Version: "v1", Resource: "jobs"},
+	"CronJob":                        {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	"Ingress":                        {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	"NetworkPolicy":                  {Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+	"PodDisruptionBudget":            {Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"},
+	"HorizontalPodAutoscaler":        {Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+	"Role":                           {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	"ClusterRole":                    {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+	"RoleBinding":                    {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+	"ClusterRoleBinding":             {Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"},
+	"SecretProviderClass":            SecretProviderClassGVR,
+	"ExternalSecret":                 ExternalSecretGVR,
+	"FlowSchema":                     {Group: "flowcontrol.apiserver.k8s.io", Version: "v1", Resource: "flowschemas"},
+	"PriorityLevelConfiguration":     {Group: "flowcontrol.apiserver.k8s.io", Version: "v1", Resource: "prioritylevelconfigurations"},
+	"MutatingWebhookConfiguration":   {Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations"},
+	"ValidatingWebhookConfiguration": {Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations"},
+}
+
+// SpecResourceTypes maps the resource type of a finding to its name in KorpScan spec resource type lists
+var SpecResourceTypes = map[string]string{
+	"ConfigMap":                      "configmaps",
+	"Secret":                         "secrets",
+	"PersistentVolumeClaim":          "pvcs",
+	"Service":                        "services",
+	"Deployment":                     "deployments",
+	"StatefulSet":                    "statefulsets",
+	"DaemonSet":                      "daemonsets",
+	"Job":                            "jobs",
+	"CronJob":                        "cronjobs",
+	"ReplicaSet":                     "replicasets",
+	"ServiceAccount":                 "serviceaccounts",
+	"Ingress":                        "ingresses",
+	"Role":                           "roles",
+	"ClusterRole":                    "clusterroles",
+	"RoleBinding":                    "rolebindings",
+	"ClusterRoleBinding":             "clusterrolebindings",
+	"NetworkPolicy":                  "networkpolicies",
+	"PodDisruptionBudget":            "poddisruptionbudgets",
+	"HorizontalPodAutoscaler":        "hpas",
+	"PersistentVolume":               "pvs",
+	"Endpoints":                      "endpoints",
+	"EndpointSlice":                  "endpointslices",
+	"ResourceQuota":                  "resourcequotas",
+	"LimitRange":                     "limitranges",
+	"SecretProviderClass":            "secretproviderclasses",
+	"ExternalSecret":                 "externalsecrets",
+	"FlowSchema":                     "flowschemas",
+	"PriorityLevelConfiguration":     "prioritylevelconfigurations",
+	"MutatingWebhookConfiguration":   "mutatingwebhookconfigurations",
+	"ValidatingWebhookConfiguration": "validatingwebhookconfigurations",
+}
+
+// unstructuredMeta returns the metadata of u that filters and policies read
+func unstructuredMeta(u *unstructured.Unstructured) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              u.GetName(),
+		Namespace:         u.GetNamespace(),
+		UID:               u.GetUID(),
+		CreationTimestamp: u.GetCreationTimestamp(),
+		Labels:            u.GetLabels(),
+		Annotations:       u.GetAnnotations(),
+		OwnerReferences:   u.GetOwnerReferences(),
+	}
+}
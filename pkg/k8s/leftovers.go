@@ -0,0 +1,388 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// helmReleaseNameAnnotation and helmReleaseNamespaceAnnotation are set by Helm 3 on every object of a release
+	helmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+
+	// managedByLabel and instanceLabel are the recommended labels Helm charts set to their manager and release
+	managedByLabel = "app.kubernetes.io/managed-by"
+	instanceLabel  = "app.kubernetes.io/instance"
+
+	// olmOwnerLabel and olmOwnerNamespaceLabel are set by OLM on objects created for a ClusterServiceVersion
+	olmOwnerLabel          = "olm.owner"
+	olmOwnerNamespaceLabel = "olm.owner.namespace"
+	olmOwnerKindLabel      = "olm.owner.kind"
+)
+
+// ClusterServiceVersionGVR is the OLM ClusterServiceVersion resource
+var ClusterServiceVersionGVR = schema.GroupVersionResource{
+	Group:    "operators.coreos.com",
+	Version:  "v1alpha1",
+	Resource: "clusterserviceversions",
+}
+
+// Leftover is a resource left behind by an uninstalled Helm release or OLM operator
+type Leftover struct {
+	metav1.ObjectMeta
+	Kind string
+
+	// App identifies what left the resource behind: "helm:<namespace>/<release>" or "olm:<namespace>/<csv>"
+	App string
+
+	// Reason is HelmReleaseUninstalled or OperatorUninstalled
+	Reason string
+}
+
+// AppTracker caches whether Helm releases and OLM operators still exist
+type AppTracker struct {
+	client        kubernetes.Interface
+	dynamic       dynamic.Interface
+	helmReleases  map[string]map[string]bool
+	operatorsSeen map[string]bool
+}
+
+// NewAppTracker returns a tracker used to resolve leftover resources across calls
+func NewAppTracker(client kubernetes.Interface, dyn dynamic.Interface) *AppTracker {
+	return &AppTracker{
+		client:        client,
+		dynamic:       dyn,
+		helmReleases:  make(map[string]map[string]bool),
+		operatorsSeen: make(map[string]bool),
+	}
+}
+
+// helmReleaseInstalled reports whether a Helm release has a deployed (not uninstalled) revision
+func (t *AppTracker) helmReleaseInstalled(ctx context.Context, ns, release string) (bool, error) {
+	releases, ok := t.helmReleases[ns]
+	if !ok {
+		releases = make(map[string]bool)
+		// Helm stores release revisions in Secrets (default driver) or ConfigMaps; only their labels are
+		// read, so the release payloads are not listed
+		secrets, err := ListMetadata(ctx, t.client, secretsResource, ns, metav1.ListOptions{LabelSelector: "owner=helm"})
+		if err != nil {
+			return false, err
+		}
+		for _, s := range secrets.Items {
+			if s.Labels["status"] != "uninstalled" {
+				releases[s.Labels["name"]] = true
+			}
+		}
+		cms, err := ListMetadata(ctx, t.client, configMapsResource, ns, metav1.ListOptions{LabelSelector: "owner=helm"})
+		if err != nil {
+			return false, err
+		}
+		for _, cm := range cms.Items {
+			if cm.Labels["status"] != "uninstalled" {
+				releases[cm.Labels["name"]] = true
+			}
+		}
+		t.helmReleases[ns] = releases
+	}
+	return releases[release], nil
+}
+
+// operatorInstalled reports whether an OLM ClusterServiceVersion still exists
+func (t *AppTracker) operatorInstalled(ctx context.Context, ns, csv string) (bool, error) {
+	key := ns + "/" + csv
+	if installed, ok := t.operatorsSeen[key]; ok {
+		return installed, nil
+	}
+
+	installed := true
+	if _, err := t.dynamic.Resource(ClusterServiceVersionGVR).Namespace(ns).Get(ctx, csv, metav1.GetOptions{}); err != nil {
+		if !errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return false, err
+		}
+		installed = false
+	}
+	t.operatorsSeen[key] = installed
+	return installed, nil
+}
+
+// HelmRelease returns the release ("<namespace>/<release>") that manages obj, named by its meta.helm.sh
+// annotations or, for objects only labeled app.kubernetes.io/managed-by=Helm, its app.kubernetes.io/instance
+// label. Cluster-scoped objects without a release namespace get the bare release name; objects Helm does
+// not manage get "".
+func HelmRelease(obj metav1.Object) string {
+	release := obj.GetAnnotations()[helmReleaseNameAnnotation]
+	releaseNs := obj.GetAnnotations()[helmReleaseNamespaceAnnotation]
+	if release == "" && obj.GetLabels()[managedByLabel] == "Helm" {
+		release = obj.GetLabels()[instanceLabel]
+		releaseNs = ""
+	}
+	if releaseNs == "" {
+		releaseNs = obj.GetNamespace()
+	}
+	if release == "" || releaseNs == "" {
+		return release
+	}
+	return releaseNs + "/" + release
+}
+
+// AbandonedHelmRelease returns the release ("<namespace>/<release>") named by obj's Helm annotations
+// when that release no longer has a deployed revision, or "" if obj is not from an abandoned release
+func (t *AppTracker) AbandonedHelmRelease(ctx context.Context, obj metav1.Object) (string, error) {
+	release := obj.GetAnnotations()[helmReleaseNameAnnotation]
+	if release == "" {
+		return "", nil
+	}
+	releaseNs := obj.GetAnnotations()[helmReleaseNamespaceAnnotation]
+	if releaseNs == "" {
+		releaseNs = obj.GetNamespace()
+	}
+	installed, err := t.helmReleaseInstalled(ctx, releaseNs, release)
+	if err != nil || installed {
+		return "", err
+	}
+	return releaseNs + "/" + release, nil
+}
+
+// check returns the leftover entry for an object, or nil if its app is still installed
+func (t *AppTracker) check(ctx context.Context, kind string, obj metav1.ObjectMeta) (*Leftover, error) {
+	if obj.Annotations[helmReleaseNameAnnotation] != "" {
+		release, err := t.AbandonedHelmRelease(ctx, &obj)
+		if err != nil || release == "" {
+			return nil, err
+		}
+		return &Leftover{ObjectMeta: obj, Kind: kind,
+			App: "helm:" + release, Reason: "HelmReleaseUninstalled"}, nil
+	}
+
+	if csv := obj.Labels[olmOwnerLabel]; csv != "" && obj.Labels[olmOwnerKindLabel] == "ClusterServiceVersion" {
+		csvNs := obj.Labels[olmOwnerNamespaceLabel]
+		installed, err := t.operatorInstalled(ctx, csvNs, csv)
+		if err != nil || installed {
+			return nil, err
+		}
+		return &Leftover{ObjectMeta: obj, Kind: kind,
+			App: "olm:" + csvNs + "/" + csv, Reason: "OperatorUninstalled"}, nil
+	}
+
+	return nil, nil
+}
+
+// collect checks each object and appends leftovers
+func (t *AppTracker) collect(ctx context.Context, out []Leftover, kind string, objects []metav1.ObjectMeta) ([]Leftover, error) {
+	for _, obj := range objects {
+		leftover, err := t.check(ctx, kind, obj)
+		if err != nil {
+			return nil, err
+		}
+		if leftover != nil {
+			out = append(out, *leftover)
+		}
+	}
+	return out, nil
+}
+
+// LeftoverResources returns namespaced resources in ns whose Helm release or OLM operator is uninstalled
+func (t *AppTracker) LeftoverResources(ctx context.Context, ns string) ([]Leftover, error) {
+	var out []Leftover
+	var metas []metav1.ObjectMeta
+
+	cms, err := ListMetadata(ctx, t.client, configMapsResource, ns, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range cms.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "ConfigMap", metas); err != nil {
+		return nil, err
+	}
+
+	secrets, err := ListMetadata(ctx, t.client, secretsResource, ns, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range secrets.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "Secret", metas); err != nil {
+		return nil, err
+	}
+
+	pvcs, err := t.client.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range pvcs.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "PersistentVolumeClaim", metas); err != nil {
+		return nil, err
+	}
+
+	services, err := t.client.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range services.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "Service", metas); err != nil {
+		return nil, err
+	}
+
+	serviceaccounts, err := t.client.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range serviceaccounts.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "ServiceAccount", metas); err != nil {
+		return nil, err
+	}
+
+	deployments, err := t.client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range deployments.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "Deployment", metas); err != nil {
+		return nil, err
+	}
+
+	statefulsets, err := t.client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range statefulsets.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "StatefulSet", metas); err != nil {
+		return nil, err
+	}
+
+	daemonsets, err := t.client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range daemonsets.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "DaemonSet", metas); err != nil {
+		return nil, err
+	}
+
+	jobs, err := t.client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range jobs.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "Job", metas); err != nil {
+		return nil, err
+	}
+
+	cronjobs, err := t.client.BatchV1().CronJobs(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range cronjobs.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "CronJob", metas); err != nil {
+		return nil, err
+	}
+
+	ingresses, err := t.client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range ingresses.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "Ingress", metas); err != nil {
+		return nil, err
+	}
+
+	roles, err := t.client.RbacV1().Roles(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range roles.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "Role", metas); err != nil {
+		return nil, err
+	}
+
+	roleBindings, err := t.client.RbacV1().RoleBindings(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range roleBindings.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "RoleBinding", metas); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ClusterLeftoverResources returns cluster-scoped resources whose Helm release or OLM operator is uninstalled
+func (t *AppTracker) ClusterLeftoverResources(ctx context.Context) ([]Leftover, error) {
+	var out []Leftover
+	var metas []metav1.ObjectMeta
+
+	clusterRoles, err := t.client.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range clusterRoles.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "ClusterRole", metas); err != nil {
+		return nil, err
+	}
+
+	clusterRoleBindings, err := t.client.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	metas = nil
+	for _, o := range clusterRoleBindings.Items {
+		metas = append(metas, o.ObjectMeta)
+	}
+	if out, err = t.collect(ctx, out, "ClusterRoleBinding", metas); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
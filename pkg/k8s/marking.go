@@ -0,0 +1,289 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// OrphanedSinceAnnotation records when korp first flagged a resource (RFC 3339)
+	OrphanedSinceAnnotation = "korp.io/orphaned-since"
+
+	// OrphanReasonAnnotation records why korp flagged a resource
+	OrphanReasonAnnotation = "korp.io/reason"
+
+	// OrphanedLabel is set to "true" by the label cleanup action so flagged resources can be selected
+	OrphanedLabel = "korp.io/orphaned"
+
+	// MarkedLabel is set to "true" alongside korp's annotations, so marked resources can be listed
+	MarkedLabel = "korp.io/marked"
+
+	// markerFieldManager owns korp's annotations on flagged resources
+	markerFieldManager = "korp-marker"
+
+	// labelFieldManager owns the orphaned label, which is set and removed independently of the annotations
+	labelFieldManager = "korp-labeler"
+
+	// OrphanCountAnnotation records on a Namespace how many orphans the last scan found in it
+	OrphanCountAnnotation = "korp.io/orphan-count"
+
+	// LastScanAnnotation records on a Namespace when it was last scanned (RFC 3339)
+	LastScanAnnotation = "korp.io/last-scan"
+
+	// ScannedByAnnotation records on a Namespace which KorpScan (namespace/name) wrote the summary
+	ScannedByAnnotation = "korp.io/scanned-by"
+
+	// namespaceFieldManagerPrefix starts the field manager of each KorpScan's summary annotations on Namespaces
+	namespaceFieldManagerPrefix = "korp-namespace-reporter/"
+
+	// maxFieldManagerLength is the longest field manager the API server accepts
+	maxFieldManagerLength = 128
+)
+
+// MarkOrphaned annotates a flagged resource with korp's assessment and labels it with MarkedLabel.
+// An existing orphaned-since value is kept, so the returned time is when the resource was first flagged.
+func MarkOrphaned(ctx context.Context, dyn dynamic.Interface, resourceType, ns, name, reason string, since time.Time) (time.Time, error) {
+	gvr, ok := ResourceGVRs[resourceType]
+	if !ok {
+		return since, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+
+	first := since
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := dyn.Resource(gvr).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		annotations := obj.GetAnnotations()
+		first = since
+		if existing, err := time.Parse(time.RFC3339, annotations[OrphanedSinceAnnotation]); err == nil {
+			first = existing
+			if annotations[OrphanReasonAnnotation] == reason && obj.GetLabels()[MarkedLabel] == "true" {
+				return nil
+			}
+		}
+
+		_, err = applyMetadata(ctx, dyn, gvr, obj, markerFieldManager, map[string]string{
+			OrphanedSinceAnnotation: first.UTC().Format(time.RFC3339),
+			OrphanReasonAnnotation:  reason,
+		}, map[string]string{MarkedLabel: "true"})
+		return err
+	})
+	return first, err
+}
+
+// ListMarked returns the resources of a type in ns that carry MarkedLabel or OrphanedLabel.
+// Use an empty namespace for cluster-scoped types or all namespaces.
+func ListMarked(ctx context.Context, dyn dynamic.Interface, resourceType, ns string) ([]unstructured.Unstructured, error) {
+	gvr, ok := ResourceGVRs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+
+	var marked []unstructured.Unstructured
+	seen := make(map[types.UID]bool)
+	for _, label := range []string{MarkedLabel, OrphanedLabel} {
+		list, err := dyn.Resource(gvr).Namespace(ns).List(ctx, metav1.ListOptions{LabelSelector: label})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			if !seen[item.GetUID()] {
+				seen[item.GetUID()] = true
+				marked = append(marked, item)
+			}
+		}
+	}
+	return marked, nil
+}
+
+// LabelOrphaned sets the orphaned label on a resource
+func LabelOrphaned(ctx context.Context, dyn dynamic.Interface, resourceType, ns, name string) error {
+	gvr, ok := ResourceGVRs[resourceType]
+	if !ok {
+		return fmt.Errorf("unknown resource type %q", resourceType)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := dyn.Resource(gvr).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		_, err = applyMetadata(ctx, dyn, gvr, obj, labelFieldManager, nil, map[string]string{OrphanedLabel: "true"})
+		return err
+	})
+}
+
+// UnmarkOrphaned removes korp's annotations and orphaned label from a resource. It returns the updated resource,
+// or nil if the resource no longer exists or was not marked.
+func UnmarkOrphaned(ctx context.Context, dyn dynamic.Interface, resourceType, ns, name string) (*unstructured.Unstructured, error) {
+	gvr, ok := ResourceGVRs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+
+	var unmarked *unstructured.Unstructured
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		unmarked = nil
+		obj, err := dyn.Resource(gvr).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !MarkedOrphaned(obj) {
+			return nil
+		}
+
+		// Applying nothing as a field manager drops every field it owns
+		for _, manager := range []string{markerFieldManager, labelFieldManager} {
+			if !managedBy(obj, manager) {
+				continue
+			}
+			if obj, err = applyMetadata(ctx, dyn, gvr, obj, manager, nil, nil); err != nil {
+				return err
+			}
+		}
+		unmarked = obj
+		if !MarkedOrphaned(obj) {
+			return nil
+		}
+
+		// Markings written before korp used server-side apply are owned by another manager
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"resourceVersion": obj.GetResourceVersion(),
+				"annotations": map[string]interface{}{
+					OrphanedSinceAnnotation: nil,
+					OrphanReasonAnnotation:  nil,
+				},
+				"labels": map[string]interface{}{
+					OrphanedLabel: nil,
+					MarkedLabel:   nil,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		unmarked, err = dyn.Resource(gvr).Namespace(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+	return unmarked, err
+}
+
+// namespaceFieldManager returns the field manager the KorpScan korpScan (namespace/name) applies its
+// Namespace summary with, so each KorpScan owns what it wrote. Over-long names are cut and suffixed with
+// a hash of the full name to stay unique.
+func namespaceFieldManager(korpScan string) string {
+	manager := namespaceFieldManagerPrefix + korpScan
+	if len(manager) <= maxFieldManagerLength {
+		return manager
+	}
+	sum := fmt.Sprintf("%x", sha256.Sum256([]byte(korpScan)))[:10]
+	return manager[:maxFieldManagerLength-len(sum)-1] + "-" + sum
+}
+
+// AnnotateNamespace sets korp's summary annotations on a Namespace, replacing the ones the KorpScan korpScan
+// (namespace/name) wrote in its previous scan. Each KorpScan applies them as its own field manager.
+func AnnotateNamespace(ctx context.Context, dyn dynamic.Interface, name, korpScan string, annotations map[string]string) error {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj, err := dyn.Resource(gvr).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		_, err = applyMetadata(ctx, dyn, gvr, obj, namespaceFieldManager(korpScan), annotations, nil)
+		return err
+	})
+}
+
+// UnannotateNamespace removes korp's summary annotations from a Namespace, whichever manager wrote them.
+// It returns false if the Namespace carried none.
+func UnannotateNamespace(ctx context.Context, dyn dynamic.Interface, name string) (bool, error) {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	obj, err := dyn.Resource(gvr).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	annotations := obj.GetAnnotations()
+	removals := make(map[string]interface{})
+	for _, key := range []string{OrphanCountAnnotation, LastScanAnnotation, ScannedByAnnotation} {
+		if _, ok := annotations[key]; ok {
+			removals[key] = nil
+		}
+	}
+	if len(removals) == 0 {
+		return false, nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": removals},
+	})
+	if err != nil {
+		return false, err
+	}
+	_, err = dyn.Resource(gvr).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err == nil, err
+}
+
+// MarkedOrphaned reports whether a resource carries any of korp's annotations or labels
+func MarkedOrphaned(obj *unstructured.Unstructured) bool {
+	annotations := obj.GetAnnotations()
+	_, marked := annotations[OrphanedSinceAnnotation]
+	_, hasReason := annotations[OrphanReasonAnnotation]
+	_, labeled := obj.GetLabels()[OrphanedLabel]
+	_, markedLabel := obj.GetLabels()[MarkedLabel]
+	return marked || hasReason || labeled || markedLabel
+}
+
+// managedBy reports whether fieldManager owns any field of a resource
+func managedBy(obj *unstructured.Unstructured, fieldManager string) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
+
+// applyMetadata server-side applies exactly the given annotations and labels to obj as fieldManager.
+// Fields the manager applied before and that are not given are removed; other managers' fields are untouched.
+// The apply carries obj's resourceVersion, so it fails with a conflict if the resource changed or was
+// deleted and recreated since obj was read, and never creates a resource that no longer exists.
+func applyMetadata(ctx context.Context, dyn dynamic.Interface, gvr schema.GroupVersionResource, obj *unstructured.Unstructured,
+	fieldManager string, annotations, labels map[string]string) (*unstructured.Unstructured, error) {
+	config := &unstructured.Unstructured{}
+	config.SetAPIVersion(obj.GetAPIVersion())
+	config.SetKind(obj.GetKind())
+	config.SetNamespace(obj.GetNamespace())
+	config.SetName(obj.GetName())
+	config.SetResourceVersion(obj.GetResourceVersion())
+	if len(annotations) > 0 {
+		config.SetAnnotations(annotations)
+	}
+	if len(labels) > 0 {
+		config.SetLabels(labels)
+	}
+
+	return dyn.Resource(gvr).Namespace(obj.GetNamespace()).Apply(ctx, obj.GetName(), config,
+		metav1.ApplyOptions{FieldManager: fieldManager, Force: true})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+)
+
+var (
+	configMapsResource = corev1.SchemeGroupVersion.WithResource("configmaps")
+	secretsResource    = corev1.SchemeGroupVersion.WithResource("secrets")
+)
+
+// MetadataLister is implemented by clientsets that can list only the metadata of objects
+type MetadataLister interface {
+	ListMetadata(ctx context.Context, gvr schema.GroupVersionResource, ns string, opts metav1.ListOptions) (*metav1.PartialObjectMetadataList, error)
+}
+
+// MetadataClient is a clientset that also lists objects through the metadata API, which returns only
+// their names, labels, annotations and ownerReferences. Detectors that need no more than that list
+// ConfigMaps and Secrets without their payloads.
+type MetadataClient struct {
+	kubernetes.Interface
+	metadata metadata.Interface
+}
+
+// NewMetadataClient creates a MetadataClient that lists metadata through meta and everything else through client
+func NewMetadataClient(client kubernetes.Interface, meta metadata.Interface) *MetadataClient {
+	return &MetadataClient{Interface: client, metadata: meta}
+}
+
+// ListMetadata lists the metadata of the gvr objects in ns
+func (c *MetadataClient) ListMetadata(ctx context.Context, gvr schema.GroupVersionResource, ns string, opts metav1.ListOptions) (*metav1.PartialObjectMetadataList, error) {
+	return c.metadata.Resource(gvr).Namespace(ns).List(ctx, opts)
+}
+
+// ListMetadata lists the metadata of the gvr objects in ns through the metadata API when client can,
+// and otherwise lists the full ConfigMaps or Secrets and keeps their metadata
+func ListMetadata(ctx context.Context, client kubernetes.Interface, gvr schema.GroupVersionResource, ns string, opts metav1.ListOptions) (*metav1.PartialObjectMetadataList, error) {
+	if lister, ok := client.(MetadataLister); ok {
+		return lister.ListMetadata(ctx, gvr, ns, opts)
+	}
+	return listObjectMetadata(ctx, client, gvr, ns, opts)
+}
+
+// listObjectMetadata lists the full objects of gvr through the typed client and keeps their metadata
+func listObjectMetadata(ctx context.Context, client kubernetes.Interface, gvr schema.GroupVersionResource, ns string, opts metav1.ListOptions) (*metav1.PartialObjectMetadataList, error) {
+	var list runtime.Object
+	var err error
+	switch gvr {
+	case configMapsResource:
+		list, err = client.CoreV1().ConfigMaps(ns).List(ctx, opts)
+	case secretsResource:
+		list, err = client.CoreV1().Secrets(ns).List(ctx, opts)
+	default:
+		return nil, fmt.Errorf("listing the metadata of %s needs a metadata client", gvr.Resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return metadataList(list)
+}
+
+// metadataList returns a copy of the metadata of the items of list
+func metadataList(list runtime.Object) (*metav1.PartialObjectMetadataList, error) {
+	objs, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	out := &metav1.PartialObjectMetadataList{Items: make([]metav1.PartialObjectMetadata, 0, len(objs))}
+	for _, obj := range objs {
+		accessor, ok := obj.(metav1.ObjectMetaAccessor)
+		if !ok {
+			return nil, fmt.Errorf("%T has no object metadata", obj)
+		}
+		objMeta, ok := accessor.GetObjectMeta().(*metav1.ObjectMeta)
+		if !ok {
+			return nil, fmt.Errorf("%T has no object metadata", obj)
+		}
+		out.Items = append(out.Items, metav1.PartialObjectMetadata{ObjectMeta: *objMeta.DeepCopy()})
+	}
+	return out, nil
+}
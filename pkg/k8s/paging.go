@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/pager"
+)
+
+// DefaultChunkSize is the number of items a paged List fetches per request, as in kubectl
+const DefaultChunkSize = 500
+
+// listPages lists through fetch with a client-go pager in requests of at most chunkSize items, so listing
+// a namespace with tens of thousands of Secrets does not time out in one request, and returns the whole
+// collection in the list type fetch returns. A chunkSize of 0 and Lists that set their own limit, continue
+// token or resource version are sent unchanged. If the continue token expires before the last page, the
+// pager lists the collection again in one request.
+func listPages(ctx context.Context, chunkSize int64, opts metav1.ListOptions,
+	fetch func(context.Context, metav1.ListOptions) (runtime.Object, error)) (runtime.Object, error) {
+	if chunkSize <= 0 || opts.Limit != 0 || opts.Continue != "" || opts.ResourceVersion != "" || opts.Watch {
+		return fetch(ctx, opts)
+	}
+
+	var first runtime.Object
+	p := pager.New(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		page, err := fetch(ctx, opts)
+		if err == nil && first == nil {
+			first = page
+		}
+		return page, err
+	})
+	p.PageSize = chunkSize
+	obj, _, err := p.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pager joins several pages in an internal list; the items are put back into the first page
+	list, ok := obj.(*metainternalversion.List)
+	if !ok {
+		return obj, nil
+	}
+	if err := meta.SetList(first, list.Items); err != nil {
+		return nil, err
+	}
+	firstMeta, err := meta.ListAccessor(first)
+	if err != nil {
+		return nil, err
+	}
+	firstMeta.SetResourceVersion(list.ResourceVersion)
+	firstMeta.SetContinue("")
+	firstMeta.SetRemainingItemCount(nil)
+	return first, nil
+}
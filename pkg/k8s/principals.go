@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// principalLookupTimeout bounds the HTTP lookup of known principals
+const principalLookupTimeout = 10 * time.Second
+
+// UnknownPrincipalsReason is the finding reason for bindings whose only subjects are unknown users or groups
+const UnknownPrincipalsReason = "UnknownPrincipals"
+
+// ErrNoPrincipals is returned when the configured sources list no users or groups. A source missing
+// its keys or returning an empty object would otherwise make every user and group binding look unknown.
+var ErrNoPrincipals = errors.New("no known principals loaded")
+
+// Principals is the set of users and groups known to the identity provider
+type Principals struct {
+	Users  map[string]bool
+	Groups map[string]bool
+}
+
+// addLines adds newline-separated principals from a list
+func addLines(set map[string]bool, value string) {
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			set[line] = true
+		}
+	}
+}
+
+// LoadPrincipals reads known users and groups from the configured sources in namespace ns.
+// It returns ErrNoPrincipals when the sources list none.
+func LoadPrincipals(ctx context.Context, client kubernetes.Interface, ns string, source *korpv1alpha1.PrincipalSource) (*Principals, error) {
+	p := &Principals{Users: make(map[string]bool), Groups: make(map[string]bool)}
+
+	if source.ConfigMapName != "" {
+		cm, err := client.CoreV1().ConfigMaps(ns).Get(ctx, source.ConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("reading known principals ConfigMap: %w", err)
+		}
+		addLines(p.Users, cm.Data["users"])
+		addLines(p.Groups, cm.Data["groups"])
+	}
+
+	if source.SecretName != "" {
+		secret, err := client.CoreV1().Secrets(ns).Get(ctx, source.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("reading known principals Secret: %w", err)
+		}
+		addLines(p.Users, string(secret.Data["users"]))
+		addLines(p.Groups, string(secret.Data["groups"]))
+	}
+
+	if source.URL != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := (&http.Client{Timeout: principalLookupTimeout}).Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("looking up known principals: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("looking up known principals: unexpected status %d", resp.StatusCode)
+		}
+
+		var body struct {
+			Users  []string `json:"users"`
+			Groups []string `json:"groups"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, fmt.Errorf("parsing known principals: %w", err)
+		}
+		for _, u := range body.Users {
+			p.Users[u] = true
+		}
+		for _, g := range body.Groups {
+			p.Groups[g] = true
+		}
+	}
+
+	if len(p.Users) == 0 && len(p.Groups) == 0 {
+		return nil, ErrNoPrincipals
+	}
+	return p, nil
+}
+
+// onlyUnknownPrincipals reports whether all subjects are users/groups unknown to the
+// identity provider. Bindings with ServiceAccount subjects or built-in "system:" principals are kept.
+func onlyUnknownPrincipals(subjects []rbacv1.Subject, known *Principals) bool {
+	if len(subjects) == 0 {
+		return false
+	}
+	for _, subject := range subjects {
+		if strings.HasPrefix(subject.Name, "system:") {
+			return false
+		}
+		switch subject.Kind {
+		case rbacv1.UserKind:
+			if known.Users[subject.Name] {
+				return false
+			}
+		case rbacv1.GroupKind:
+			if known.Groups[subject.Name] {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// RoleBindingsWithUnknownPrincipals returns the RoleBindings whose only subjects are unknown users or groups
+func RoleBindingsWithUnknownPrincipals(ctx context.Context, client kubernetes.Interface, ns string, known *Principals) ([]metav1.ObjectMeta, error) {
+	bindings, err := client.RbacV1().RoleBindings(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []metav1.ObjectMeta
+	for _, rb := range bindings.Items {
+		if onlyUnknownPrincipals(rb.Subjects, known) {
+			out = append(out, rb.ObjectMeta)
+		}
+	}
+	return out, nil
+}
+
+// ClusterRoleBindingsWithUnknownPrincipals returns the ClusterRoleBindings whose only subjects are unknown users or groups
+func ClusterRoleBindingsWithUnknownPrincipals(ctx context.Context, client kubernetes.Interface, known *Principals) ([]metav1.ObjectMeta, error) {
+	bindings, err := client.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []metav1.ObjectMeta
+	for _, crb := range bindings.Items {
+		if onlyUnknownPrincipals(crb.Subjects, known) {
+			out = append(out, crb.ObjectMeta)
+		}
+	}
+	return out, nil
+}
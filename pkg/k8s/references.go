@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// PopularReferenceResources are custom resources that commonly name ConfigMaps or Secrets in their spec
+var PopularReferenceResources = []schema.GroupVersionResource{
+	{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheuses"},
+	{Group: "monitoring.coreos.com", Version: "v1", Resource: "alertmanagers"},
+	{Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors"},
+	{Group: "monitoring.coreos.com", Version: "v1", Resource: "podmonitors"},
+	{Group: "monitoring.coreos.com", Version: "v1", Resource: "probes"},
+	{Group: "monitoring.coreos.com", Version: "v1alpha1", Resource: "alertmanagerconfigs"},
+	{Group: "grafana.integreatly.org", Version: "v1beta1", Resource: "grafanadashboards"},
+	{Group: "grafana.integreatly.org", Version: "v1beta1", Resource: "grafanadatasources"},
+	{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+	{Group: "cert-manager.io", Version: "v1", Resource: "issuers"},
+	{Group: "cert-manager.io", Version: "v1", Resource: "clusterissuers"},
+	{Group: "keda.sh", Version: "v1alpha1", Resource: "triggerauthentications"},
+	{Group: "keda.sh", Version: "v1alpha1", Resource: "clustertriggerauthentications"},
+}
+
+// ReferenceIndex holds names of ConfigMaps and Secrets referenced by custom resources, keyed by namespace.
+// The "" namespace holds references from cluster-scoped resources that do not name a namespace.
+type ReferenceIndex map[string]map[string]bool
+
+// Has reports whether a ConfigMap or Secret in ns is referenced
+func (idx ReferenceIndex) Has(ns, name string) bool {
+	return idx[ns][name] || idx[""][name]
+}
+
+func (idx ReferenceIndex) add(ns, name string) {
+	if idx[ns] == nil {
+		idx[ns] = make(map[string]bool)
+	}
+	idx[ns][name] = true
+}
+
+// CustomResourceReferences lists every object of the given resources across all namespaces and indexes
+// the ConfigMaps and Secrets named in their spec. Resources that are not installed are skipped.
+func CustomResourceReferences(ctx context.Context, dyn dynamic.Interface, gvrs []schema.GroupVersionResource) (ReferenceIndex, error) {
+	idx := make(ReferenceIndex)
+	for _, gvr := range gvrs {
+		items, err := ListOptional(ctx, dyn, gvr, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			ns := item.GetNamespace()
+			for key, value := range item.Object {
+				if key == "metadata" || key == "status" {
+					continue
+				}
+				collectReferences(key, value, func(refNs, name string) {
+					if refNs == "" {
+						refNs = ns
+					}
+					idx.add(refNs, name)
+				})
+			}
+		}
+	}
+	return idx, nil
+}
+
+// collectReferences walks a field of an unstructured object and reports ConfigMap/Secret references by
+// field-name convention: string fields ending in "secretName" or "configMapName", string lists named
+// "secrets" or "configMaps", and objects (or object lists) whose field name mentions a secret or
+// configMap and that carry a "name", such as secretRef, configMapKeyRef or bearerTokenSecret.
+func collectReferences(key string, value interface{}, add func(ns, name string)) {
+	lower := strings.ToLower(key)
+	mentionsRef := strings.Contains(lower, "secret") || strings.Contains(lower, "configmap")
+
+	switch v := value.(type) {
+	case string:
+		if strings.HasSuffix(lower, "secretname") || strings.HasSuffix(lower, "configmapname") || lower == "existingsecret" {
+			add("", v)
+		}
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok && mentionsRef {
+			ns, _ := v["namespace"].(string)
+			add(ns, name)
+		}
+		for k, child := range v {
+			collectReferences(k, child, add)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if name, ok := elem.(string); ok {
+				if lower == "secrets" || lower == "configmaps" {
+					add("", name)
+				}
+				continue
+			}
+			collectReferences(key, elem, add)
+		}
+	}
+}
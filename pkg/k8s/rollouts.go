@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PausedRolloutReason is the finding reason for workloads that are paused or mid-rollout
+const PausedRolloutReason = "PausedRollout"
+
+// newReplicaSetAvailable is the Progressing condition reason of a Deployment whose rollout has finished
+const newReplicaSetAvailable = "NewReplicaSetAvailable"
+
+// deploymentRolling reports whether a Deployment is paused or has a rollout that has not finished
+func deploymentRolling(dep appsv1.Deployment) bool {
+	if dep.Spec.Paused || dep.Status.ObservedGeneration < dep.Generation {
+		return true
+	}
+	for _, cond := range dep.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionTrue && cond.Reason != newReplicaSetAvailable {
+			return true
+		}
+	}
+	return false
+}
+
+// statefulSetRolling reports whether a StatefulSet has a rollout that has not finished,
+// including partitioned rollouts held back on purpose
+func statefulSetRolling(sts appsv1.StatefulSet) bool {
+	if sts.Status.ObservedGeneration < sts.Generation {
+		return true
+	}
+	return sts.Status.UpdateRevision != "" && sts.Status.CurrentRevision != sts.Status.UpdateRevision
+}
+
+// PausedOrRollingDeployments returns names of Deployments in ns that are paused or mid-rollout
+func PausedOrRollingDeployments(ctx context.Context, client kubernetes.Interface, ns string) (map[string]bool, error) {
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rolling := make(map[string]bool)
+	for _, dep := range deployments.Items {
+		if deploymentRolling(dep) {
+			rolling[dep.Name] = true
+		}
+	}
+	return rolling, nil
+}
+
+// defaultProgressDeadline is the progressDeadlineSeconds the API server assigns when a Deployment sets none
+const defaultProgressDeadline = 600 * time.Second
+
+// ProgressDeadlines returns the progressDeadlineSeconds of each Deployment in ns, keyed by name
+func ProgressDeadlines(ctx context.Context, client kubernetes.Interface, ns string) (map[string]time.Duration, error) {
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	deadlines := make(map[string]time.Duration, len(deployments.Items))
+	for _, dep := range deployments.Items {
+		deadline := defaultProgressDeadline
+		if dep.Spec.ProgressDeadlineSeconds != nil {
+			deadline = time.Duration(*dep.Spec.ProgressDeadlineSeconds) * time.Second
+		}
+		deadlines[dep.Name] = deadline
+	}
+	return deadlines, nil
+}
+
+// RollingStatefulSets returns names of StatefulSets in ns that are mid-rollout
+func RollingStatefulSets(ctx context.Context, client kubernetes.Interface, ns string) (map[string]bool, error) {
+	statefulsets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rolling := make(map[string]bool)
+	for _, sts := range statefulsets.Items {
+		if statefulSetRolling(sts) {
+			rolling[sts.Name] = true
+		}
+	}
+	return rolling, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// CAInjectionAnnotation names the Secret (namespace/name) whose CA cert-manager's cainjector
+// copies into the caBundle of webhook configurations, APIServices and CRDs
+const CAInjectionAnnotation = "cert-manager.io/inject-ca-from-secret"
+
+var (
+	// APIServiceGVR is the API resource of aggregated APIServices
+	APIServiceGVR = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+
+	// CustomResourceDefinitionGVR is the API resource of CustomResourceDefinitions
+	CustomResourceDefinitionGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+)
+
+// namespaceSecretReferences returns names of Secrets in ns used by objects other than pods:
+// Ingress TLS certificates, ServiceAccount imagePullSecrets and secrets, and the token
+// Secrets of ServiceAccounts that still exist
+func namespaceSecretReferences(ctx context.Context, client kubernetes.Interface, ns string) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+
+	ingresses, err := client.NetworkingV1().Ingresses(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ing := range ingresses.Items {
+		for _, tls := range ing.Spec.TLS {
+			if tls.SecretName != "" {
+				referenced[tls.SecretName] = true
+			}
+		}
+	}
+
+	serviceAccounts, err := client.CoreV1().ServiceAccounts(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	accounts := make(map[string]bool, len(serviceAccounts.Items))
+	for _, sa := range serviceAccounts.Items {
+		accounts[sa.Name] = true
+		for _, ips := range sa.ImagePullSecrets {
+			referenced[ips.Name] = true
+		}
+		for _, ref := range sa.Secrets {
+			referenced[ref.Name] = true
+		}
+	}
+
+	// Token Secrets name their ServiceAccount in an annotation. Their type is not in the metadata,
+	// so it is matched by a field selector.
+	tokens, err := ListMetadata(ctx, client, secretsResource, ns, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", string(corev1.SecretTypeServiceAccountToken)).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range tokens.Items {
+		if accounts[s.Annotations[corev1.ServiceAccountNameKey]] {
+			referenced[s.Name] = true
+		}
+	}
+
+	return referenced, nil
+}
+
+// ClusterSecretReferences indexes Secrets referenced by cluster-scoped objects: the CSI secret
+// references of PersistentVolumes, and the cert-manager CA injection source of webhook
+// configurations, APIServices and CustomResourceDefinitions
+func ClusterSecretReferences(ctx context.Context, client kubernetes.Interface, dyn dynamic.Interface) (ReferenceIndex, error) {
+	idx := make(ReferenceIndex)
+	addRef := func(ref *corev1.SecretReference) {
+		if ref != nil && ref.Namespace != "" && ref.Name != "" {
+			idx.add(ref.Namespace, ref.Name)
+		}
+	}
+	addInjected := func(annotations map[string]string) {
+		ns, name, ok := strings.Cut(annotations[CAInjectionAnnotation], "/")
+		if ok && ns != "" && name != "" {
+			idx.add(ns, name)
+		}
+	}
+
+	pvs, err := client.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, pv := range pvs.Items {
+		if csi := pv.Spec.CSI; csi != nil {
+			addRef(csi.NodePublishSecretRef)
+			addRef(csi.NodeStageSecretRef)
+			addRef(csi.ControllerPublishSecretRef)
+			addRef(csi.ControllerExpandSecretRef)
+			addRef(csi.NodeExpandSecretRef)
+		}
+	}
+
+	mutating, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range mutating.Items {
+		addInjected(w.Annotations)
+	}
+
+	validating, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range validating.Items {
+		addInjected(w.Annotations)
+	}
+
+	for _, gvr := range []schema.GroupVersionResource{APIServiceGVR, CustomResourceDefinitionGVR} {
+		items, err := ListOptional(ctx, dyn, gvr, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			addInjected(item.GetAnnotations())
+		}
+	}
+
+	return idx, nil
+}
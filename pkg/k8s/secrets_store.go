@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// secretsStoreCSIDriver is the driver name used by the Secrets Store CSI Driver
+const secretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+
+var (
+	// SecretProviderClassGVR identifies Secrets Store CSI Driver SecretProviderClasses
+	SecretProviderClassGVR = schema.GroupVersionResource{Group: "secrets-store.csi.x-k8s.io", Version: "v1", Resource: "secretproviderclasses"}
+
+	// ExternalSecretGVR identifies External Secrets Operator ExternalSecrets. Older operators serve only
+	// v1beta1; resolve the served version with APIVersions.GVR.
+	ExternalSecretGVR = schema.GroupVersionResource{Group: "external-secrets.io", Version: "v1", Resource: "externalsecrets"}
+)
+
+// ListOptional lists a custom resource in chunks of DefaultChunkSize items, treating a missing CRD as an empty list
+func ListOptional(ctx context.Context, dyn dynamic.Interface, gvr schema.GroupVersionResource, ns string) ([]unstructured.Unstructured, error) {
+	list, err := listPages(ctx, DefaultChunkSize, metav1.ListOptions{}, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return dyn.Resource(gvr).Namespace(ns).List(ctx, opts)
+	})
+	if err != nil {
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return list.(*unstructured.UnstructuredList).Items, nil
+}
+
+// externalSecretTarget returns the name of the Secret an ExternalSecret writes to
+func externalSecretTarget(es unstructured.Unstructured) string {
+	name, found, _ := unstructured.NestedString(es.Object, "spec", "target", "name")
+	if !found || name == "" {
+		return es.GetName()
+	}
+	return name
+}
+
+// SecretsSyncedByOperators returns names of Secrets that are produced by
+// SecretProviderClass secretObjects or ExternalSecret targets in a namespace.
+// Such Secrets are managed by their operator and must not be reported as orphans.
+// ExternalSecrets are read in the version versions resolves.
+func SecretsSyncedByOperators(ctx context.Context, dyn dynamic.Interface, versions *APIVersions, ns string) (map[string]bool, error) {
+	synced := make(map[string]bool)
+
+	spcs, err := ListOptional(ctx, dyn, SecretProviderClassGVR, ns)
+	if err != nil {
+		return nil, err
+	}
+	for _, spc := range spcs {
+		objects, _, _ := unstructured.NestedSlice(spc.Object, "spec", "secretObjects")
+		for _, o := range objects {
+			obj, ok := o.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, ok := obj["secretName"].(string); ok && name != "" {
+				synced[name] = true
+			}
+		}
+	}
+
+	externalSecrets, err := ListOptional(ctx, dyn, versions.GVR("ExternalSecret"), ns)
+	if err != nil {
+		return nil, err
+	}
+	for _, es := range externalSecrets {
+		synced[externalSecretTarget(es)] = true
+	}
+
+	return synced, nil
+}
+
+// OrphanSecretProviderClasses returns the SecretProviderClasses not mounted by any pod
+// through the Secrets Store CSI driver
+func OrphanSecretProviderClasses(ctx context.Context, client kubernetes.Interface, dyn dynamic.Interface, ns string) ([]metav1.ObjectMeta, error) {
+	spcs, err := ListOptional(ctx, dyn, SecretProviderClassGVR, ns)
+	if err != nil || len(spcs) == 0 {
+		return nil, err
+	}
+
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Build set of SecretProviderClasses mounted by pods
+	mounted := make(map[string]bool)
+	for _, pod := range pods.Items {
+		for _, vol := range pod.Spec.Volumes {
+			if vol.CSI == nil || vol.CSI.Driver != secretsStoreCSIDriver {
+				continue
+			}
+			if name := vol.CSI.VolumeAttributes["secretProviderClass"]; name != "" {
+				mounted[name] = true
+			}
+		}
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, spc := range spcs {
+		if len(spc.GetOwnerReferences()) > 0 {
+			continue
+		}
+		if !mounted[spc.GetName()] {
+			orphans = append(orphans, unstructuredMeta(&spc))
+		}
+	}
+	return orphans, nil
+}
+
+// OrphanExternalSecrets returns the ExternalSecrets whose target Secret is not used by any pod or workload pod template.
+// ExternalSecrets are read in the version versions resolves.
+func OrphanExternalSecrets(ctx context.Context, client kubernetes.Interface, dyn dynamic.Interface, versions *APIVersions, ns string) ([]metav1.ObjectMeta, error) {
+	externalSecrets, err := ListOptional(ctx, dyn, versions.GVR("ExternalSecret"), ns)
+	if err != nil || len(externalSecrets) == 0 {
+		return nil, err
+	}
+
+	specs, err := podSpecs(ctx, client, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []metav1.ObjectMeta
+	for _, es := range externalSecrets {
+		if len(es.GetOwnerReferences()) > 0 {
+			continue
+		}
+
+		target := externalSecretTarget(es)
+		isUsed := false
+		for _, spec := range specs {
+			if isSecretUsedByPodSpec(spec, target) {
+				isUsed = true
+				break
+			}
+		}
+
+		if !isUsed {
+			orphans = append(orphans, unstructuredMeta(&es))
+		}
+	}
+	return orphans, nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// OversizedObjectReason is the finding reason for ConfigMaps and Secrets over the configured size, used or not
+const OversizedObjectReason = "OversizedObject"
+
+// OversizedConfigMaps returns the ConfigMaps in a namespace whose encoded size exceeds maxBytes
+func OversizedConfigMaps(ctx context.Context, client kubernetes.Interface, ns string, maxBytes int64) ([]metav1.ObjectMeta, error) {
+	cms, err := client.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []metav1.ObjectMeta
+	for i := range cms.Items {
+		// Size is the protobuf encoding the API server stores in etcd
+		if int64(cms.Items[i].Size()) > maxBytes {
+			orphans = append(orphans, cms.Items[i].ObjectMeta)
+		}
+	}
+	return orphans, nil
+}
+
+// OversizedSecrets returns the Secrets in a namespace whose encoded size exceeds maxBytes
+func OversizedSecrets(ctx context.Context, client kubernetes.Interface, ns string, maxBytes int64) ([]metav1.ObjectMeta, error) {
+	secrets, err := client.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []metav1.ObjectMeta
+	for i := range secrets.Items {
+		if int64(secrets.Items[i].Size()) > maxBytes {
+			orphans = append(orphans, secrets.Items[i].ObjectMeta)
+		}
+	}
+	return orphans, nil
+}
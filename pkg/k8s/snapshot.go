@@ -0,0 +1,545 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	typedappsv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	typedbatchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	typednetworkingv1 "k8s.io/client-go/kubernetes/typed/networking/v1"
+	typedrbacv1 "k8s.io/client-go/kubernetes/typed/rbac/v1"
+)
+
+// SnapshotClient is a clientset whose unfiltered Lists of the resources detectors share are sent to
+// the API server once per namespace and answered from memory afterwards, so a scan lists the Pods of
+// a namespace once instead of once per detector. Lists of those resources are fetched in chunks.
+// Lists with a selector, limit or resource version, all other requests, and failed Lists go to the
+// API server unchanged.
+//
+// Each List returns its own copy of the snapshot, so callers may modify it. Release drops the lists
+// of a namespace once it is scanned, bounding memory to one namespace and the cluster-scoped lists.
+type SnapshotClient struct {
+	kubernetes.Interface
+
+	// informers answer snapshotted Lists from their caches in watch mode; nil otherwise
+	informers *Informers
+
+	// chunkSize is the number of items listed per request; 0 lists whole collections at once
+	chunkSize int64
+
+	mu    sync.Mutex
+	lists map[string]runtime.Object
+}
+
+// NewSnapshotClient creates a SnapshotClient that lists through client in chunks of chunkSize items
+func NewSnapshotClient(client kubernetes.Interface, chunkSize int64) *SnapshotClient {
+	return &SnapshotClient{Interface: client, chunkSize: chunkSize, lists: make(map[string]runtime.Object)}
+}
+
+// NewInformerSnapshotClient creates a SnapshotClient that takes its snapshots from the caches of
+// informers once they have synced, and lists through client otherwise
+func NewInformerSnapshotClient(client kubernetes.Interface, informers *Informers, chunkSize int64) *SnapshotClient {
+	c := NewSnapshotClient(client, chunkSize)
+	c.informers = informers
+	return c
+}
+
+// Release drops the snapshot of namespace ns
+func (c *SnapshotClient) Release(ns string) {
+	if ns == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.lists {
+		if snapshotNamespace(key) == ns {
+			delete(c.lists, key)
+		}
+	}
+}
+
+// list returns the snapshot of resource in ns, listing it in chunks with fetch the first time.
+// Filtered or paginated Lists are never snapshotted.
+func (c *SnapshotClient) list(ctx context.Context, resource, ns string, opts metav1.ListOptions,
+	fetch func(context.Context, metav1.ListOptions) (runtime.Object, error)) (runtime.Object, error) {
+	if !snapshotted(opts) {
+		return listPages(ctx, c.chunkSize, opts, fetch)
+	}
+
+	key := ns + "/" + resource
+	c.mu.Lock()
+	cached, ok := c.lists[key]
+	c.mu.Unlock()
+	if ok {
+		return cached.DeepCopyObject(), nil
+	}
+
+	obj, ok := c.informers.list(ctx, resource, ns)
+	if !ok {
+		var err error
+		if obj, err = listPages(ctx, c.chunkSize, opts, fetch); err != nil {
+			return nil, err
+		}
+	}
+	c.mu.Lock()
+	c.lists[key] = obj
+	c.mu.Unlock()
+	return obj.DeepCopyObject(), nil
+}
+
+// snapshotted reports whether a List with opts is answered from the snapshot
+func snapshotted(opts metav1.ListOptions) bool {
+	return opts.LabelSelector == "" && opts.FieldSelector == "" && opts.Limit == 0 && opts.Continue == "" &&
+		opts.ResourceVersion == "" && !opts.Watch
+}
+
+// ListMetadata lists the metadata of the gvr objects in ns. It is taken from the snapshot of the full
+// objects when there is one, so the objects are not listed twice, and otherwise from the informers'
+// metadata caches or the metadata API once, and snapshotted on its own.
+func (c *SnapshotClient) ListMetadata(ctx context.Context, gvr schema.GroupVersionResource, ns string, opts metav1.ListOptions) (*metav1.PartialObjectMetadataList, error) {
+	if snapshotted(opts) {
+		c.mu.Lock()
+		full, ok := c.lists[ns+"/"+gvr.Resource]
+		c.mu.Unlock()
+		if ok {
+			return metadataList(full)
+		}
+	}
+	obj, err := c.list(ctx, gvr.Resource+".metadata", ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return ListMetadata(ctx, c.Interface, gvr, ns, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*metav1.PartialObjectMetadataList), nil
+}
+
+// snapshotNamespace returns the namespace of a snapshot key; "" for cluster-scoped and all-namespace lists
+func snapshotNamespace(key string) string {
+	ns, _, _ := strings.Cut(key, "/")
+	return ns
+}
+
+// CoreV1 returns the core client, with Lists of its workload-related resources snapshotted
+func (c *SnapshotClient) CoreV1() typedcorev1.CoreV1Interface {
+	return snapshotCoreV1{CoreV1Interface: c.Interface.CoreV1(), snapshot: c}
+}
+
+// AppsV1 returns the apps client, with Lists snapshotted
+func (c *SnapshotClient) AppsV1() typedappsv1.AppsV1Interface {
+	return snapshotAppsV1{AppsV1Interface: c.Interface.AppsV1(), snapshot: c}
+}
+
+// BatchV1 returns the batch client, with Lists snapshotted
+func (c *SnapshotClient) BatchV1() typedbatchv1.BatchV1Interface {
+	return snapshotBatchV1{BatchV1Interface: c.Interface.BatchV1(), snapshot: c}
+}
+
+// RbacV1 returns the RBAC client, with Lists snapshotted
+func (c *SnapshotClient) RbacV1() typedrbacv1.RbacV1Interface {
+	return snapshotRbacV1{RbacV1Interface: c.Interface.RbacV1(), snapshot: c}
+}
+
+// NetworkingV1 returns the networking client, with Lists of Ingresses snapshotted
+func (c *SnapshotClient) NetworkingV1() typednetworkingv1.NetworkingV1Interface {
+	return snapshotNetworkingV1{NetworkingV1Interface: c.Interface.NetworkingV1(), snapshot: c}
+}
+
+type snapshotCoreV1 struct {
+	typedcorev1.CoreV1Interface
+	snapshot *SnapshotClient
+}
+
+func (c snapshotCoreV1) Pods(ns string) typedcorev1.PodInterface {
+	return snapshotPods{PodInterface: c.CoreV1Interface.Pods(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotCoreV1) Services(ns string) typedcorev1.ServiceInterface {
+	return snapshotServices{ServiceInterface: c.CoreV1Interface.Services(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotCoreV1) ServiceAccounts(ns string) typedcorev1.ServiceAccountInterface {
+	return snapshotServiceAccounts{ServiceAccountInterface: c.CoreV1Interface.ServiceAccounts(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotCoreV1) Secrets(ns string) typedcorev1.SecretInterface {
+	return snapshotSecrets{SecretInterface: c.CoreV1Interface.Secrets(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotCoreV1) ConfigMaps(ns string) typedcorev1.ConfigMapInterface {
+	return snapshotConfigMaps{ConfigMapInterface: c.CoreV1Interface.ConfigMaps(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotCoreV1) PersistentVolumeClaims(ns string) typedcorev1.PersistentVolumeClaimInterface {
+	return snapshotPVCs{PersistentVolumeClaimInterface: c.CoreV1Interface.PersistentVolumeClaims(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotCoreV1) PersistentVolumes() typedcorev1.PersistentVolumeInterface {
+	return snapshotPVs{PersistentVolumeInterface: c.CoreV1Interface.PersistentVolumes(), snapshot: c.snapshot}
+}
+
+type snapshotPods struct {
+	typedcorev1.PodInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotPods) List(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+	obj, err := l.snapshot.list(ctx, "pods", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.PodInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.PodList), nil
+}
+
+type snapshotServices struct {
+	typedcorev1.ServiceInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotServices) List(ctx context.Context, opts metav1.ListOptions) (*corev1.ServiceList, error) {
+	obj, err := l.snapshot.list(ctx, "services", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.ServiceInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.ServiceList), nil
+}
+
+type snapshotServiceAccounts struct {
+	typedcorev1.ServiceAccountInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotServiceAccounts) List(ctx context.Context, opts metav1.ListOptions) (*corev1.ServiceAccountList, error) {
+	obj, err := l.snapshot.list(ctx, "serviceaccounts", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.ServiceAccountInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.ServiceAccountList), nil
+}
+
+type snapshotSecrets struct {
+	typedcorev1.SecretInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotSecrets) List(ctx context.Context, opts metav1.ListOptions) (*corev1.SecretList, error) {
+	obj, err := l.snapshot.list(ctx, "secrets", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.SecretInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.SecretList), nil
+}
+
+type snapshotConfigMaps struct {
+	typedcorev1.ConfigMapInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotConfigMaps) List(ctx context.Context, opts metav1.ListOptions) (*corev1.ConfigMapList, error) {
+	obj, err := l.snapshot.list(ctx, "configmaps", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.ConfigMapInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.ConfigMapList), nil
+}
+
+type snapshotPVCs struct {
+	typedcorev1.PersistentVolumeClaimInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotPVCs) List(ctx context.Context, opts metav1.ListOptions) (*corev1.PersistentVolumeClaimList, error) {
+	obj, err := l.snapshot.list(ctx, "persistentvolumeclaims", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.PersistentVolumeClaimInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.PersistentVolumeClaimList), nil
+}
+
+type snapshotPVs struct {
+	typedcorev1.PersistentVolumeInterface
+	snapshot *SnapshotClient
+}
+
+func (l snapshotPVs) List(ctx context.Context, opts metav1.ListOptions) (*corev1.PersistentVolumeList, error) {
+	obj, err := l.snapshot.list(ctx, "persistentvolumes", "", opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.PersistentVolumeInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*corev1.PersistentVolumeList), nil
+}
+
+type snapshotAppsV1 struct {
+	typedappsv1.AppsV1Interface
+	snapshot *SnapshotClient
+}
+
+func (c snapshotAppsV1) Deployments(ns string) typedappsv1.DeploymentInterface {
+	return snapshotDeployments{DeploymentInterface: c.AppsV1Interface.Deployments(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotAppsV1) StatefulSets(ns string) typedappsv1.StatefulSetInterface {
+	return snapshotStatefulSets{StatefulSetInterface: c.AppsV1Interface.StatefulSets(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotAppsV1) DaemonSets(ns string) typedappsv1.DaemonSetInterface {
+	return snapshotDaemonSets{DaemonSetInterface: c.AppsV1Interface.DaemonSets(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotAppsV1) ReplicaSets(ns string) typedappsv1.ReplicaSetInterface {
+	return snapshotReplicaSets{ReplicaSetInterface: c.AppsV1Interface.ReplicaSets(ns), snapshot: c.snapshot, ns: ns}
+}
+
+type snapshotDeployments struct {
+	typedappsv1.DeploymentInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotDeployments) List(ctx context.Context, opts metav1.ListOptions) (*appsv1.DeploymentList, error) {
+	obj, err := l.snapshot.list(ctx, "deployments", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.DeploymentInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*appsv1.DeploymentList), nil
+}
+
+type snapshotStatefulSets struct {
+	typedappsv1.StatefulSetInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotStatefulSets) List(ctx context.Context, opts metav1.ListOptions) (*appsv1.StatefulSetList, error) {
+	obj, err := l.snapshot.list(ctx, "statefulsets", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.StatefulSetInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*appsv1.StatefulSetList), nil
+}
+
+type snapshotDaemonSets struct {
+	typedappsv1.DaemonSetInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotDaemonSets) List(ctx context.Context, opts metav1.ListOptions) (*appsv1.DaemonSetList, error) {
+	obj, err := l.snapshot.list(ctx, "daemonsets", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.DaemonSetInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*appsv1.DaemonSetList), nil
+}
+
+type snapshotReplicaSets struct {
+	typedappsv1.ReplicaSetInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotReplicaSets) List(ctx context.Context, opts metav1.ListOptions) (*appsv1.ReplicaSetList, error) {
+	obj, err := l.snapshot.list(ctx, "replicasets", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.ReplicaSetInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*appsv1.ReplicaSetList), nil
+}
+
+type snapshotBatchV1 struct {
+	typedbatchv1.BatchV1Interface
+	snapshot *SnapshotClient
+}
+
+func (c snapshotBatchV1) Jobs(ns string) typedbatchv1.JobInterface {
+	return snapshotJobs{JobInterface: c.BatchV1Interface.Jobs(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotBatchV1) CronJobs(ns string) typedbatchv1.CronJobInterface {
+	return snapshotCronJobs{CronJobInterface: c.BatchV1Interface.CronJobs(ns), snapshot: c.snapshot, ns: ns}
+}
+
+type snapshotJobs struct {
+	typedbatchv1.JobInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotJobs) List(ctx context.Context, opts metav1.ListOptions) (*batchv1.JobList, error) {
+	obj, err := l.snapshot.list(ctx, "jobs", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.JobInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*batchv1.JobList), nil
+}
+
+type snapshotCronJobs struct {
+	typedbatchv1.CronJobInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotCronJobs) List(ctx context.Context, opts metav1.ListOptions) (*batchv1.CronJobList, error) {
+	obj, err := l.snapshot.list(ctx, "cronjobs", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.CronJobInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*batchv1.CronJobList), nil
+}
+
+type snapshotRbacV1 struct {
+	typedrbacv1.RbacV1Interface
+	snapshot *SnapshotClient
+}
+
+func (c snapshotRbacV1) Roles(ns string) typedrbacv1.RoleInterface {
+	return snapshotRoles{RoleInterface: c.RbacV1Interface.Roles(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotRbacV1) RoleBindings(ns string) typedrbacv1.RoleBindingInterface {
+	return snapshotRoleBindings{RoleBindingInterface: c.RbacV1Interface.RoleBindings(ns), snapshot: c.snapshot, ns: ns}
+}
+
+func (c snapshotRbacV1) ClusterRoles() typedrbacv1.ClusterRoleInterface {
+	return snapshotClusterRoles{ClusterRoleInterface: c.RbacV1Interface.ClusterRoles(), snapshot: c.snapshot}
+}
+
+func (c snapshotRbacV1) ClusterRoleBindings() typedrbacv1.ClusterRoleBindingInterface {
+	return snapshotClusterRoleBindings{ClusterRoleBindingInterface: c.RbacV1Interface.ClusterRoleBindings(), snapshot: c.snapshot}
+}
+
+type snapshotRoles struct {
+	typedrbacv1.RoleInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotRoles) List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.RoleList, error) {
+	obj, err := l.snapshot.list(ctx, "roles", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.RoleInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*rbacv1.RoleList), nil
+}
+
+type snapshotRoleBindings struct {
+	typedrbacv1.RoleBindingInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotRoleBindings) List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.RoleBindingList, error) {
+	obj, err := l.snapshot.list(ctx, "rolebindings", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.RoleBindingInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*rbacv1.RoleBindingList), nil
+}
+
+type snapshotClusterRoles struct {
+	typedrbacv1.ClusterRoleInterface
+	snapshot *SnapshotClient
+}
+
+func (l snapshotClusterRoles) List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.ClusterRoleList, error) {
+	obj, err := l.snapshot.list(ctx, "clusterroles", "", opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.ClusterRoleInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*rbacv1.ClusterRoleList), nil
+}
+
+type snapshotClusterRoleBindings struct {
+	typedrbacv1.ClusterRoleBindingInterface
+	snapshot *SnapshotClient
+}
+
+func (l snapshotClusterRoleBindings) List(ctx context.Context, opts metav1.ListOptions) (*rbacv1.ClusterRoleBindingList, error) {
+	obj, err := l.snapshot.list(ctx, "clusterrolebindings", "", opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.ClusterRoleBindingInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*rbacv1.ClusterRoleBindingList), nil
+}
+
+type snapshotNetworkingV1 struct {
+	typednetworkingv1.NetworkingV1Interface
+	snapshot *SnapshotClient
+}
+
+func (c snapshotNetworkingV1) Ingresses(ns string) typednetworkingv1.IngressInterface {
+	return snapshotIngresses{IngressInterface: c.NetworkingV1Interface.Ingresses(ns), snapshot: c.snapshot, ns: ns}
+}
+
+type snapshotIngresses struct {
+	typednetworkingv1.IngressInterface
+	snapshot *SnapshotClient
+	ns       string
+}
+
+func (l snapshotIngresses) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1.IngressList, error) {
+	obj, err := l.snapshot.list(ctx, "ingresses", l.ns, opts, func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return l.IngressInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*networkingv1.IngressList), nil
+}
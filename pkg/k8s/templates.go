@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// podSpecs returns the specs of the running pods in ns and the pod templates of its
+// Deployments, StatefulSets, DaemonSets, Jobs and CronJobs, so objects referenced
+// only by a workload scaled to zero or a CronJob between runs still count as in use.
+func podSpecs(ctx context.Context, client kubernetes.Interface, ns string) ([]corev1.PodSpec, error) {
+	var specs []corev1.PodSpec
+
+	pods, err := client.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		specs = append(specs, pod.Spec)
+	}
+
+	deployments, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments.Items {
+		specs = append(specs, d.Spec.Template.Spec)
+	}
+
+	statefulSets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statefulSets.Items {
+		specs = append(specs, s.Spec.Template.Spec)
+	}
+
+	daemonSets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range daemonSets.Items {
+		specs = append(specs, d.Spec.Template.Spec)
+	}
+
+	jobs, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, j := range jobs.Items {
+		specs = append(specs, j.Spec.Template.Spec)
+	}
+
+	cronJobs, err := client.BatchV1().CronJobs(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cronJobs.Items {
+		specs = append(specs, c.Spec.JobTemplate.Spec.Template.Spec)
+	}
+
+	return specs, nil
+}
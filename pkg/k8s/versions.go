@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// ResourceVersionFallbacks lists, newest first, the older API versions korp can read for resource types
+// whose version in ResourceGVRs is not served by older clusters still in support
+var ResourceVersionFallbacks = map[string][]string{
+	// policy/v1 is served since Kubernetes 1.21
+	"PodDisruptionBudget": {"v1beta1"},
+
+	// autoscaling/v2 is served since Kubernetes 1.23
+	"HorizontalPodAutoscaler": {"v2beta2", "v1"},
+
+	// external-secrets.io/v1 is served since External Secrets Operator 0.16
+	"ExternalSecret": {"v1beta1"},
+}
+
+// APIVersions resolves resource types to the newest API version the cluster serves, using discovery.
+// Discovery results are cached, so use one APIVersions per scan.
+type APIVersions struct {
+	discovery discovery.DiscoveryInterface
+
+	// served holds the resources of each group version asked for; nil if the group version is not served
+	served map[string]*metav1.APIResourceList
+}
+
+// NewAPIVersions creates an APIVersions that asks d
+func NewAPIVersions(d discovery.DiscoveryInterface) *APIVersions {
+	return &APIVersions{discovery: d, served: make(map[string]*metav1.APIResourceList)}
+}
+
+// Resolve returns the API resource of resourceType in the version of ResourceGVRs if the cluster serves
+// it, else in the newest version of ResourceVersionFallbacks it serves. served is false if no version is
+// served. Discovery errors other than a missing group version are returned.
+func (v *APIVersions) Resolve(resourceType string) (gvr schema.GroupVersionResource, served bool, err error) {
+	preferred, ok := ResourceGVRs[resourceType]
+	if !ok {
+		return schema.GroupVersionResource{}, false, nil
+	}
+	candidates := []schema.GroupVersionResource{preferred}
+	for _, version := range ResourceVersionFallbacks[resourceType] {
+		candidates = append(candidates, preferred.GroupResource().WithVersion(version))
+	}
+
+	for _, candidate := range candidates {
+		resources, err := v.resources(candidate.GroupVersion().String())
+		if err != nil {
+			return preferred, false, err
+		}
+		if resources == nil {
+			continue
+		}
+		for _, r := range resources.APIResources {
+			if r.Name == candidate.Resource {
+				return candidate, true, nil
+			}
+		}
+	}
+	return preferred, false, nil
+}
+
+// GVR returns the API resource to read resourceType in: the newest version the cluster serves, or the
+// version of ResourceGVRs when discovery cannot tell. A nil APIVersions always returns ResourceGVRs.
+func (v *APIVersions) GVR(resourceType string) schema.GroupVersionResource {
+	if v == nil {
+		return ResourceGVRs[resourceType]
+	}
+	if gvr, served, err := v.Resolve(resourceType); err == nil && served {
+		return gvr
+	}
+	return ResourceGVRs[resourceType]
+}
+
+// ServesGroupVersion reports whether the cluster serves the group version gv, e.g. "policy/v1"
+func (v *APIVersions) ServesGroupVersion(gv string) (bool, error) {
+	resources, err := v.resources(gv)
+	return resources != nil, err
+}
+
+// resources returns the resources the cluster serves in gv, or nil if gv is not served
+func (v *APIVersions) resources(gv string) (*metav1.APIResourceList, error) {
+	if resources, ok := v.served[gv]; ok {
+		return resources, nil
+	}
+	resources, err := v.discovery.ServerResourcesForGroupVersion(gv)
+	if errors.IsNotFound(err) {
+		resources, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	v.served[gv] = resources
+	return resources, nil
+}
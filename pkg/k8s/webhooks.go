@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package k8s
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// StaleCABundleReason is the finding reason for webhook configurations whose caBundle has no valid certificate
+const StaleCABundleReason = "StaleCABundle"
+
+// caBundleStale reports whether a non-empty caBundle holds no certificate that is valid at now.
+// An empty caBundle is not stale: the API server then verifies with its system trust roots.
+func caBundleStale(bundle []byte, now time.Time) bool {
+	if len(bundle) == 0 {
+		return false
+	}
+
+	for rest := bundle; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return true
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if !now.Before(cert.NotBefore) && !now.After(cert.NotAfter) {
+			return false
+		}
+	}
+}
+
+// MutatingWebhookConfigurationsWithStaleCABundle returns the MutatingWebhookConfigurations
+// with at least one webhook whose caBundle is expired or unusable
+func MutatingWebhookConfigurationsWithStaleCABundle(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
+	configs, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var orphans []metav1.ObjectMeta
+	for _, config := range configs.Items {
+		for _, webhook := range config.Webhooks {
+			if caBundleStale(webhook.ClientConfig.CABundle, now) {
+				orphans = append(orphans, config.ObjectMeta)
+				break
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// ValidatingWebhookConfigurationsWithStaleCABundle returns the ValidatingWebhookConfigurations
+// with at least one webhook whose caBundle is expired or unusable
+func ValidatingWebhookConfigurationsWithStaleCABundle(ctx context.Context, client kubernetes.Interface) ([]metav1.ObjectMeta, error) {
+	configs, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var orphans []metav1.ObjectMeta
+	for _, config := range configs.Items {
+		for _, webhook := range config.Webhooks {
+			if caBundleStale(webhook.ClientConfig.CABundle, now) {
+				orphans = append(orphans, config.ObjectMeta)
+				break
+			}
+		}
+	}
+	return orphans, nil
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package korp is the Go library for embedding korp's orphan detection and cleanup in other tools,
+// such as platform CLIs or admission controllers. It depends only on client-go and apimachinery.
+//
+//	k := korp.New(clientset, dynamicClient)
+//	result, err := k.Scan(ctx, korp.ScanOptions{Namespace: "staging"})
+//	...
+//	cleaned, err := k.Clean(ctx, result, korp.CleanOptions{MinAgeDays: 14})
+package korp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/cleanup"
+	"github.com/kamilbabayev/korp/pkg/policy"
+	"github.com/kamilbabayev/korp/pkg/scan"
+)
+
+// Result types shared with the KorpScan API
+type (
+	// Finding is one orphaned resource
+	Finding = korpv1alpha1.Finding
+
+	// Summary holds the counts of a scan by resource type
+	Summary = korpv1alpha1.ScanSummary
+
+	// BlindSpot is a resource type korp was forbidden to list, with the namespaces affected
+	BlindSpot = korpv1alpha1.BlindSpot
+
+	// CleanupSummary holds the counts of a cleanup
+	CleanupSummary = korpv1alpha1.CleanupSummary
+
+	// Remediation is a resource cleanup changed, with the action applied
+	Remediation = korpv1alpha1.DeletedResource
+
+	// PlannedRemediation is a resource a dry run would have changed
+	PlannedRemediation = korpv1alpha1.PlannedRemediation
+
+	// FailedRemediation is a resource cleanup failed to change
+	FailedRemediation = korpv1alpha1.FailedDeletion
+)
+
+// Typed errors of detectors; use errors.As to tell them apart
+type (
+	// PermissionError means korp is forbidden to read what a detector needs
+	PermissionError = scan.PermissionError
+
+	// NotSupportedError means the cluster does not serve an API a detector needs
+	NotSupportedError = scan.NotSupportedError
+
+	// TransientAPIError means the API server failed in a way that is likely to pass; retrying usually helps
+	TransientAPIError = scan.TransientAPIError
+)
+
+// Client scans a cluster for orphaned resources and cleans them up
+type Client struct {
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+}
+
+// New creates a Client from a Kubernetes clientset and a dynamic client
+func New(client kubernetes.Interface, dynamicClient dynamic.Interface) *Client {
+	return &Client{client: client, dynamic: dynamicClient}
+}
+
+// NewForConfig creates a Client for the cluster described by config
+func NewForConfig(config *rest.Config) (*Client, error) {
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("creating clientset: %w", err)
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("creating dynamic client: %w", err)
+	}
+	return New(client, dynamicClient), nil
+}
+
+// ScanOptions configures a scan. The zero value scans all namespaces for all supported resource types.
+type ScanOptions struct {
+	// Namespace is the namespace to scan; "" or "*" scans all namespaces
+	Namespace string
+
+	// ResourceTypes are the resource types to scan (e.g. "configmaps", "pvcs"); all supported types if empty
+	ResourceTypes []string
+
+	// ExcludeNamespaces are namespaces not scanned when scanning all namespaces
+	ExcludeNamespaces []string
+
+	// IncludeNamePatterns are regular expressions of which a resource's name must match one to be reported; all names if empty
+	IncludeNamePatterns []string
+
+	// IncludeLabels are labels a resource must all carry to be reported; all resources if empty
+	IncludeLabels map[string]string
+
+	// ExcludeNamePatterns are regular expressions for resource names never reported
+	ExcludeNamePatterns []string
+
+	// FilterPresets are named sets of known-safe exclusions: helm, argocd, istio, cert-manager
+	FilterPresets []string
+
+	// NamespaceOptIn scans only namespaces annotated with korp.io/scan=enabled
+	NamespaceOptIn bool
+
+	// NamespaceSelector scans only namespaces whose labels match it when scanning all namespaces
+	NamespaceSelector *metav1.LabelSelector
+
+	// IncludeNamespaces scans only these namespaces, and those NamespaceSelector matches, when scanning all namespaces
+	IncludeNamespaces []string
+
+	// ReferenceAnnotations are extra annotation keys whose values name ConfigMaps or Secrets in use
+	ReferenceAnnotations []string
+
+	// MaxObjectSizeBytes also reports ConfigMaps and Secrets larger than this, used or not; 0 disables it
+	MaxObjectSizeBytes int64
+
+	// Labels stand in for the labels of a KorpScan when matching the scanSelector of KorpPolicies
+	Labels map[string]string
+
+	// Progress is called before each namespace is scanned, if set
+	Progress func(scanned, total int, current string)
+}
+
+// ScanResult is the outcome of a scan
+type ScanResult struct {
+	// Findings are the orphaned resources found
+	Findings []Finding
+
+	// Summary holds the counts by resource type
+	Summary Summary
+
+	// ScannedNamespaces are the namespaces whose namespace-scoped resources were scanned
+	ScannedNamespaces []string
+
+	// BlindSpots are resource types korp was forbidden to list
+	BlindSpots []BlindSpot
+
+	// UnsupportedTypes are requested resource types the cluster does not serve or korp does not know
+	UnsupportedTypes []string
+
+	// Partial is set when ctx ended before the scan finished; Findings then holds what was found so far
+	Partial bool
+
+	// SkippedNamespaces are the namespaces a partial scan did not finish
+	SkippedNamespaces []string
+
+	// policies are the KorpPolicies that applied to the scan, evaluated again by Clean
+	policies *policy.Set
+}
+
+// Scan scans the cluster for orphaned resources. KorpPolicies in the cluster are honored.
+// If ctx ends during the scan, the findings so far are returned in a result marked Partial together with the error.
+// Detector failures that end the scan are returned as a PermissionError, NotSupportedError or TransientAPIError
+// when they fall in one of those classes.
+func (c *Client) Scan(ctx context.Context, opts ScanOptions) (*ScanResult, error) {
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = "*"
+	}
+	korpScan := &korpv1alpha1.KorpScan{
+		ObjectMeta: metav1.ObjectMeta{Labels: opts.Labels},
+		Spec: korpv1alpha1.KorpScanSpec{
+			TargetNamespace:   namespace,
+			NamespaceOptIn:    opts.NamespaceOptIn,
+			NamespaceSelector: opts.NamespaceSelector,
+			IncludeNamespaces: opts.IncludeNamespaces,
+			ResourceTypes:     opts.ResourceTypes,
+			FilterPresets:     opts.FilterPresets,
+			Filters: korpv1alpha1.FilterSpec{
+				IncludeNamePatterns: opts.IncludeNamePatterns,
+				IncludeLabels:       opts.IncludeLabels,
+				ExcludeNamespaces:   opts.ExcludeNamespaces,
+				ExcludeNamePatterns: opts.ExcludeNamePatterns,
+			},
+			Detection: korpv1alpha1.DetectionSpec{
+				ReferenceAnnotations: opts.ReferenceAnnotations,
+				MaxObjectSizeBytes:   opts.MaxObjectSizeBytes,
+			},
+		},
+	}
+
+	res, err := scan.NewScanner(c.client, c.dynamic).ScanWithProgress(ctx, korpScan, opts.Progress)
+	if res == nil {
+		return nil, err
+	}
+
+	result := &ScanResult{
+		Findings:          res.Details,
+		Summary:           res.Summary,
+		ScannedNamespaces: res.ScannedNamespaces,
+		BlindSpots:        res.BlindSpots,
+		Partial:           res.Partial,
+		SkippedNamespaces: res.SkippedNamespaces,
+		policies:          res.Policies,
+	}
+	result.Summary.OrphanCount = res.Summary.TotalOrphans()
+	for _, u := range res.UnsupportedTypes {
+		result.UnsupportedTypes = append(result.UnsupportedTypes, u.String())
+	}
+	return result, err
+}
+
+// CarryOver gives findings that were already reported by an earlier scan the time they were first
+// detected, so MinAgeDays in Clean counts from then. previous are the findings of the earlier scan.
+func (r *ScanResult) CarryOver(previous []Finding) {
+	scan.NewDetectionTimes(previous).Apply(r.Findings)
+}
+
+// CleanOptions configures a cleanup. The zero value is a dry run over findings at least 7 days old.
+type CleanOptions struct {
+	// Apply changes resources; otherwise Clean only reports what it would do
+	Apply bool
+
+	// MinAgeDays is how long a resource must have been orphaned before it is cleaned; 0 means 7.
+	// Findings count as detected at scan time unless carried over with ScanResult.CarryOver.
+	MinAgeDays int
+
+	// ResourceTypes limits cleanup to these resource types; all if empty
+	ResourceTypes []string
+
+	// PreservationLabels are label keys that keep a resource from being cleaned
+	PreservationLabels []string
+
+	// AllowedActions limits the remediations: delete, scaleToZero, patch or label; all if empty
+	AllowedActions []string
+
+	// IncludePausedRollouts makes workloads reported as PausedRollout eligible
+	IncludePausedRollouts bool
+
+	// DeletionBudget is the maximum number of resources remediated; 0 means no limit
+	DeletionBudget int
+
+	// Logger receives a line per resource; nothing is logged if unset
+	Logger logr.Logger
+}
+
+// CleanResult is the outcome of a cleanup
+type CleanResult struct {
+	// Summary holds the counts of remediated and skipped resources
+	Summary CleanupSummary
+
+	// Remediated are the resources cleanup changed
+	Remediated []Remediation
+
+	// WouldRemediate are the resources a dry run would have changed
+	WouldRemediate []PlannedRemediation
+
+	// Failed are the resources cleanup failed to change
+	Failed []FailedRemediation
+}
+
+// Clean remediates the findings of a scan: each is deleted, scaled to zero, patched or labeled
+// according to its suggested action. KorpPolicies that applied to the scan are evaluated again.
+func (c *Client) Clean(ctx context.Context, result *ScanResult, opts CleanOptions) (*CleanResult, error) {
+	dryRun := !opts.Apply
+	spec := &korpv1alpha1.CleanupSpec{
+		Enabled:               true,
+		DryRun:                &dryRun,
+		ResourceTypes:         opts.ResourceTypes,
+		MinAgeDays:            opts.MinAgeDays,
+		PreservationLabels:    opts.PreservationLabels,
+		IncludePausedRollouts: opts.IncludePausedRollouts,
+		AllowedActions:        opts.AllowedActions,
+		DeletionBudget:        opts.DeletionBudget,
+	}
+
+	res, err := cleanup.NewCleaner(c.client, c.dynamic, opts.Logger).Clean(ctx, result.Findings, spec, result.policies)
+	if err != nil {
+		return nil, err
+	}
+	return &CleanResult{
+		Summary:        *res.Summary,
+		Remediated:     res.DeletedResources,
+		WouldRemediate: res.WouldRemediate,
+		Failed:         res.FailedDeletions,
+	}, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package metrics defines the Prometheus metrics exported by the korp operator
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+var (
+	// OrphansCreated is the number of orphans newly detected by the last scan of a KorpScan
+	OrphansCreated = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "korp_orphans_created",
+		Help: "Number of orphaned resources newly detected by the last scan",
+	}, []string{"korpscan_namespace", "korpscan"})
+
+	// OrphansResolved is the number of orphans from the previous scan that are gone in the last scan
+	OrphansResolved = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "korp_orphans_resolved",
+		Help: "Number of orphaned resources resolved since the previous scan",
+	}, []string{"korpscan_namespace", "korpscan"})
+
+	// OrphanAge is the distribution of how long current orphans have been detected.
+	// It is reset on every scan so it always reflects the latest scan.
+	OrphanAge = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "korp_orphan_age_seconds",
+		Help: "Time since current orphaned resources were first detected",
+		Buckets: []float64{
+			(1 * time.Hour).Seconds(),
+			(24 * time.Hour).Seconds(),
+			(7 * 24 * time.Hour).Seconds(),
+			(30 * 24 * time.Hour).Seconds(),
+			(90 * 24 * time.Hour).Seconds(),
+			(180 * 24 * time.Hour).Seconds(),
+			(365 * 24 * time.Hour).Seconds(),
+		},
+	}, []string{"korpscan_namespace", "korpscan", "resource_type"})
+
+	// OrphanedResources is the number of orphans per namespace and resource type found by the last scan.
+	// It is reset on every scan; cluster-scoped orphans have an empty namespace label.
+	OrphanedResources = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "korp_orphaned_resources",
+		Help: "Number of orphaned resources found by the last scan",
+	}, []string{"korpscan_namespace", "korpscan", "namespace", "resource_type"})
+
+	// ScanDuration is the distribution of completed scan durations
+	ScanDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "korp_scan_duration_seconds",
+		Help:    "Duration of completed scans",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"korpscan_namespace", "korpscan"})
+
+	// CleanupRemediations counts resources remediated by cleanup, excluding dry runs
+	CleanupRemediations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "korp_cleanup_remediations_total",
+		Help: "Number of orphaned resources deleted, scaled to zero, patched or labeled by cleanup",
+	}, []string{"korpscan_namespace", "korpscan", "resource_type", "action"})
+
+	// CleanupFailures counts failed cleanup remediations, excluding dry runs
+	CleanupFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "korp_cleanup_failures_total",
+		Help: "Number of cleanup remediations that failed",
+	}, []string{"korpscan_namespace", "korpscan", "resource_type", "action"})
+
+	// WebhookFailures counts webhook notifications that failed after all retries
+	WebhookFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "korp_webhook_failures_total",
+		Help: "Number of webhook notifications that failed after all retries",
+	}, []string{"korpscan_namespace", "korpscan"})
+
+	// ScansSkipped counts reconciles that ended without a scan because the next scan was not due
+	ScansSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "korp_scans_skipped_total",
+		Help: "Number of reconciles that skipped scanning because the next scan was not due",
+	}, []string{"korpscan_namespace", "korpscan"})
+
+	// ScheduleDrift is how late scans start relative to their schedule (last scan time plus interval)
+	ScheduleDrift = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "korp_schedule_drift_seconds",
+		Help:    "Delay between when a scan was due and when it started",
+		Buckets: []float64{1, 5, 15, 30, 60, 300, 900, 3600},
+	}, []string{"korpscan_namespace", "korpscan"})
+
+	// QueueLatency is how late requeued reconciles run relative to the requested requeue time
+	QueueLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "korp_reconcile_queue_latency_seconds",
+		Help:    "Delay between a requested requeue time and the start of the reconcile",
+		Buckets: []float64{0.1, 0.5, 1, 5, 15, 30, 60, 300},
+	}, []string{"korpscan_namespace", "korpscan"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(OrphansCreated, OrphansResolved, OrphanAge, ScansSkipped, ScheduleDrift, QueueLatency,
+		OrphanedResources, ScanDuration, CleanupRemediations, CleanupFailures, WebhookFailures)
+}
+
+// RecordTrend records created/resolved counts and orphan ages for a completed scan
+func RecordTrend(korpScan *korpv1alpha1.KorpScan, created, resolved int, findings []korpv1alpha1.Finding, now time.Time) {
+	OrphansCreated.WithLabelValues(korpScan.Namespace, korpScan.Name).Set(float64(created))
+	OrphansResolved.WithLabelValues(korpScan.Namespace, korpScan.Name).Set(float64(resolved))
+
+	OrphanAge.DeletePartialMatch(prometheus.Labels{"korpscan_namespace": korpScan.Namespace, "korpscan": korpScan.Name})
+	for _, f := range findings {
+		if !korpv1alpha1.IsOrphan(f) {
+			continue
+		}
+		OrphanAge.WithLabelValues(korpScan.Namespace, korpScan.Name, f.ResourceType).
+			Observe(now.Sub(f.DetectedAt.Time).Seconds())
+	}
+}
+
+// RecordScan records per-namespace orphan counts and the duration of a completed scan
+func RecordScan(korpScan *korpv1alpha1.KorpScan, findings []korpv1alpha1.Finding, duration time.Duration) {
+	OrphanedResources.DeletePartialMatch(prometheus.Labels{"korpscan_namespace": korpScan.Namespace, "korpscan": korpScan.Name})
+	for _, f := range findings {
+		if !korpv1alpha1.IsOrphan(f) {
+			continue
+		}
+		OrphanedResources.WithLabelValues(korpScan.Namespace, korpScan.Name, f.Namespace, f.ResourceType).Inc()
+	}
+	ScanDuration.WithLabelValues(korpScan.Namespace, korpScan.Name).Observe(duration.Seconds())
+}
+
+// RecordCleanup counts the remediations and failures of a cleanup run; dry runs are not counted
+func RecordCleanup(korpScan *korpv1alpha1.KorpScan, remediated []korpv1alpha1.DeletedResource,
+	failed []korpv1alpha1.FailedDeletion, dryRun bool) {
+	if dryRun {
+		return
+	}
+	for _, r := range remediated {
+		CleanupRemediations.WithLabelValues(korpScan.Namespace, korpScan.Name, r.ResourceType, r.Action).Inc()
+	}
+	for _, f := range failed {
+		CleanupFailures.WithLabelValues(korpScan.Namespace, korpScan.Name, f.ResourceType, f.Action).Inc()
+	}
+}
+
+// RecordWebhookFailure counts a webhook notification that failed after all retries
+func RecordWebhookFailure(namespace, name string) {
+	WebhookFailures.WithLabelValues(namespace, name).Inc()
+}
+
+// RecordSkip counts a reconcile of a KorpScan whose next scan was not due
+func RecordSkip(namespace, name string) {
+	ScansSkipped.WithLabelValues(namespace, name).Inc()
+}
+
+// RecordDrift records how long after its due time a scan started
+func RecordDrift(namespace, name string, drift time.Duration) {
+	ScheduleDrift.WithLabelValues(namespace, name).Observe(drift.Seconds())
+}
+
+// RecordQueueLatency records how long after its requested requeue time a reconcile started
+func RecordQueueLatency(namespace, name string, latency time.Duration) {
+	QueueLatency.WithLabelValues(namespace, name).Observe(latency.Seconds())
+}
+
+// Forget removes all series of a deleted KorpScan
+func Forget(namespace, name string) {
+	labels := prometheus.Labels{"korpscan_namespace": namespace, "korpscan": name}
+	OrphansCreated.DeletePartialMatch(labels)
+	OrphansResolved.DeletePartialMatch(labels)
+	OrphanAge.DeletePartialMatch(labels)
+	ScansSkipped.DeletePartialMatch(labels)
+	ScheduleDrift.DeletePartialMatch(labels)
+	QueueLatency.DeletePartialMatch(labels)
+	OrphanedResources.DeletePartialMatch(labels)
+	ScanDuration.DeletePartialMatch(labels)
+	CleanupRemediations.DeletePartialMatch(labels)
+	CleanupFailures.DeletePartialMatch(labels)
+	WebhookFailures.DeletePartialMatch(labels)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+const (
+	// AnomalyEventType is the event type of alerts about cleanup runs that deleted more than expected
+	AnomalyEventType = "cleanup.anomaly"
+
+	// PriorityHigh marks payloads that need attention now
+	PriorityHigh = "high"
+)
+
+// AnomalyPayload turns the payload of a scan into a high-priority alert about anomaly.
+// Findings are left out; the cleanup summary says what the run did.
+func AnomalyPayload(payload WebhookPayload, anomaly v1alpha1.CleanupAnomaly) WebhookPayload {
+	payload.EventType = AnomalyEventType
+	payload.Priority = PriorityHigh
+	payload.Anomaly = &anomaly
+	payload.Findings = []v1alpha1.Finding{}
+	payload.NewFindings, payload.ResolvedFindings = nil, nil
+	payload.Page = nil
+	return payload
+}
+
+// Alert posts the anomaly of an AnomalyPayload to the default channel
+func (s *SlackNotifier) Alert(ctx context.Context, payload WebhookPayload) error {
+	if payload.Anomaly == nil {
+		return fmt.Errorf("payload of event type %s has no anomaly", payload.EventType)
+	}
+	text := fmt.Sprintf(":rotating_light: korp cleanup anomaly in %s/%s: %s. Cleanup was switched to dry run.",
+		payload.KorpScan.Namespace, payload.KorpScan.Name, payload.Anomaly.Message)
+
+	message := map[string]interface{}{
+		"text": text,
+		"blocks": []map[string]interface{}{
+			{"type": "section", "text": map[string]string{"type": "mrkdwn", "text": truncate(text, maxSlackSectionLength)}},
+		},
+	}
+	if s.config.Channel != "" {
+		message["channel"] = s.config.Channel
+	}
+	return s.sendOnce(ctx, message)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// Notifier delivers payloads to one notification channel
+type Notifier interface {
+	// Send delivers payload; anomaly alerts (eventType cleanup.anomaly) go through Send as well
+	Send(ctx context.Context, payload WebhookPayload) error
+}
+
+// Factory builds the notifier of spec. The Secrets and ConfigMaps it refers to are read from namespace ns.
+type Factory func(ctx context.Context, client kubernetes.Interface, ns string, spec v1alpha1.NotifierSpec, logger logr.Logger) (Notifier, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+func init() {
+	Register(v1alpha1.NotifierTypeWebhook, newWebhookFromSpec)
+	Register(v1alpha1.NotifierTypeSlack, newSlackFromSpec)
+	Register(v1alpha1.NotifierTypePagerDuty, newPagerDutyFromSpec)
+}
+
+// Register makes a notifier type available to NotifierSpecs. It panics if the type is already registered.
+func Register(notifierType string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[notifierType]; ok {
+		panic(fmt.Sprintf("notifier type %q registered twice", notifierType))
+	}
+	registry[notifierType] = factory
+}
+
+// Types returns the registered notifier types, sorted
+func Types() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	types := make([]string, 0, len(registry))
+	for t := range registry {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// New builds the notifier of spec with the factory registered for its type
+func New(ctx context.Context, client kubernetes.Interface, ns string, spec v1alpha1.NotifierSpec, logger logr.Logger) (Notifier, error) {
+	registryMu.RLock()
+	factory, ok := registry[spec.Type]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown notifier type %q (registered: %s)", spec.Type, strings.Join(Types(), ", "))
+	}
+	if spec.Name != "" {
+		logger = logger.WithValues("notifier", spec.Name)
+	}
+	return factory(ctx, client, ns, spec, logger)
+}
+
+// Specs returns the notifiers configured in reporting: Webhook, Slack and PagerDuty as notifiers without a name,
+// followed by Notifiers
+func Specs(reporting v1alpha1.ReportingSpec) []v1alpha1.NotifierSpec {
+	var specs []v1alpha1.NotifierSpec
+	if reporting.Webhook != nil {
+		specs = append(specs, v1alpha1.NotifierSpec{Type: v1alpha1.NotifierTypeWebhook, Webhook: reporting.Webhook})
+	}
+	if reporting.Slack != nil {
+		specs = append(specs, v1alpha1.NotifierSpec{Type: v1alpha1.NotifierTypeSlack, Slack: reporting.Slack})
+	}
+	if reporting.PagerDuty != nil {
+		specs = append(specs, v1alpha1.NotifierSpec{Type: v1alpha1.NotifierTypePagerDuty, PagerDuty: reporting.PagerDuty})
+	}
+	return append(specs, reporting.Notifiers...)
+}
+
+// FanOut builds the notifier of every spec and sends payload through all of them concurrently.
+// It returns one error per spec, nil when its delivery succeeded, so that a failing notifier
+// neither delays nor fails the others. A name used by an earlier spec fails the later one.
+func FanOut(ctx context.Context, client kubernetes.Interface, ns string, specs []v1alpha1.NotifierSpec, payload WebhookPayload, logger logr.Logger) []error {
+	errs := make([]error, len(specs))
+	seen := make(map[string]bool)
+	var wg sync.WaitGroup
+	for i, spec := range specs {
+		if spec.Name != "" {
+			if seen[spec.Name] {
+				errs[i] = fmt.Errorf("duplicate notifier name %q", spec.Name)
+				continue
+			}
+			seen[spec.Name] = true
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := New(ctx, client, ns, spec, logger)
+			if err == nil {
+				err = n.Send(ctx, payload)
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// Target describes where spec delivers to, for events and logs
+func Target(spec v1alpha1.NotifierSpec) string {
+	switch {
+	case spec.Webhook != nil && spec.Type == v1alpha1.NotifierTypeWebhook:
+		return spec.Webhook.URL
+	case spec.Slack != nil && spec.Type == v1alpha1.NotifierTypeSlack:
+		if spec.Slack.Channel != "" {
+			return spec.Slack.Channel
+		}
+		return "incoming webhook"
+	case spec.PagerDuty != nil && spec.Type == v1alpha1.NotifierTypePagerDuty:
+		return "Events API v2"
+	}
+	return spec.Type
+}
+
+// newWebhookFromSpec builds a webhook notifier with the values read from its Secrets and its payload template
+func newWebhookFromSpec(ctx context.Context, client kubernetes.Interface, ns string, spec v1alpha1.NotifierSpec, logger logr.Logger) (Notifier, error) {
+	if spec.Webhook == nil {
+		return nil, errors.New("notifier of type webhook has no webhook configuration")
+	}
+	config := *spec.Webhook
+	secrets, err := LoadWebhookSecrets(ctx, client, ns, config)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := LoadPayloadTemplate(ctx, client, ns, config)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebhookNotifier(config, secrets, tmpl, logger), nil
+}
+
+// newSlackFromSpec builds a Slack notifier with the credentials read from its Secret
+func newSlackFromSpec(ctx context.Context, client kubernetes.Interface, ns string, spec v1alpha1.NotifierSpec, logger logr.Logger) (Notifier, error) {
+	if spec.Slack == nil {
+		return nil, errors.New("notifier of type slack has no slack configuration")
+	}
+	creds, err := LoadSlackCredentials(ctx, client, ns, *spec.Slack)
+	if err != nil {
+		return nil, err
+	}
+	slack, err := NewSlackNotifier(*spec.Slack, creds, logger)
+	if err != nil {
+		return nil, err
+	}
+	return slack, nil
+}
+
+// newPagerDutyFromSpec builds a PagerDuty notifier with the integration key read from its Secret
+func newPagerDutyFromSpec(ctx context.Context, client kubernetes.Interface, ns string, spec v1alpha1.NotifierSpec, logger logr.Logger) (Notifier, error) {
+	if spec.PagerDuty == nil {
+		return nil, errors.New("notifier of type pagerduty has no pagerDuty configuration")
+	}
+	if client == nil {
+		return nil, errors.New("no cluster access to read the PagerDuty Secret")
+	}
+	routingKey, err := LoadPagerDutyRoutingKey(ctx, client, ns, *spec.PagerDuty)
+	if err != nil {
+		return nil, err
+	}
+	return NewPagerDutyNotifier(*spec.PagerDuty, routingKey, logger), nil
+}
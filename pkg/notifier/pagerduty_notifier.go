@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+const (
+	// pagerDutyEventsURL is the Events API v2 endpoint alerts are sent to
+	pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+	defaultPagerDutySeverity = "warning"
+
+	// PagerDuty rejects alert summaries over 1024 characters
+	maxPagerDutySummaryLength = 1024
+
+	// PagerDutyTrigger opens the alert, or updates it while it is open
+	PagerDutyTrigger = "trigger"
+
+	// PagerDutyResolve closes the alert
+	PagerDutyResolve = "resolve"
+)
+
+// LoadPagerDutyRoutingKey reads the integration key of the configured Secret in namespace ns
+func LoadPagerDutyRoutingKey(ctx context.Context, client kubernetes.Interface, ns string, config v1alpha1.PagerDutyConfig) (string, error) {
+	ref := config.RoutingKeySecretRef
+	secret, err := client.CoreV1().Secrets(ns).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("reading PagerDuty Secret: %w", err)
+	}
+	key := strings.TrimSpace(string(secret.Data[ref.Key]))
+	if key == "" {
+		return "", fmt.Errorf("PagerDuty Secret %s has no %s key", ref.Name, ref.Key)
+	}
+	return key, nil
+}
+
+// PagerDutyDedupKey identifies the alert of a KorpScan, so every scan updates the same incident
+func PagerDutyDedupKey(metadata ScanMetadata) string {
+	return "korp/" + metadata.Namespace + "/" + metadata.Name
+}
+
+// PagerDutyBreached reports whether a scan summary crosses the alert threshold of config
+func PagerDutyBreached(config v1alpha1.PagerDutyConfig, summary v1alpha1.ScanSummary) bool {
+	return summary.CountAtLeast(config.MinSeverity) > config.OrphanThreshold
+}
+
+// PagerDutyNotifier triggers and resolves the PagerDuty alert of a KorpScan through the Events API v2
+type PagerDutyNotifier struct {
+	config     v1alpha1.PagerDutyConfig
+	routingKey string
+	url        string
+	client     *http.Client
+	logger     logr.Logger
+}
+
+// NewPagerDutyNotifier creates a PagerDuty notifier with the given configuration and integration key
+func NewPagerDutyNotifier(config v1alpha1.PagerDutyConfig, routingKey string, logger logr.Logger) *PagerDutyNotifier {
+	timeout := defaultTimeoutSeconds
+	if config.TimeoutSeconds > 0 {
+		timeout = config.TimeoutSeconds
+	}
+	return &PagerDutyNotifier{
+		config:     config,
+		routingKey: routingKey,
+		url:        pagerDutyEventsURL,
+		client:     &http.Client{Timeout: time.Duration(timeout) * time.Second},
+		logger:     logger,
+	}
+}
+
+// Send triggers the KorpScan's alert when the payload crosses the threshold, updating the open alert
+// if there is one, and resolves it otherwise. Cleanup anomalies do not page. A test payload triggers
+// an info alert under a dedup key of its own and resolves it right away, so the test neither pages
+// anyone nor touches the KorpScan's alert.
+func (p *PagerDutyNotifier) Send(ctx context.Context, payload WebhookPayload) error {
+	switch payload.EventType {
+	case AnomalyEventType:
+		return nil
+	case TestEventType:
+		test := *p
+		test.config.Severity = "info"
+		dedupKey := PagerDutyDedupKey(payload.KorpScan) + "/test"
+		if err := test.trigger(ctx, dedupKey, payload); err != nil {
+			return err
+		}
+		return test.resolve(ctx, dedupKey)
+	}
+	if PagerDutyBreached(p.config, payload.Summary) {
+		return p.trigger(ctx, PagerDutyDedupKey(payload.KorpScan), payload)
+	}
+	return p.resolve(ctx, PagerDutyDedupKey(payload.KorpScan))
+}
+
+// trigger opens or updates the alert with dedupKey
+func (p *PagerDutyNotifier) trigger(ctx context.Context, dedupKey string, payload WebhookPayload) error {
+	severity := p.config.Severity
+	if severity == "" {
+		severity = defaultPagerDutySeverity
+	}
+
+	counted := payload.Summary.CountAtLeast(p.config.MinSeverity)
+	summary := fmt.Sprintf("korp: %d orphaned resources in %s/%s", counted, payload.KorpScan.Namespace, payload.KorpScan.Name)
+	if p.config.MinSeverity != "" {
+		summary = fmt.Sprintf("korp: %d orphaned resources of severity %s or higher in %s/%s",
+			counted, p.config.MinSeverity, payload.KorpScan.Namespace, payload.KorpScan.Name)
+	}
+
+	return p.sendOnce(ctx, map[string]interface{}{
+		"routing_key":  p.routingKey,
+		"event_action": PagerDutyTrigger,
+		"dedup_key":    dedupKey,
+		"client":       "korp",
+		"payload": map[string]interface{}{
+			"summary":   truncate(summary, maxPagerDutySummaryLength),
+			"source":    "korp",
+			"severity":  severity,
+			"component": payload.KorpScan.TargetNamespace,
+			"group":     payload.KorpScan.Namespace,
+			"class":     "orphaned-resources",
+			"custom_details": map[string]interface{}{
+				"orphanCount":      payload.Summary.TotalOrphans(),
+				"countsByType":     payload.Summary.CountsByType,
+				"countsBySeverity": payload.Summary.CountsBySeverity,
+				"threshold":        p.config.OrphanThreshold,
+				"scanDuration":     payload.ScanDuration,
+			},
+		},
+	})
+}
+
+// resolve closes the alert with dedupKey; PagerDuty ignores keys without an open alert
+func (p *PagerDutyNotifier) resolve(ctx context.Context, dedupKey string) error {
+	return p.sendOnce(ctx, map[string]interface{}{
+		"routing_key":  p.routingKey,
+		"event_action": PagerDutyResolve,
+		"dedup_key":    dedupKey,
+	})
+}
+
+// sendOnce posts one event to the Events API
+func (p *PagerDutyNotifier) sendOnce(ctx context.Context, event map[string]interface{}) error {
+	jsonData, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("pagerduty returned non-success status: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	p.logger.V(1).Info("PagerDuty event sent successfully", "action", event["event_action"], "dedupKey", event["dedup_key"])
+	return nil
+}
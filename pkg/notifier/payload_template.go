@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// templateFuncs are available to payload templates in addition to the text/template builtins
+var templateFuncs = template.FuncMap{
+	// json encodes a value, e.g. {{ json .Findings }} or a quoted string {{ json .KorpScan.Name }}
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"join":  strings.Join,
+	// unixTime converts an RFC 3339 timestamp such as .Timestamp to seconds since the epoch
+	"unixTime": func(s string) (int64, error) {
+		t, err := time.Parse(time.RFC3339, s)
+		return t.Unix(), err
+	},
+}
+
+// UsesConfigMap reports whether config reads its payload template from a ConfigMap
+func UsesConfigMap(config v1alpha1.WebhookConfig) bool {
+	return config.PayloadTemplate != nil && config.PayloadTemplate.ConfigMapKeyRef != nil
+}
+
+// LoadPayloadTemplate parses the payload template of config, reading it from its ConfigMap in namespace ns.
+// It returns nil when the default payload is sent.
+func LoadPayloadTemplate(ctx context.Context, client kubernetes.Interface, ns string, config v1alpha1.WebhookConfig) (*template.Template, error) {
+	spec := config.PayloadTemplate
+	if spec == nil {
+		return nil, nil
+	}
+	if (spec.Inline != "") == (spec.ConfigMapKeyRef != nil) {
+		return nil, errors.New("payloadTemplate needs exactly one of inline and configMapKeyRef")
+	}
+
+	text := spec.Inline
+	if ref := spec.ConfigMapKeyRef; ref != nil {
+		cm, err := client.CoreV1().ConfigMaps(ns).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("reading payload template ConfigMap %s: %w", ref.Name, err)
+		}
+		text = cm.Data[ref.Key]
+		if text == "" {
+			return nil, fmt.Errorf("payload template ConfigMap %s has no %s key", ref.Name, ref.Key)
+		}
+	}
+
+	tmpl, err := template.New("payload").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing payload template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderPayload executes tmpl with payload. The result must be valid JSON, so a template that
+// breaks on unusual values fails the delivery instead of posting a body the receiver rejects.
+func renderPayload(tmpl *template.Template, payload WebhookPayload) ([]byte, error) {
+	var buf bytes.Buffer
+	// A pointer, so templates can call methods such as .Summary.TotalOrphans
+	if err := tmpl.Execute(&buf, &payload); err != nil {
+		return nil, fmt.Errorf("rendering payload template: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, errors.New("payload template did not render valid JSON")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+const (
+	defaultSlackMaxFindings = 10
+	defaultSlackTemplate    = "korp: {{.FindingCount}} orphaned resources ({{.KorpScan.Namespace}}/{{.KorpScan.Name}})"
+
+	// slackPostMessageURL is the Web API method used with bot tokens
+	slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+
+	// Slack rejects header text over 150 characters and section text over 3000
+	maxSlackHeaderLength  = 150
+	maxSlackSectionLength = 3000
+)
+
+// SlackCredentials are the secrets used to post to Slack
+type SlackCredentials struct {
+	// WebhookURL is an incoming webhook URL
+	WebhookURL string
+
+	// Token is a bot token used with chat.postMessage
+	Token string
+}
+
+// LoadSlackCredentials reads the Slack credentials from the configured Secret in namespace ns
+func LoadSlackCredentials(ctx context.Context, client kubernetes.Interface, ns string, config v1alpha1.SlackConfig) (SlackCredentials, error) {
+	secret, err := client.CoreV1().Secrets(ns).Get(ctx, config.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return SlackCredentials{}, fmt.Errorf("reading Slack Secret: %w", err)
+	}
+	creds := SlackCredentials{
+		WebhookURL: strings.TrimSpace(string(secret.Data["webhookURL"])),
+		Token:      strings.TrimSpace(string(secret.Data["token"])),
+	}
+	if creds.WebhookURL == "" && creds.Token == "" {
+		return SlackCredentials{}, fmt.Errorf("slack Secret %s has neither a webhookURL nor a token key", config.SecretName)
+	}
+	return creds, nil
+}
+
+// SlackMessageData is the data the message template is executed with
+type SlackMessageData struct {
+	KorpScan     ScanMetadata
+	Summary      v1alpha1.ScanSummary
+	Channel      string
+	FindingCount int
+	ScanDuration string
+}
+
+// SlackNotifier posts scan results to Slack channels
+type SlackNotifier struct {
+	config   v1alpha1.SlackConfig
+	creds    SlackCredentials
+	template *template.Template
+	client   *http.Client
+	logger   logr.Logger
+}
+
+// NewSlackNotifier creates a new Slack notifier with the given configuration and credentials
+func NewSlackNotifier(config v1alpha1.SlackConfig, creds SlackCredentials, logger logr.Logger) (*SlackNotifier, error) {
+	if creds.Token != "" && config.Channel == "" {
+		return nil, errors.New("slack channel is required when posting with a bot token")
+	}
+	// An incoming webhook always posts to its own channel, so routed findings would never move
+	if creds.Token == "" && len(config.Routes) > 0 {
+		return nil, errors.New("slack routes require a bot token; an incoming webhook cannot post to other channels")
+	}
+
+	text := defaultSlackTemplate
+	if config.MessageTemplate != "" {
+		text = config.MessageTemplate
+	}
+	tmpl, err := template.New("slack").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Slack message template: %w", err)
+	}
+
+	timeout := defaultTimeoutSeconds
+	if config.TimeoutSeconds > 0 {
+		timeout = config.TimeoutSeconds
+	}
+
+	return &SlackNotifier{
+		config:   config,
+		creds:    creds,
+		template: tmpl,
+		client:   &http.Client{Timeout: time.Duration(timeout) * time.Second},
+		logger:   logger,
+	}, nil
+}
+
+// Target describes where messages are posted, for events and logs
+func (s *SlackNotifier) Target() string {
+	if s.config.Channel != "" {
+		return s.config.Channel
+	}
+	return "incoming webhook"
+}
+
+// Send posts one message per channel: the default channel always receives the scan summary,
+// and every routed channel with findings receives its own message. Anomaly alerts are posted
+// to the default channel only.
+// All channels are attempted; the returned error joins the failures.
+func (s *SlackNotifier) Send(ctx context.Context, payload WebhookPayload) error {
+	if payload.Anomaly != nil {
+		return s.Alert(ctx, payload)
+	}
+	byChannel := s.route(payload.Findings)
+
+	channels := []string{s.config.Channel}
+	for _, route := range s.config.Routes {
+		if _, ok := byChannel[route.Channel]; ok && !contains(channels, route.Channel) {
+			channels = append(channels, route.Channel)
+		}
+	}
+
+	var errs []error
+	for _, channel := range channels {
+		if err := s.post(ctx, channel, payload, byChannel[channel]); err != nil {
+			target := channel
+			if target == "" {
+				target = "incoming webhook"
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", target, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// route groups findings by the channel of the first route matching their namespace
+func (s *SlackNotifier) route(findings []v1alpha1.Finding) map[string][]v1alpha1.Finding {
+	byChannel := make(map[string][]v1alpha1.Finding)
+	for _, finding := range findings {
+		channel := s.config.Channel
+		if finding.Namespace != "" {
+		routes:
+			for _, route := range s.config.Routes {
+				for _, pattern := range route.Namespaces {
+					if ok, _ := path.Match(pattern, finding.Namespace); ok {
+						channel = route.Channel
+						break routes
+					}
+				}
+			}
+		}
+		byChannel[channel] = append(byChannel[channel], finding)
+	}
+	return byChannel
+}
+
+// post builds and sends the message for one channel
+func (s *SlackNotifier) post(ctx context.Context, channel string, payload WebhookPayload, findings []v1alpha1.Finding) error {
+	var header bytes.Buffer
+	err := s.template.Execute(&header, SlackMessageData{
+		KorpScan:     payload.KorpScan,
+		Summary:      payload.Summary,
+		Channel:      channel,
+		FindingCount: len(findings),
+		ScanDuration: payload.ScanDuration,
+	})
+	if err != nil {
+		return fmt.Errorf("executing message template: %w", err)
+	}
+
+	message := map[string]interface{}{
+		"text":   header.String(),
+		"blocks": s.blocks(truncate(header.String(), maxSlackHeaderLength), payload, findings),
+	}
+	if channel != "" {
+		message["channel"] = channel
+	}
+	return s.sendOnce(ctx, message)
+}
+
+// blocks formats the summary and the top findings as Slack Block Kit blocks
+func (s *SlackNotifier) blocks(header string, payload WebhookPayload, findings []v1alpha1.Finding) []map[string]interface{} {
+	field := func(label string, value interface{}) map[string]string {
+		return map[string]string{"type": "mrkdwn", "text": fmt.Sprintf("*%s*\n%v", label, value)}
+	}
+
+	blocks := []map[string]interface{}{
+		{"type": "header", "text": map[string]string{"type": "plain_text", "text": header}},
+		{"type": "section", "fields": []map[string]string{
+			field("Resources scanned", payload.Summary.TotalResources),
+			field("Orphaned", payload.Summary.OrphanCount),
+			field("In this channel", len(findings)),
+			field("Scan duration", payload.ScanDuration),
+		}},
+	}
+
+	if len(findings) == 0 {
+		return blocks
+	}
+
+	limit := defaultSlackMaxFindings
+	if s.config.MaxFindings > 0 {
+		limit = s.config.MaxFindings
+	}
+	var lines strings.Builder
+	for i, finding := range findings {
+		if i == limit {
+			break
+		}
+		name := finding.Name
+		if finding.Namespace != "" {
+			name = finding.Namespace + "/" + finding.Name
+		}
+		fmt.Fprintf(&lines, "• %s `%s` (%s)\n", finding.ResourceType, name, finding.Reason)
+	}
+	blocks = append(blocks, map[string]interface{}{
+		"type": "section",
+		"text": map[string]string{"type": "mrkdwn", "text": truncate(lines.String(), maxSlackSectionLength)},
+	})
+
+	if len(findings) > limit {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "context",
+			"elements": []map[string]string{
+				{"type": "mrkdwn", "text": fmt.Sprintf("and %d more", len(findings)-limit)},
+			},
+		})
+	}
+	return blocks
+}
+
+// sendOnce posts one message through the bot token or the incoming webhook
+func (s *SlackNotifier) sendOnce(ctx context.Context, message map[string]interface{}) error {
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	url := s.creds.WebhookURL
+	if s.creds.Token != "" {
+		url = slackPostMessageURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if s.creds.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.creds.Token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("slack returned non-success status: %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	// The Web API reports failures in the body with a 200 status
+	if s.creds.Token != "" {
+		var result struct {
+			OK    bool   `json:"ok"`
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("failed to decode Slack response: %w", err)
+		}
+		if !result.OK {
+			return fmt.Errorf("slack API error: %s", result.Error)
+		}
+	}
+
+	s.logger.V(1).Info("Slack message sent successfully", "channel", message["channel"])
+	return nil
+}
+
+// truncate shortens s to at most n runes, marking the cut with an ellipsis
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// contains reports whether list holds s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+	"github.com/kamilbabayev/korp/pkg/report"
+)
+
+// TestEventType is the event type of synthetic payloads sent to validate notification config
+const TestEventType = "notification.test"
+
+// DeliveryResult is the outcome of sending a notification through one channel
+type DeliveryResult struct {
+	// Channel is the kind of channel, e.g. "webhook", or "<type>/<name>" for a named notifier
+	Channel string
+
+	// Target identifies the destination, e.g. the webhook URL
+	Target string
+
+	// Err is nil when the notification was delivered
+	Err error
+}
+
+// TestPayload builds a synthetic payload with one example finding
+func TestPayload(metadata ScanMetadata) WebhookPayload {
+	finding := v1alpha1.Finding{
+		Separator:    "---",
+		Description:  "ConfigMap korp-test/example (NoOwnerReference)",
+		ResourceType: "ConfigMap",
+		Name:         "example",
+		Namespace:    "korp-test",
+		Reason:       "NoOwnerReference",
+		DetectedAt:   metav1.Now(),
+	}
+
+	summary := v1alpha1.ScanSummary{TotalResources: 1, OrphanCount: 1}
+	summary.Count([]v1alpha1.Finding{finding})
+
+	return WebhookPayload{
+		SchemaVersion: report.SchemaVersion,
+		EventType:     TestEventType,
+		Timestamp:     time.Now().Format(time.RFC3339),
+		KorpScan:      metadata,
+		Summary:       summary,
+		Findings:      []v1alpha1.Finding{finding},
+		ScanDuration:  "0s",
+	}
+}
+
+// SendTest sends a synthetic payload once through every notification channel configured in reporting.
+// Retries are not attempted so misconfiguration is reported immediately.
+// client reads the Slack, PagerDuty and webhook Secrets from the KorpScan namespace; without it channels
+// that need a Secret are reported as failed.
+func SendTest(ctx context.Context, client kubernetes.Interface, reporting v1alpha1.ReportingSpec, metadata ScanMetadata, logger logr.Logger) []DeliveryResult {
+	payload := TestPayload(metadata)
+
+	var results []DeliveryResult
+	for _, spec := range Specs(reporting) {
+		results = append(results, sendNotifierTest(ctx, client, spec, metadata, payload, logger))
+	}
+	return results
+}
+
+// sendNotifierTest sends the synthetic payload once through a notifier; the channel of its result
+// is its type, or "<type>/<name>" for a named notifier
+func sendNotifierTest(ctx context.Context, client kubernetes.Interface, spec v1alpha1.NotifierSpec, metadata ScanMetadata, payload WebhookPayload, logger logr.Logger) DeliveryResult {
+	var result DeliveryResult
+	switch {
+	case spec.Type == v1alpha1.NotifierTypeWebhook && spec.Webhook != nil:
+		result = sendWebhookTest(ctx, client, *spec.Webhook, metadata, payload, logger)
+	case spec.Type == v1alpha1.NotifierTypeSlack && spec.Slack != nil:
+		result = sendSlackTest(ctx, client, *spec.Slack, metadata, payload, logger)
+	default:
+		result.Target = Target(spec)
+		n, err := New(ctx, client, metadata.Namespace, spec, logger)
+		if err == nil {
+			err = n.Send(ctx, payload)
+		}
+		result.Err = err
+	}
+	result.Channel = spec.Type
+	if spec.Name != "" {
+		result.Channel += "/" + spec.Name
+	}
+	return result
+}
+
+// sendWebhookTest sends the synthetic payload once, with the signing key and headers read from Secrets
+// and the payload template
+func sendWebhookTest(ctx context.Context, client kubernetes.Interface, config v1alpha1.WebhookConfig, metadata ScanMetadata, payload WebhookPayload, logger logr.Logger) DeliveryResult {
+	result := DeliveryResult{Channel: "webhook", Target: config.URL}
+	if UsesSecrets(config) && client == nil {
+		result.Err = errors.New("no cluster access to read the webhook Secrets")
+		return result
+	}
+	if UsesConfigMap(config) && client == nil {
+		result.Err = errors.New("no cluster access to read the payload template ConfigMap")
+		return result
+	}
+	secrets, err := LoadWebhookSecrets(ctx, client, metadata.Namespace, config)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	tmpl, err := LoadPayloadTemplate(ctx, client, metadata.Namespace, config)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Err = NewWebhookNotifier(config, secrets, tmpl, logger).sendOnce(ctx, payload)
+	return result
+}
+
+// sendSlackTest posts the synthetic payload to the default Slack channel
+func sendSlackTest(ctx context.Context, client kubernetes.Interface, config v1alpha1.SlackConfig, metadata ScanMetadata, payload WebhookPayload, logger logr.Logger) DeliveryResult {
+	result := DeliveryResult{Channel: "slack", Target: config.Channel}
+	if client == nil {
+		result.Err = errors.New("no cluster access to read the Slack Secret")
+		return result
+	}
+
+	creds, err := LoadSlackCredentials(ctx, client, metadata.Namespace, config)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	slack, err := NewSlackNotifier(config, creds, logger)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Target = slack.Target()
+	result.Err = slack.post(ctx, config.Channel, payload, payload.Findings)
+	return result
+}
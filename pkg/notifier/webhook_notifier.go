@@ -9,14 +9,26 @@ package notifier
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
 	"github.com/kamilbabayev/korp/api/v1alpha1"
 )
 
@@ -25,30 +37,163 @@ const (
 	defaultTimeoutSeconds      = 30
 	defaultMaxRetries          = 3
 	defaultInitialDelaySeconds = 1
+
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the request body when signing is configured
+	SignatureHeader = "X-Korp-Signature"
 )
 
+// redacted replaces secret header values in errors
+const redacted = "[REDACTED]"
+
 // WebhookNotifier handles sending webhook notifications
 type WebhookNotifier struct {
-	config v1alpha1.WebhookConfig
-	client *http.Client
-	logger logr.Logger
+	config  v1alpha1.WebhookConfig
+	secrets WebhookSecrets
+	// template renders request bodies instead of the default JSON encoding when set
+	template *template.Template
+	client   *http.Client
+	logger   logr.Logger
+}
+
+// WebhookSecrets are the parts of a webhook configuration read from Secrets, and the CA bundle
+// parsed with them
+type WebhookSecrets struct {
+	// SigningKey signs request bodies unless it is empty
+	SigningKey []byte
+
+	// Headers are sent with every request, after the headers of the configuration
+	Headers map[string]string
+
+	// RootCAs verify the server certificate instead of the system trust roots unless nil
+	RootCAs *x509.CertPool
+
+	// ClientCertificate is presented to servers that ask for one unless nil
+	ClientCertificate *tls.Certificate
+}
+
+// UsesSecrets reports whether config reads any values from Secrets
+func UsesSecrets(config v1alpha1.WebhookConfig) bool {
+	return config.SigningSecretRef != nil || config.BearerTokenSecretRef != nil || len(config.HeadersFrom) > 0 ||
+		config.CASecretRef != nil || config.ClientCertSecretRef != nil
+}
+
+// LoadWebhookSecrets reads the signing key, the secret header values, the CA bundle and the client
+// certificate of config from namespace ns. Each Secret is read once.
+func LoadWebhookSecrets(ctx context.Context, client kubernetes.Interface, ns string, config v1alpha1.WebhookConfig) (WebhookSecrets, error) {
+	var secrets WebhookSecrets
+	if !UsesSecrets(config) {
+		if config.CABundle != "" {
+			return secrets, loadRootCAs(&secrets, []byte(config.CABundle))
+		}
+		return secrets, nil
+	}
+
+	read := make(map[string]map[string][]byte)
+	value := func(ref v1alpha1.SecretKeyRef) ([]byte, error) {
+		data, ok := read[ref.Name]
+		if !ok {
+			secret, err := client.CoreV1().Secrets(ns).Get(ctx, ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("reading webhook Secret %s: %w", ref.Name, err)
+			}
+			data = secret.Data
+			read[ref.Name] = data
+		}
+		if len(data[ref.Key]) == 0 {
+			return nil, fmt.Errorf("webhook Secret %s has no %s key", ref.Name, ref.Key)
+		}
+		return data[ref.Key], nil
+	}
+
+	if ref := config.SigningSecretRef; ref != nil {
+		key, err := value(*ref)
+		if err != nil {
+			return secrets, err
+		}
+		secrets.SigningKey = key
+	}
+
+	if len(config.HeadersFrom) > 0 || config.BearerTokenSecretRef != nil {
+		secrets.Headers = make(map[string]string)
+	}
+	for _, header := range config.HeadersFrom {
+		v, err := value(header.SecretKeyRef)
+		if err != nil {
+			return secrets, err
+		}
+		secrets.Headers[header.Name] = strings.TrimSpace(string(v))
+	}
+	if ref := config.BearerTokenSecretRef; ref != nil {
+		token, err := value(*ref)
+		if err != nil {
+			return secrets, err
+		}
+		secrets.Headers["Authorization"] = "Bearer " + strings.TrimSpace(string(token))
+	}
+
+	if ref := config.ClientCertSecretRef; ref != nil {
+		certPEM, err := value(v1alpha1.SecretKeyRef{Name: ref.Name, Key: corev1.TLSCertKey})
+		if err != nil {
+			return secrets, err
+		}
+		keyPEM, err := value(v1alpha1.SecretKeyRef{Name: ref.Name, Key: corev1.TLSPrivateKeyKey})
+		if err != nil {
+			return secrets, err
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return secrets, fmt.Errorf("webhook client certificate Secret %s: %w", ref.Name, err)
+		}
+		secrets.ClientCertificate = &cert
+	}
+
+	bundle := []byte(config.CABundle)
+	if ref := config.CASecretRef; ref != nil {
+		ca, err := value(*ref)
+		if err != nil {
+			return secrets, err
+		}
+		bundle = append(append(bundle, '\n'), ca...)
+	}
+	if len(bundle) > 0 {
+		return secrets, loadRootCAs(&secrets, bundle)
+	}
+	return secrets, nil
 }
 
-// NewWebhookNotifier creates a new webhook notifier with the given configuration
-func NewWebhookNotifier(config v1alpha1.WebhookConfig, logger logr.Logger) *WebhookNotifier {
+// loadRootCAs sets the root CAs of secrets to the certificates of a PEM bundle
+func loadRootCAs(secrets *WebhookSecrets, bundle []byte) error {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return errors.New("webhook CA bundle has no PEM certificates")
+	}
+	secrets.RootCAs = pool
+	return nil
+}
+
+// NewWebhookNotifier creates a new webhook notifier with the given configuration, the values
+// read from its Secrets and its payload template, which may be nil
+func NewWebhookNotifier(config v1alpha1.WebhookConfig, secrets WebhookSecrets, tmpl *template.Template, logger logr.Logger) *WebhookNotifier {
 	timeout := defaultTimeoutSeconds
 	if config.TimeoutSeconds > 0 {
 		timeout = config.TimeoutSeconds
 	}
 
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		RootCAs:            secrets.RootCAs,
+	}
+	if secrets.ClientCertificate != nil {
+		tlsConfig.Certificates = []tls.Certificate{*secrets.ClientCertificate}
+	}
 	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: config.InsecureSkipVerify,
-		},
+		TLSClientConfig: tlsConfig,
 	}
 
 	return &WebhookNotifier{
-		config: config,
+		config:   config,
+		secrets:  secrets,
+		template: tmpl,
 		client: &http.Client{
 			Timeout:   time.Duration(timeout) * time.Second,
 			Transport: transport,
@@ -57,9 +202,64 @@ func NewWebhookNotifier(config v1alpha1.WebhookConfig, logger logr.Logger) *Webh
 	}
 }
 
-// Send sends a webhook notification with the given payload
-// Returns error if all retry attempts fail
+// Send sends a webhook notification with the given payload, split into sequential
+// requests when MaxFindingsPerRequest is set and exceeded by the findings or by the new, resolved
+// or would-remediate lists, which are split the same way.
+// Returns error if all retry attempts of a request fail; later parts are then not sent.
 func (w *WebhookNotifier) Send(ctx context.Context, payload WebhookPayload) error {
+	limit := w.config.MaxFindingsPerRequest
+	longest := max(len(payload.Findings), len(payload.NewFindings), len(payload.ResolvedFindings), len(payload.WouldRemediate))
+	if limit <= 0 || longest <= limit {
+		return w.sendWithRetry(ctx, payload)
+	}
+
+	correlationID, err := newCorrelationID()
+	if err != nil {
+		return err
+	}
+
+	total := (longest + limit - 1) / limit
+	for i := 0; i < total; i++ {
+		part := payload
+		part.Findings = pageOf(payload.Findings, i, limit)
+		part.NewFindings = pageOf(payload.NewFindings, i, limit)
+		part.ResolvedFindings = pageOf(payload.ResolvedFindings, i, limit)
+		part.WouldRemediate = pageOf(payload.WouldRemediate, i, limit)
+		part.Page = &PageInfo{
+			CorrelationID:         correlationID,
+			Number:                i + 1,
+			Total:                 total,
+			TotalFindings:         len(payload.Findings),
+			TotalNewFindings:      len(payload.NewFindings),
+			TotalResolvedFindings: len(payload.ResolvedFindings),
+			TotalWouldRemediate:   len(payload.WouldRemediate),
+		}
+		if err := w.sendWithRetry(ctx, part); err != nil {
+			return fmt.Errorf("part %d of %d: %w", i+1, total, err)
+		}
+	}
+	return nil
+}
+
+// pageOf returns the items of the 0-based page i of size limit, nil past the end of items
+func pageOf[T any](items []T, i, limit int) []T {
+	if i*limit >= len(items) {
+		return nil
+	}
+	return items[i*limit : min((i+1)*limit, len(items))]
+}
+
+// newCorrelationID returns a random identifier for the parts of one delivery
+func newCorrelationID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate correlation ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// sendWithRetry sends one request, retrying with exponential backoff
+func (w *WebhookNotifier) sendWithRetry(ctx context.Context, payload WebhookPayload) error {
 	maxRetries := defaultMaxRetries
 	if w.config.RetryPolicy != nil && w.config.RetryPolicy.MaxRetries >= 0 {
 		maxRetries = w.config.RetryPolicy.MaxRetries
@@ -107,12 +307,18 @@ func (w *WebhookNotifier) Send(ctx context.Context, payload WebhookPayload) erro
 	return fmt.Errorf("webhook failed after %d attempts: %w", maxRetries+1, lastErr)
 }
 
-// sendOnce performs a single webhook send attempt
+// sendOnce performs a single webhook send attempt. Secret header values are redacted from the error,
+// which ends up in logs, events and status.
 func (w *WebhookNotifier) sendOnce(ctx context.Context, payload WebhookPayload) error {
-	// Marshal payload to JSON
-	jsonData, err := json.Marshal(payload)
+	return w.redact(w.send(ctx, payload))
+}
+
+// send performs a single webhook send attempt
+func (w *WebhookNotifier) send(ctx context.Context, payload WebhookPayload) error {
+	// Marshal payload to JSON, or render it with the payload template
+	jsonData, err := w.body(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
+		return err
 	}
 
 	// Determine HTTP method
@@ -134,6 +340,14 @@ func (w *WebhookNotifier) sendOnce(ctx context.Context, payload WebhookPayload)
 	for key, value := range w.config.Headers {
 		req.Header.Set(key, value)
 	}
+	for key, value := range w.secrets.Headers {
+		req.Header.Set(key, value)
+	}
+
+	// The signature is set last so custom headers cannot replace it
+	if len(w.secrets.SigningKey) > 0 {
+		req.Header.Set(SignatureHeader, sign(w.secrets.SigningKey, jsonData))
+	}
 
 	// Send request
 	resp, err := w.client.Do(req)
@@ -157,3 +371,42 @@ func (w *WebhookNotifier) sendOnce(ctx context.Context, payload WebhookPayload)
 
 	return nil
 }
+
+// body returns the request body of payload
+func (w *WebhookNotifier) body(payload WebhookPayload) ([]byte, error) {
+	if w.template != nil {
+		return renderPayload(w.template, payload)
+	}
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return jsonData, nil
+}
+
+// sign returns the SignatureHeader value for body
+func sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// redact replaces the secret header values in err, e.g. when a receiver echoes the request in its response
+func (w *WebhookNotifier) redact(err error) error {
+	if err == nil || len(w.secrets.Headers) == 0 {
+		return err
+	}
+	msg := err.Error()
+	for _, value := range w.secrets.Headers {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok && token != "" {
+			msg = strings.ReplaceAll(msg, token, redacted)
+		}
+		if value != "" {
+			msg = strings.ReplaceAll(msg, value, redacted)
+		}
+	}
+	if msg == err.Error() {
+		return err
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package policy evaluates the cluster-wide KorpPolicies that apply to a KorpScan
+package policy
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// KorpPolicyGVR is the API resource of KorpPolicies
+var KorpPolicyGVR = korpv1alpha1.GroupVersion.WithResource("korppolicies")
+
+// Set holds the KorpPolicies that apply to one KorpScan.
+// A nil Set has no policies and allows everything.
+type Set struct {
+	policies []compiled
+}
+
+// compiled is a KorpPolicy with its label selector parsed
+type compiled struct {
+	korpv1alpha1.KorpPolicy
+	protectedLabels labels.Selector
+}
+
+// Load lists the KorpPolicies whose scan selector matches korpScan.
+// Clusters without the KorpPolicy CRD have no policies.
+func Load(ctx context.Context, dyn dynamic.Interface, korpScan *korpv1alpha1.KorpScan) (*Set, error) {
+	items, err := k8sutil.ListOptional(ctx, dyn, KorpPolicyGVR, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []korpv1alpha1.KorpPolicy
+	for _, item := range items {
+		var p korpv1alpha1.KorpPolicy
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &p); err != nil {
+			return nil, fmt.Errorf("decoding KorpPolicy %s: %w", item.GetName(), err)
+		}
+		policies = append(policies, p)
+	}
+	return New(policies, korpScan)
+}
+
+// New builds the Set of policies whose scan selector matches korpScan
+func New(policies []korpv1alpha1.KorpPolicy, korpScan *korpv1alpha1.KorpScan) (*Set, error) {
+	set := &Set{}
+	for _, p := range policies {
+		if p.Spec.ScanSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(p.Spec.ScanSelector)
+			if err != nil {
+				return nil, fmt.Errorf("KorpPolicy %s has an invalid scanSelector: %w", p.Name, err)
+			}
+			if !selector.Matches(labels.Set(korpScan.Labels)) {
+				continue
+			}
+		}
+
+		c := compiled{KorpPolicy: p}
+		if p.Spec.ProtectedLabels != nil {
+			selector, err := metav1.LabelSelectorAsSelector(p.Spec.ProtectedLabels)
+			if err != nil {
+				return nil, fmt.Errorf("KorpPolicy %s has invalid protectedLabels: %w", p.Name, err)
+			}
+			c.protectedLabels = selector
+		}
+		set.policies = append(set.policies, c)
+	}
+	return set, nil
+}
+
+// AllowsType reports whether every policy allows scanning the resource type (spec name, e.g. "configmaps")
+func (s *Set) AllowsType(specType string) bool {
+	if s == nil {
+		return true
+	}
+	for _, p := range s.policies {
+		if len(p.Spec.AllowedResourceTypes) > 0 && !contains(p.Spec.AllowedResourceTypes, specType) {
+			return false
+		}
+	}
+	return true
+}
+
+// ProtectsNamespace returns the name of the first policy protecting namespace ns, or "" if none does
+func (s *Set) ProtectsNamespace(ns string) string {
+	if s == nil || ns == "" {
+		return ""
+	}
+	for _, p := range s.policies {
+		if matchesAny(p.Spec.ProtectedNamespaces, ns) {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// InspectsObjects reports whether any policy protects resources by their labels or annotations,
+// so callers only fetch objects when they have to
+func (s *Set) InspectsObjects() bool {
+	if s == nil {
+		return false
+	}
+	for _, p := range s.policies {
+		if p.protectedLabels != nil || len(p.Spec.ProtectedAnnotations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Protects returns the name of the first policy protecting obj by its namespace, labels or annotations,
+// or "" if none does
+func (s *Set) Protects(obj metav1.Object) string {
+	if s == nil {
+		return ""
+	}
+	if name := s.ProtectsNamespace(obj.GetNamespace()); name != "" {
+		return name
+	}
+	for _, p := range s.policies {
+		if p.protectedLabels != nil && p.protectedLabels.Matches(labels.Set(obj.GetLabels())) {
+			return p.Name
+		}
+		annotations := obj.GetAnnotations()
+		for key, value := range p.Spec.ProtectedAnnotations {
+			if v, ok := annotations[key]; ok && (value == "" || v == value) {
+				return p.Name
+			}
+		}
+	}
+	return ""
+}
+
+// ForbidsRemediation returns the name of the first policy protecting the finding's namespace
+// or with a never-delete rule matching it, or "" if cleanup may act on it
+func (s *Set) ForbidsRemediation(finding korpv1alpha1.Finding) string {
+	if s == nil {
+		return ""
+	}
+	if name := s.ProtectsNamespace(finding.Namespace); name != "" {
+		return name
+	}
+	specType := k8sutil.SpecResourceTypes[finding.ResourceType]
+	for _, p := range s.policies {
+		for _, rule := range p.Spec.NeverDelete {
+			if len(rule.ResourceTypes) > 0 && !contains(rule.ResourceTypes, specType) {
+				continue
+			}
+			if len(rule.Namespaces) > 0 && !matchesAny(rule.Namespaces, finding.Namespace) {
+				continue
+			}
+			if len(rule.Names) > 0 && !matchesAny(rule.Names, finding.Name) {
+				continue
+			}
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// matchesAny reports whether s matches any of the glob patterns
+func matchesAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether list holds s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+// Package report defines the JSON documents korp emits: the CLI scan output, the
+// webhook payload and SARIF logs. External consumers can import it to parse korp output.
+//
+// Every document carries a schemaVersion. Fields may be added within a schema
+// version; removing or changing the meaning of a field bumps the version.
+package report
+
+import (
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// SchemaVersion is the current version of the documents in this package
+const SchemaVersion = "v2"
+
+// ScanResult is the JSON output of `korp scan --output json`
+type ScanResult struct {
+	// SchemaVersion is the version of this document's schema
+	SchemaVersion string `json:"schemaVersion"`
+
+	// Namespace is the scanned namespace; empty means all namespaces
+	Namespace string `json:"namespace"`
+
+	// Resource counts in the scanned namespace
+	Pods       int `json:"pods"`
+	ConfigMaps int `json:"configmaps"`
+	Secrets    int `json:"secrets"`
+	Services   int `json:"services"`
+	PVCs       int `json:"pvcs"`
+	Endpoints  int `json:"endpoints"`
+
+	// Orphan counts per resource type
+	OrphanConfigMaps    int `json:"orphan_configmaps"`
+	OrphanSecrets       int `json:"orphan_secrets"`
+	OrphanPVCs          int `json:"orphan_pvcs"`
+	ServicesNoEndpoints int `json:"services_no_endpoints"`
+	OrphanEndpoints     int `json:"orphan_endpoints"`
+
+	// Orphan names per resource type; "namespace/name" when Namespace is empty
+	OrphanConfigMapNames     []string `json:"orphan_configmap_names,omitempty"`
+	OrphanSecretNames        []string `json:"orphan_secret_names,omitempty"`
+	OrphanPVCNames           []string `json:"orphan_pvc_names,omitempty"`
+	ServicesNoEndpointsNames []string `json:"services_no_endpoints_names,omitempty"`
+	OrphanEndpointNames      []string `json:"orphan_endpoint_names,omitempty"`
+
+	// BlindSpots are resource types the scan was forbidden to list; their counts above are incomplete
+	BlindSpots []v1alpha1.BlindSpot `json:"blind_spots,omitempty"`
+
+	// NewFindings are "Type/name" findings not seen in the previous run (requires --store)
+	NewFindings []string `json:"new_findings,omitempty"`
+
+	// ResolvedFindings are "Type/name" findings gone since the previous run (requires --store)
+	ResolvedFindings []string `json:"resolved_findings,omitempty"`
+
+	// NotInBaseline are "Type/name" findings missing from the --baseline result
+	NotInBaseline []string `json:"not_in_baseline,omitempty"`
+
+	// LeftBehind groups resources left behind by uninstalled applications (--uninstall-audit)
+	LeftBehind []LeftBehindGroup `json:"left_behind,omitempty"`
+}
+
+// LeftBehindGroup lists the resources left behind by one uninstalled application
+type LeftBehindGroup struct {
+	// App is "helm:<namespace>/<release>" or "olm:<namespace>/<csv>"
+	App string `json:"app"`
+
+	// Resources are "Kind/namespace/name" entries; namespace is empty for cluster-scoped resources
+	Resources []string `json:"resources"`
+}
+
+// WebhookPayload represents the JSON payload sent to webhook endpoints
+type WebhookPayload struct {
+	// SchemaVersion is the version of this document's schema
+	SchemaVersion string `json:"schemaVersion"`
+
+	// EventType describes the type of event (e.g., "scan.completed")
+	EventType string `json:"eventType"`
+
+	// Priority is "high" for events that need attention now, such as a cleanup anomaly
+	Priority string `json:"priority,omitempty"`
+
+	// Timestamp is the ISO8601 formatted time when the event occurred
+	Timestamp string `json:"timestamp"`
+
+	// KorpScan contains metadata about the KorpScan resource
+	KorpScan ScanMetadata `json:"korpscan"`
+
+	// Summary contains aggregate counts of orphaned resources
+	Summary v1alpha1.ScanSummary `json:"summary"`
+
+	// Findings contains detailed information about each orphaned resource
+	Findings []v1alpha1.Finding `json:"findings"`
+
+	// ScanDuration is the human-readable duration of the scan (e.g., "2.5s")
+	ScanDuration string `json:"scanDuration"`
+
+	// Cleanup summarizes the cleanup run after this scan, including reclaimed
+	// storage and freed CPU/memory requests. Omitted when cleanup is disabled.
+	Cleanup *v1alpha1.CleanupSummary `json:"cleanup,omitempty"`
+
+	// WouldRemediate lists what the cleanup after this scan would have deleted, scaled to zero, patched
+	// or labeled in a dry run, of the whole cleanup or of types whose policy is a dry run
+	WouldRemediate []v1alpha1.PlannedRemediation `json:"wouldRemediate,omitempty"`
+
+	// NewFindings are the findings the previous scan did not report
+	NewFindings []v1alpha1.FindingRef `json:"newFindings,omitempty"`
+
+	// ResolvedFindings are the findings of the previous scan that are no longer reported
+	ResolvedFindings []v1alpha1.FindingRef `json:"resolvedFindings,omitempty"`
+
+	// Page identifies this request's part of a scan delivered in several requests.
+	// Omitted when all findings are sent in one request.
+	Page *PageInfo `json:"page,omitempty"`
+
+	// Anomaly describes the cleanup run that remediated more than expected (eventType "cleanup.anomaly")
+	Anomaly *v1alpha1.CleanupAnomaly `json:"anomaly,omitempty"`
+}
+
+// PageInfo describes one part of a multi-part webhook delivery
+type PageInfo struct {
+	// CorrelationID is shared by all parts of the same delivery
+	CorrelationID string `json:"correlationId"`
+
+	// Number is the 1-based position of this part
+	Number int `json:"number"`
+
+	// Total is the number of parts in the delivery
+	Total int `json:"total"`
+
+	// TotalFindings is the number of findings across all parts
+	TotalFindings int `json:"totalFindings"`
+
+	// TotalNewFindings, TotalResolvedFindings and TotalWouldRemediate count the entries of those
+	// lists across all parts; each part carries at most as many of them as findings
+	TotalNewFindings      int `json:"totalNewFindings,omitempty"`
+	TotalResolvedFindings int `json:"totalResolvedFindings,omitempty"`
+	TotalWouldRemediate   int `json:"totalWouldRemediate,omitempty"`
+}
+
+// ScanMetadata contains identifying information about a KorpScan resource
+type ScanMetadata struct {
+	// Name is the name of the KorpScan resource
+	Name string `json:"name"`
+
+	// Namespace is the namespace where the KorpScan resource resides
+	Namespace string `json:"namespace"`
+
+	// TargetNamespace is the namespace being scanned
+	TargetNamespace string `json:"targetNamespace"`
+
+	// Cluster is the scanned cluster's version and the detectors the scan ran and skipped
+	Cluster *v1alpha1.ClusterInfo `json:"cluster,omitempty"`
+}
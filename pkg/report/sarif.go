@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// SARIFVersion is the version of the SARIF documents korp emits
+const SARIFVersion = "2.1.0"
+
+// SARIFSchema is the JSON schema of SARIF 2.1.0 documents
+const SARIFSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+// sarifFingerprint is the partial fingerprint that identifies a finding across runs
+const sarifFingerprint = "korpFinding/v1"
+
+// SARIFLog is a SARIF 2.1.0 log with one run of korp, as uploaded to GitHub code scanning.
+// Each resource type and reason is a rule, e.g. "ConfigMap/NoOwnerReference", and each
+// finding is a result located at "namespaces/<namespace>/<type>/<name>".
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun is one run of korp
+type SARIFRun struct {
+	Tool    SARIFTool              `json:"tool"`
+	Results []SARIFResult          `json:"results"`
+	Props   map[string]interface{} `json:"properties,omitempty"`
+}
+
+// SARIFTool describes korp and the rules it reported
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver describes korp
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+// SARIFRule is one resource type and reason
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+// SARIFMessage is a plain text message
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFResult is one finding
+type SARIFResult struct {
+	RuleID              string                 `json:"ruleId"`
+	RuleIndex           int                    `json:"ruleIndex"`
+	Level               string                 `json:"level"`
+	Message             SARIFMessage           `json:"message"`
+	Locations           []SARIFLocation        `json:"locations"`
+	PartialFingerprints map[string]string      `json:"partialFingerprints"`
+	Props               map[string]interface{} `json:"properties,omitempty"`
+}
+
+// SARIFLocation locates a finding by its path and by its Kubernetes identity
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// SARIFPhysicalLocation holds the resource path of a finding
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+// SARIFArtifactLocation is a resource path
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFLogicalLocation is a namespace or a resource
+type SARIFLogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind"`
+}
+
+// NewSARIF returns the SARIF log of findings. version is korp's version; "" omits it.
+func NewSARIF(findings []v1alpha1.Finding, version string) *SARIFLog {
+	ruleIndex := make(map[string]int)
+	rules := []SARIFRule{}
+	for _, f := range findings {
+		id := SARIFRuleID(f.ResourceType, f.Reason)
+		if _, ok := ruleIndex[id]; ok {
+			continue
+		}
+		ruleIndex[id] = len(rules)
+		rules = append(rules, SARIFRule{
+			ID:               id,
+			Name:             f.ResourceType + f.Reason,
+			ShortDescription: SARIFMessage{Text: fmt.Sprintf("%s: %s", f.ResourceType, f.Reason)},
+			HelpURI:          "https://github.com/kamilbabayev/korp#supported-resource-types",
+		})
+	}
+
+	// Rules in ID order keep the document stable across runs
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	for i, rule := range rules {
+		ruleIndex[rule.ID] = i
+	}
+
+	results := make([]SARIFResult, 0, len(findings))
+	for _, f := range findings {
+		id := SARIFRuleID(f.ResourceType, f.Reason)
+		result := SARIFResult{
+			RuleID:    id,
+			RuleIndex: ruleIndex[id],
+			Level:     "warning",
+			Message:   SARIFMessage{Text: sarifMessage(f)},
+			Locations: []SARIFLocation{sarifLocation(f)},
+			PartialFingerprints: map[string]string{
+				sarifFingerprint: strings.Join([]string{f.ResourceType, f.Namespace, f.Name, f.Reason}, "/"),
+			},
+		}
+		props := map[string]interface{}{}
+		if !f.DetectedAt.IsZero() {
+			props["detectedAt"] = f.DetectedAt.UTC().Format(time.RFC3339)
+		}
+		if f.SuggestedAction != "" {
+			props["suggestedAction"] = f.SuggestedAction
+		}
+		if f.Severity != "" {
+			props["severity"] = f.Severity
+			props["confidence"] = f.Confidence
+		}
+		if len(props) > 0 {
+			result.Props = props
+		}
+		results = append(results, result)
+	}
+
+	return &SARIFLog{
+		Schema:  SARIFSchema,
+		Version: SARIFVersion,
+		Runs: []SARIFRun{{
+			Tool: SARIFTool{Driver: SARIFDriver{
+				Name:           "korp",
+				Version:        version,
+				InformationURI: "https://github.com/kamilbabayev/korp",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+// SARIFRuleID is the rule of a resource type and reason, e.g. "ConfigMap/NoOwnerReference"
+func SARIFRuleID(resourceType, reason string) string {
+	return resourceType + "/" + reason
+}
+
+// sarifMessage describes a finding
+func sarifMessage(f v1alpha1.Finding) string {
+	if f.Namespace == "" {
+		return fmt.Sprintf("%s %s is orphaned (%s)", f.ResourceType, f.Name, f.Reason)
+	}
+	return fmt.Sprintf("%s %s/%s is orphaned (%s)", f.ResourceType, f.Namespace, f.Name, f.Reason)
+}
+
+// sarifLocation places a finding at "namespaces/<namespace>/<type>/<name>", or "<type>/<name>"
+// for cluster-scoped resources
+func sarifLocation(f v1alpha1.Finding) SARIFLocation {
+	path := strings.ToLower(f.ResourceType) + "/" + f.Name
+	qualified := f.ResourceType + "/" + f.Name
+	logical := []SARIFLogicalLocation{}
+	if f.Namespace != "" {
+		path = "namespaces/" + f.Namespace + "/" + path
+		qualified = f.Namespace + "/" + qualified
+		logical = append(logical, SARIFLogicalLocation{Name: f.Namespace, Kind: "namespace"})
+	}
+	logical = append(logical, SARIFLogicalLocation{Name: f.Name, FullyQualifiedName: qualified, Kind: "resource"})
+	return SARIFLocation{
+		PhysicalLocation: SARIFPhysicalLocation{ArtifactLocation: SARIFArtifactLocation{URI: path}},
+		LogicalLocations: logical,
+	}
+}
@@ -9,34 +9,37 @@ package reporter
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 
 	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
 	"github.com/kamilbabayev/korp/pkg/scan"
 )
 
 // EventReporter creates Kubernetes events for scan findings
 type EventReporter struct {
 	recorder record.EventRecorder
-	client   kubernetes.Interface
+	dynamic  dynamic.Interface
 }
 
 // NewEventReporter creates a new EventReporter instance
-func NewEventReporter(client kubernetes.Interface, scheme *runtime.Scheme) *EventReporter {
+func NewEventReporter(client kubernetes.Interface, dynamicClient dynamic.Interface, scheme *runtime.Scheme) *EventReporter {
 	broadcaster := record.NewBroadcaster()
 	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
 		Interface: client.CoreV1().Events(""),
 	})
 	recorder := broadcaster.NewRecorder(scheme, corev1.EventSource{Component: "korp"})
 
-	return &EventReporter{recorder: recorder, client: client}
+	return &EventReporter{recorder: recorder, dynamic: dynamicClient}
 }
 
 // CreateEvents creates Kubernetes events for each finding (attached to the orphaned resource) and a summary event
@@ -47,15 +50,45 @@ func (r *EventReporter) CreateEvents(ctx context.Context, korpScan *korpv1alpha1
 		severity = "Warning"
 	}
 
+	maxPerNamespace := korpScan.Spec.Reporting.MaxEventsPerNamespace
+	maxPerScan := korpScan.Spec.Reporting.MaxEventsPerScan
+
 	// Create events for individual findings attached to the actual orphaned resources
 	// This avoids event aggregation since each event has a different involvedObject
+	created := 0
+	createdPerNamespace := make(map[string]int)
+	suppressed := make(map[string]int)
+	var suppressedNamespaces []string
 	for _, finding := range result.Details {
-		obj := r.getResourceObject(ctx, finding)
+		// Stop creating per-finding events once a cap is reached
+		if (maxPerScan > 0 && created >= maxPerScan) ||
+			(maxPerNamespace > 0 && createdPerNamespace[finding.Namespace] >= maxPerNamespace) {
+			if suppressed[finding.Namespace] == 0 {
+				suppressedNamespaces = append(suppressedNamespaces, finding.Namespace)
+			}
+			suppressed[finding.Namespace]++
+			continue
+		}
+
+		obj := r.objectReference(ctx, finding)
 		if obj != nil {
 			reason := "Orphaned"
 			message := fmt.Sprintf("Resource is orphaned (%s) - detected by korp", finding.Reason)
-			r.recorder.Event(obj, severity, reason, message)
+			r.recorder.Event(obj, findingSeverity(severity, korpScan.Spec.Reporting.SeverityOverrides, finding.Reason), reason, message)
+			created++
+			createdPerNamespace[finding.Namespace]++
+		}
+	}
+
+	// Summarize suppressed findings with one event per namespace
+	for _, ns := range suppressedNamespaces {
+		scope := "in namespace " + ns
+		if ns == "" {
+			scope = "among cluster-scoped resources"
 		}
+		r.recorder.Event(korpScan, severity, "OrphansNotReported",
+			fmt.Sprintf("%d more orphaned resources %s were not reported individually (event cap reached); see KorpScan status",
+				suppressed[ns], scope))
 	}
 
 	// Create summary event on KorpScan
@@ -64,91 +97,41 @@ func (r *EventReporter) CreateEvents(ctx context.Context, korpScan *korpv1alpha1
 	r.recorder.Event(korpScan, "Normal", "ScanCompleted", summary)
 }
 
-// getResourceObject fetches the actual Kubernetes resource object for a finding
-func (r *EventReporter) getResourceObject(ctx context.Context, finding korpv1alpha1.Finding) runtime.Object {
-	switch finding.ResourceType {
-	case "ConfigMap":
-		obj, err := r.client.CoreV1().ConfigMaps(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "Secret":
-		obj, err := r.client.CoreV1().Secrets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "PersistentVolumeClaim":
-		obj, err := r.client.CoreV1().PersistentVolumeClaims(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "Service":
-		obj, err := r.client.CoreV1().Services(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "ServiceAccount":
-		obj, err := r.client.CoreV1().ServiceAccounts(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "Deployment":
-		obj, err := r.client.AppsV1().Deployments(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "StatefulSet":
-		obj, err := r.client.AppsV1().StatefulSets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "DaemonSet":
-		obj, err := r.client.AppsV1().DaemonSets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "ReplicaSet":
-		obj, err := r.client.AppsV1().ReplicaSets(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "Job":
-		obj, err := r.client.BatchV1().Jobs(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "CronJob":
-		obj, err := r.client.BatchV1().CronJobs(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "Ingress":
-		obj, err := r.client.NetworkingV1().Ingresses(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "Role":
-		obj, err := r.client.RbacV1().Roles(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "ClusterRole":
-		obj, err := r.client.RbacV1().ClusterRoles().Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "RoleBinding":
-		obj, err := r.client.RbacV1().RoleBindings(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
-		}
-	case "ClusterRoleBinding":
-		obj, err := r.client.RbacV1().ClusterRoleBindings().Get(ctx, finding.Name, metav1.GetOptions{})
-		if err == nil {
-			return obj
+// objectReference builds the event target for a finding, so events show up in kubectl describe.
+// The UID recorded at detection is used; findings carried over from reports written before UIDs
+// were recorded read their resource once. Findings whose resource is gone return nil.
+func (r *EventReporter) objectReference(ctx context.Context, finding korpv1alpha1.Finding) *corev1.ObjectReference {
+	gvr, ok := k8sutil.ResourceGVRs[finding.ResourceType]
+	if !ok {
+		return nil
+	}
+
+	uid := finding.UID
+	if uid == "" {
+		obj, err := r.dynamic.Resource(gvr).Namespace(finding.Namespace).Get(ctx, finding.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil
 		}
+		uid = obj.GetUID()
+	}
+
+	return &corev1.ObjectReference{
+		APIVersion: gvr.GroupVersion().String(),
+		Kind:       finding.ResourceType,
+		Namespace:  finding.Namespace,
+		Name:       finding.Name,
+		UID:        uid,
 	}
-	return nil
+}
+
+// findingSeverity returns the event severity for a finding reason, honoring per-reason overrides.
+// Overrides other than Normal or Warning are ignored.
+func findingSeverity(severity string, overrides map[string]string, reason string) string {
+	switch override := overrides[reason]; override {
+	case corev1.EventTypeNormal, corev1.EventTypeWarning:
+		return override
+	}
+	return severity
 }
 
 // CreateEvent creates a single Kubernetes event
@@ -156,40 +139,25 @@ func (r *EventReporter) CreateEvent(obj runtime.Object, eventType, reason, messa
 	r.recorder.Event(obj, eventType, reason, message)
 }
 
-// buildSummaryMessage creates a summary message showing only non-zero orphan counts
+// buildSummaryMessage creates a summary message showing the count of each orphan reason found.
+// Reasons that do not mark orphans are left out, so the counts add up to totalOrphans.
 func buildSummaryMessage(totalOrphans int, summary *korpv1alpha1.ScanSummary) string {
 	if totalOrphans == 0 {
 		return "Scan completed: no orphaned resources found"
 	}
 
-	// Define resource types and their counts
-	resourceCounts := []struct {
-		name  string
-		count int
-	}{
-		{"ConfigMaps", summary.OrphanedConfigMaps},
-		{"Secrets", summary.OrphanedSecrets},
-		{"PVCs", summary.OrphanedPVCs},
-		{"Services", summary.ServicesWithoutEndpoints},
-		{"Deployments", summary.OrphanedDeployments},
-		{"StatefulSets", summary.OrphanedStatefulSets},
-		{"DaemonSets", summary.OrphanedDaemonSets},
-		{"Jobs", summary.OrphanedJobs},
-		{"CronJobs", summary.OrphanedCronJobs},
-		{"ReplicaSets", summary.OrphanedReplicaSets},
-		{"Ingresses", summary.OrphanedIngresses},
-		{"ServiceAccounts", summary.OrphanedServiceAccounts},
-		{"Roles", summary.OrphanedRoles},
-		{"ClusterRoles", summary.OrphanedClusterRoles},
-		{"RoleBindings", summary.OrphanedRoleBindings},
-		{"ClusterRoleBindings", summary.OrphanedClusterRoleBindings},
+	reasons := make([]string, 0, len(summary.CountsByReason))
+	for reason := range summary.CountsByReason {
+		if korpv1alpha1.IsOrphan(korpv1alpha1.Finding{Reason: reason}) {
+			reasons = append(reasons, reason)
+		}
 	}
+	sort.Strings(reasons)
 
-	// Build list of non-zero counts
 	var parts []string
-	for _, rc := range resourceCounts {
-		if rc.count > 0 {
-			parts = append(parts, fmt.Sprintf("%s: %d", rc.name, rc.count))
+	for _, reason := range reasons {
+		if n := summary.CountsByReason[reason]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", reason, n))
 		}
 	}
 
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package reporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/scan"
+)
+
+// AnnotateNamespaces writes the orphan count and scan time onto every scanned namespace,
+// including namespaces without findings so a cleaned-up namespace reports zero
+func (r *EventReporter) AnnotateNamespaces(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *scan.ScanResult, now time.Time) error {
+	counts := make(map[string]int)
+	for _, finding := range result.Details {
+		if finding.Namespace != "" && korpv1alpha1.IsOrphan(finding) {
+			counts[finding.Namespace]++
+		}
+	}
+
+	scannedBy := korpScan.Namespace + "/" + korpScan.Name
+	lastScan := now.UTC().Format(time.RFC3339)
+	var errs []error
+	for _, ns := range result.ScannedNamespaces {
+		err := k8sutil.AnnotateNamespace(ctx, r.dynamic, ns, scannedBy, map[string]string{
+			k8sutil.OrphanCountAnnotation: strconv.Itoa(counts[ns]),
+			k8sutil.LastScanAnnotation:    lastScan,
+			k8sutil.ScannedByAnnotation:   scannedBy,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("namespace %s: %w", ns, err))
+		}
+	}
+	return errors.Join(errs...)
+}
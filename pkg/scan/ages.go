@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"sort"
+	"time"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+const day = 24 * time.Hour
+
+// SummarizeAges fills the age distribution and the oldest finding per resource type
+// into summary. It must run after DetectedAt has been carried over from earlier scans.
+func SummarizeAges(summary *korpv1alpha1.ScanSummary, findings []korpv1alpha1.Finding, now time.Time) {
+	summary.AgeDistribution = nil
+	summary.OldestByType = nil
+	if len(findings) == 0 {
+		return
+	}
+
+	dist := &korpv1alpha1.AgeDistribution{}
+	oldest := make(map[string]korpv1alpha1.Finding)
+	for _, f := range findings {
+		switch age := now.Sub(f.DetectedAt.Time); {
+		case age < 7*day:
+			dist.Under7Days++
+		case age < 30*day:
+			dist.From7To30Days++
+		case age < 90*day:
+			dist.From30To90Days++
+		default:
+			dist.Over90Days++
+		}
+
+		if o, ok := oldest[f.ResourceType]; !ok || f.DetectedAt.Before(&o.DetectedAt) {
+			oldest[f.ResourceType] = f
+		}
+	}
+
+	summary.AgeDistribution = dist
+	for rt, f := range oldest {
+		summary.OldestByType = append(summary.OldestByType, korpv1alpha1.OldestFinding{
+			ResourceType: rt,
+			Namespace:    f.Namespace,
+			Name:         f.Name,
+			DetectedAt:   f.DetectedAt,
+			AgeDays:      int(now.Sub(f.DetectedAt.Time) / day),
+		})
+	}
+	sort.Slice(summary.OldestByType, func(i, j int) bool {
+		return summary.OldestByType[i].ResourceType < summary.OldestByType[j].ResourceType
+	})
+}
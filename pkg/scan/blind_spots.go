@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// maxBlindSpotNamespaces caps the namespace names kept per blind spot
+const maxBlindSpotNamespaces = 20
+
+// AddBlindSpot records that resourceType could not be listed in ns ("" for cluster-scoped types
+// or all namespaces) and returns the updated list, with one entry per resource type
+func AddBlindSpot(spots []korpv1alpha1.BlindSpot, resourceType, ns string, err error) []korpv1alpha1.BlindSpot {
+	i := 0
+	for i < len(spots) && spots[i].ResourceType != resourceType {
+		i++
+	}
+	if i == len(spots) {
+		spots = append(spots, korpv1alpha1.BlindSpot{ResourceType: resourceType, Error: err.Error()})
+	}
+	if ns != "" {
+		spots[i].NamespaceCount++
+		if len(spots[i].Namespaces) < maxBlindSpotNamespaces {
+			spots[i].Namespaces = append(spots[i].Namespaces, ns)
+		}
+	}
+	return spots
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/flowcontrol"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// NewScannerForConfig creates a Scanner with API clients of its own, built from a copy of cfg and
+// limited to qps requests per second with bursts of burst. The typed, dynamic and metadata clients
+// share the limit, and it is separate from every other client of cfg, so scans do not use up the
+// budget of the controller's client.
+func NewScannerForConfig(cfg *rest.Config, qps float32, burst int) (*Scanner, error) {
+	s, err := newRateLimitedScanner(cfg, qps, burst)
+	if err != nil {
+		return nil, err
+	}
+	s.config = cfg
+	return s, nil
+}
+
+// ForKorpScan returns the Scanner to scan korpScan with. A KorpScan with Spec.APIRateLimit gets
+// clients limited to it, which only Scanners created by NewScannerForConfig can build; otherwise s
+// itself is returned.
+func (s *Scanner) ForKorpScan(korpScan *korpv1alpha1.KorpScan) (*Scanner, error) {
+	limit := korpScan.Spec.APIRateLimit
+	if limit == nil || s.config == nil {
+		return s, nil
+	}
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = limit.QPS
+	}
+	scanner, err := newRateLimitedScanner(s.config, float32(limit.QPS), int(burst))
+	if err != nil {
+		return nil, err
+	}
+	return scanner.WithChunkSize(s.chunkSize), nil
+}
+
+// newRateLimitedScanner creates a Scanner whose clients share one token bucket
+func newRateLimitedScanner(cfg *rest.Config, qps float32, burst int) (*Scanner, error) {
+	cfg = rest.CopyConfig(cfg)
+	cfg.QPS = qps
+	cfg.Burst = burst
+	cfg.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	metadataClient, err := metadata.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewScanner(k8sutil.NewMetadataClient(client, metadataClient), dynamicClient), nil
+}
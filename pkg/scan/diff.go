@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// FindingsDiff describes how findings changed between two consecutive scans
+type FindingsDiff struct {
+	// New are findings that were not present in the previous scan
+	New []korpv1alpha1.Finding
+
+	// Resolved are findings from the previous scan that are no longer present
+	Resolved []korpv1alpha1.Finding
+}
+
+// findingKey identifies a finding across scans
+func findingKey(f korpv1alpha1.Finding) string {
+	return f.ResourceType + "/" + f.Namespace + "/" + f.Name
+}
+
+// TrackFindings compares current findings with the previous scan. Findings that persist keep the
+// DetectedAt of their first detection, so ages accumulate across scans, and count one more consecutive scan.
+func TrackFindings(previous []korpv1alpha1.Finding, current []korpv1alpha1.Finding) FindingsDiff {
+	prev := make(map[string]korpv1alpha1.Finding, len(previous))
+	for _, f := range previous {
+		prev[findingKey(f)] = f
+	}
+
+	var diff FindingsDiff
+	seen := make(map[string]bool, len(current))
+	for i := range current {
+		key := findingKey(current[i])
+		seen[key] = true
+		if old, ok := prev[key]; ok {
+			current[i].DetectedAt = old.DetectedAt
+			current[i].ConsecutiveScans = consecutiveScans(old) + 1
+			continue
+		}
+		current[i].ConsecutiveScans = 1
+		diff.New = append(diff.New, current[i])
+	}
+
+	for _, f := range previous {
+		if !seen[findingKey(f)] {
+			diff.Resolved = append(diff.Resolved, f)
+		}
+	}
+
+	return diff
+}
+
+// Track compares the findings of the scan with the previous scan like TrackFindings. Previous findings of
+// resource types the scan skipped or could not list in their namespace are not resolved: they are carried
+// over unchanged into Details, since the scan cannot tell whether they still hold.
+func (r *ScanResult) Track(previous []korpv1alpha1.Finding) FindingsDiff {
+	var seen, carried []korpv1alpha1.Finding
+	for _, f := range previous {
+		if r.unseen(f) {
+			carried = append(carried, f)
+		} else {
+			seen = append(seen, f)
+		}
+	}
+
+	diff := TrackFindings(seen, r.Details)
+	if len(carried) > 0 {
+		r.Details = append(r.Details, carried...)
+		r.summarize()
+	}
+	return diff
+}
+
+// unseen reports whether the scan skipped the resource type of f or could not list it in f's namespace
+func (r *ScanResult) unseen(f korpv1alpha1.Finding) bool {
+	specName := k8sutil.SpecResourceTypes[f.ResourceType]
+	if specName == "" {
+		return false
+	}
+	for _, u := range r.UnsupportedTypes {
+		if u.Type == specName {
+			return true
+		}
+	}
+	for _, spot := range r.BlindSpots {
+		if spot.ResourceType != specName {
+			continue
+		}
+		// Cluster-scoped types, and namespaces past the listed ones, are not known to have been seen
+		if spot.NamespaceCount == 0 || spot.NamespaceCount > len(spot.Namespaces) {
+			return true
+		}
+		for _, ns := range spot.Namespaces {
+			if ns == f.Namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// consecutiveScans returns how many successive scans reported a previous finding.
+// Findings recorded before scans were counted were reported at least once.
+func consecutiveScans(f korpv1alpha1.Finding) int {
+	if f.ConsecutiveScans < 1 {
+		return 1
+	}
+	return f.ConsecutiveScans
+}
+
+// detection is when a finding of a previous scan was first detected and how many successive scans reported it
+type detection struct {
+	detectedAt metav1.Time
+	scans      int
+}
+
+// DetectionTimes holds when each finding of a previous scan was first detected
+type DetectionTimes map[string]detection
+
+// NewDetectionTimes indexes the first detection times of the previous scan's findings
+func NewDetectionTimes(previous []korpv1alpha1.Finding) DetectionTimes {
+	times := make(DetectionTimes, len(previous))
+	for _, f := range previous {
+		times[findingKey(f)] = detection{detectedAt: f.DetectedAt, scans: consecutiveScans(f)}
+	}
+	return times
+}
+
+// Apply gives findings that were seen before the DetectedAt of their first detection and their
+// count of consecutive scans, like TrackFindings does for a whole scan
+func (d DetectionTimes) Apply(findings []korpv1alpha1.Finding) {
+	for i := range findings {
+		if t, ok := d[findingKey(findings[i])]; ok {
+			findings[i].DetectedAt = t.detectedAt
+			findings[i].ConsecutiveScans = t.scans + 1
+			continue
+		}
+		findings[i].ConsecutiveScans = 1
+	}
+}
+
+// Refs returns references to findings, at most limit of them when limit > 0
+func Refs(findings []korpv1alpha1.Finding, limit int) []korpv1alpha1.FindingRef {
+	if limit > 0 && len(findings) > limit {
+		findings = findings[:limit]
+	}
+	var refs []korpv1alpha1.FindingRef
+	for _, f := range findings {
+		detectedAt := f.DetectedAt
+		refs = append(refs, korpv1alpha1.FindingRef{
+			ResourceType: f.ResourceType,
+			Namespace:    f.Namespace,
+			Name:         f.Name,
+			Reason:       f.Reason,
+			DetectedAt:   &detectedAt,
+		})
+	}
+	return refs
+}
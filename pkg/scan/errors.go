@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// PermissionError is returned when korp is forbidden to read what a detector needs.
+// Callers usually skip the resource type and record a blind spot.
+type PermissionError struct {
+	// ResourceType is the resource type whose detector failed
+	ResourceType string
+
+	// Namespace is the namespace scanned, "" for cluster-scoped types
+	Namespace string
+
+	Err error
+}
+
+func (e *PermissionError) Error() string {
+	return detectorErrorMessage(e.ResourceType, e.Namespace, "permission denied", e.Err)
+}
+
+func (e *PermissionError) Unwrap() error { return e.Err }
+
+// NotSupportedError is returned when the cluster does not serve an API a detector needs,
+// for example because a CRD was removed during the scan. Callers usually skip the resource type.
+type NotSupportedError struct {
+	// ResourceType is the resource type whose detector failed
+	ResourceType string
+
+	// Namespace is the namespace scanned, "" for cluster-scoped types
+	Namespace string
+
+	Err error
+}
+
+func (e *NotSupportedError) Error() string {
+	return detectorErrorMessage(e.ResourceType, e.Namespace, "not supported", e.Err)
+}
+
+func (e *NotSupportedError) Unwrap() error { return e.Err }
+
+// TransientAPIError is returned when the API server failed in a way that is likely to pass:
+// timeouts, throttling, unavailability and internal errors. Callers usually retry soon.
+type TransientAPIError struct {
+	// ResourceType is the resource type whose detector failed
+	ResourceType string
+
+	// Namespace is the namespace scanned, "" for cluster-scoped types
+	Namespace string
+
+	Err error
+}
+
+func (e *TransientAPIError) Error() string {
+	return detectorErrorMessage(e.ResourceType, e.Namespace, "transient API error", e.Err)
+}
+
+func (e *TransientAPIError) Unwrap() error { return e.Err }
+
+// detectorErrorMessage formats the message of a typed detector error
+func detectorErrorMessage(resourceType, ns, class string, err error) string {
+	if ns == "" {
+		return fmt.Sprintf("scanning %s: %s: %v", resourceType, class, err)
+	}
+	return fmt.Sprintf("scanning %s in %s: %s: %v", resourceType, ns, class, err)
+}
+
+// DetectorError classifies an error of the detector for resourceType in namespace ns ("" for
+// cluster-scoped types) as a PermissionError, NotSupportedError or TransientAPIError.
+// Errors that are already typed, and errors of no known class, are returned unchanged.
+func DetectorError(resourceType, ns string, err error) error {
+	if err == nil || IsPermission(err) || IsNotSupported(err) || IsTransient(err) {
+		return err
+	}
+	switch {
+	case apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err):
+		return &PermissionError{ResourceType: resourceType, Namespace: ns, Err: err}
+	case apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) || meta.IsNoMatchError(err):
+		return &NotSupportedError{ResourceType: resourceType, Namespace: ns, Err: err}
+	case apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) || apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err) || apierrors.IsUnexpectedServerError(err):
+		return &TransientAPIError{ResourceType: resourceType, Namespace: ns, Err: err}
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return &TransientAPIError{ResourceType: resourceType, Namespace: ns, Err: err}
+	}
+	return err
+}
+
+// skipDetectorError records a PermissionError as a blind spot and a NotSupportedError as an
+// unsupported type, once per type, and returns nil for both; other errors are returned
+func (r *ScanResult) skipDetectorError(resourceType, ns string, err error) error {
+	switch {
+	case IsPermission(err):
+		r.BlindSpots = AddBlindSpot(r.BlindSpots, resourceType, ns, errors.Unwrap(err))
+		return nil
+	case IsNotSupported(err):
+		for _, u := range r.UnsupportedTypes {
+			if u.Type == resourceType {
+				return nil
+			}
+		}
+		r.UnsupportedTypes = append(r.UnsupportedTypes, UnsupportedType{Type: resourceType, Reason: "no longer served by this cluster"})
+		return nil
+	}
+	return err
+}
+
+// IsPermission reports whether err is or wraps a PermissionError
+func IsPermission(err error) bool {
+	var target *PermissionError
+	return errors.As(err, &target)
+}
+
+// IsNotSupported reports whether err is or wraps a NotSupportedError
+func IsNotSupported(err error) bool {
+	var target *NotSupportedError
+	return errors.As(err, &target)
+}
+
+// IsTransient reports whether err is or wraps a TransientAPIError
+func IsTransient(err error) bool {
+	var target *TransientAPIError
+	return errors.As(err, &target)
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/policy"
+)
+
+// Every detector and every false-positive fix ships with a scenario under testdata/fixtures. A
+// scenario directory holds:
+//
+//	resources.yaml  objects of the cluster, as YAML documents
+//	korpscan.yaml   optional KorpScan whose spec configures the scan; all namespaces if absent
+//	expected.json   the expected findings, written with -update
+
+var update = flag.Bool("update", false, "write the findings of each fixture scenario to its expected.json")
+
+// fixturesDir holds one directory per scenario
+const fixturesDir = "testdata/fixtures"
+
+// fixtureFinding is the part of a finding a scenario pins down; times and descriptions vary between runs
+type fixtureFinding struct {
+	ResourceType    string `json:"resourceType"`
+	Namespace       string `json:"namespace,omitempty"`
+	Name            string `json:"name"`
+	Reason          string `json:"reason"`
+	SuggestedAction string `json:"suggestedAction,omitempty"`
+}
+
+func (f fixtureFinding) String() string {
+	return fmt.Sprintf("%s %s/%s (%s)", f.ResourceType, f.Namespace, f.Name, f.Reason)
+}
+
+func TestFixtures(t *testing.T) {
+	entries, err := os.ReadDir(fixturesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		dir := filepath.Join(fixturesDir, e.Name())
+		t.Run(e.Name(), func(t *testing.T) {
+			got := scanFixture(t, dir)
+			expectedFile := filepath.Join(dir, "expected.json")
+			if *update {
+				data, err := json.MarshalIndent(got, "", "  ")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(expectedFile, append(data, '\n'), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			data, err := os.ReadFile(expectedFile)
+			if err != nil {
+				t.Fatalf("%v (run with -update to create it)", err)
+			}
+			var want []fixtureFinding
+			if err := json.Unmarshal(data, &want); err != nil {
+				t.Fatalf("parsing expected.json: %v", err)
+			}
+			missing, unexpected := diffFindings(want, got)
+			for _, f := range missing {
+				t.Errorf("missing:    %s", f)
+			}
+			for _, f := range unexpected {
+				t.Errorf("unexpected: %s", f)
+			}
+		})
+	}
+}
+
+// scanFixture scans the scenario in dir and returns its findings in a stable order
+func scanFixture(t *testing.T, dir string) []fixtureFinding {
+	t.Helper()
+	korpScan := &korpv1alpha1.KorpScan{
+		ObjectMeta: metav1.ObjectMeta{Name: "fixture", Namespace: "korp"},
+		Spec:       korpv1alpha1.KorpScanSpec{TargetNamespace: "*"},
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "korpscan.yaml")); err == nil {
+		if err := yaml.UnmarshalStrict(data, korpScan); err != nil {
+			t.Fatalf("parsing korpscan.yaml: %v", err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		t.Fatal(err)
+	}
+
+	client, dyn := fixtureClients(t, loadFixtureObjects(t, filepath.Join(dir, "resources.yaml")))
+	result, err := NewScanner(client, dyn).Scan(context.Background(), korpScan)
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+
+	findings := make([]fixtureFinding, 0, len(result.Details))
+	for _, f := range result.Details {
+		findings = append(findings, fixtureFinding{
+			ResourceType:    f.ResourceType,
+			Namespace:       f.Namespace,
+			Name:            f.Name,
+			Reason:          f.Reason,
+			SuggestedAction: f.SuggestedAction,
+		})
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Reason < b.Reason
+	})
+	return findings
+}
+
+// loadFixtureObjects reads the objects of a resources.yaml. Kinds client-go knows are typed, others unstructured.
+func loadFixtureObjects(t *testing.T, path string) []runtime.Object {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var objects []runtime.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("reading %s: %v", path, err)
+		}
+		u := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(doc, &u.Object); err != nil {
+			t.Fatalf("decoding %s: %v", path, err)
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+		if u.GetKind() == "" || u.GetAPIVersion() == "" {
+			t.Fatalf("object %q in %s has no apiVersion or kind", u.GetName(), path)
+		}
+		if !scheme.Scheme.Recognizes(u.GroupVersionKind()) {
+			objects = append(objects, u)
+			continue
+		}
+		obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(doc, nil, nil)
+		if err != nil {
+			t.Fatalf("decoding %s: %v", path, err)
+		}
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// fixtureClients returns in-memory clients serving objects. API discovery lists the resource types
+// korp knows and the kinds of the objects; other APIs are not served, as on a cluster without the CRD.
+func fixtureClients(t *testing.T, objects []runtime.Object) (kubernetes.Interface, dynamic.Interface) {
+	t.Helper()
+	var typed []runtime.Object
+	var unstructuredObjects []runtime.Object
+	listKinds := make(map[schema.GroupVersionResource]string)
+	served := make(map[schema.GroupVersion][]metav1.APIResource)
+	serve := func(gvr schema.GroupVersionResource, kind string) {
+		if _, ok := listKinds[gvr]; ok {
+			return
+		}
+		listKinds[gvr] = kind + "List"
+		gv := gvr.GroupVersion()
+		served[gv] = append(served[gv], metav1.APIResource{Name: gvr.Resource, Kind: kind})
+	}
+
+	for kind, gvr := range k8sutil.ResourceGVRs {
+		serve(gvr, kind)
+	}
+	serve(policy.KorpPolicyGVR, "KorpPolicy")
+
+	for _, obj := range objects {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		if _, ok := obj.(*unstructured.Unstructured); !ok {
+			typed = append(typed, obj)
+		}
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		serve(gvr, gvk.Kind)
+
+		// Everything korp reads through the dynamic client is served there too
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := &unstructured.Unstructured{Object: content}
+		u.SetGroupVersionKind(gvk)
+		unstructuredObjects = append(unstructuredObjects, u)
+	}
+
+	client := fake.NewClientset(typed...)
+	discovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+	for gv, resources := range served {
+		discovery.Resources = append(discovery.Resources, &metav1.APIResourceList{GroupVersion: gv.String(), APIResources: resources})
+	}
+	sort.Slice(discovery.Resources, func(i, j int) bool {
+		return discovery.Resources[i].GroupVersion < discovery.Resources[j].GroupVersion
+	})
+
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, unstructuredObjects...)
+	return client, &servedDynamic{Interface: dyn, listKinds: listKinds}
+}
+
+// diffFindings returns the findings missing from got and the unexpected ones in it
+func diffFindings(want, got []fixtureFinding) (missing, unexpected []fixtureFinding) {
+	count := make(map[fixtureFinding]int)
+	for _, f := range got {
+		count[f]++
+	}
+	for _, f := range want {
+		if count[f] > 0 {
+			count[f]--
+			continue
+		}
+		missing = append(missing, f)
+	}
+	for _, f := range got {
+		if count[f] > 0 {
+			count[f]--
+			unexpected = append(unexpected, f)
+		}
+	}
+	return missing, unexpected
+}
+
+// servedDynamic serves the resources of the scenario and answers NotFound for every other API
+type servedDynamic struct {
+	dynamic.Interface
+	listKinds map[schema.GroupVersionResource]string
+}
+
+func (d *servedDynamic) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	if _, ok := d.listKinds[gvr]; ok {
+		return d.Interface.Resource(gvr)
+	}
+	return &unservedResource{gvr: gvr}
+}
+
+// unservedResource is an API the scenario does not serve. Only reads are answered.
+type unservedResource struct {
+	dynamic.NamespaceableResourceInterface
+	gvr schema.GroupVersionResource
+}
+
+func (r *unservedResource) Namespace(string) dynamic.ResourceInterface { return r }
+
+func (r *unservedResource) Get(_ context.Context, name string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+	return nil, apierrors.NewNotFound(r.gvr.GroupResource(), name)
+}
+
+func (r *unservedResource) List(context.Context, metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return nil, apierrors.NewNotFound(r.gvr.GroupResource(), "")
+}
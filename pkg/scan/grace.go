@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// DefaultGraceWindowMinutes are per-type windows during which new resources are not flagged,
+// covering normal rollout churn. Keys are spec resource type names. Deployments have no entry:
+// each one waits out its own progressDeadlineSeconds (see pastProgressDeadline).
+var DefaultGraceWindowMinutes = map[string]int{
+	"replicasets":  10,
+	"statefulsets": 10,
+	// PVCs with WaitForFirstConsumer binding wait for their pod to be scheduled
+	"pvcs": 5,
+	// Endpoints are populated once the selected pods become ready
+	"services":       2,
+	"endpoints":      2,
+	"endpointslices": 2,
+}
+
+// minAgeMinutes returns how old a resource of the given type must be before it is flagged:
+// the larger of the global minimum and the type's grace window
+func minAgeMinutes(resourceType string, filters korpv1alpha1.FilterSpec) int {
+	minutes := filters.MinResourceAgeMinutes
+
+	specType := k8sutil.SpecResourceTypes[resourceType]
+	window, ok := filters.GraceWindowMinutes[specType]
+	if !ok {
+		window = DefaultGraceWindowMinutes[specType]
+	}
+	if window > minutes {
+		minutes = window
+	}
+	return minutes
+}
+
+// pastProgressDeadline drops Deployments created less than their progressDeadlineSeconds ago, since
+// their first rollout may still be settling. A graceWindowMinutes entry for deployments replaces the
+// per-object deadline; Deployments missing from deadlines are kept.
+func pastProgressDeadline(objs []metav1.ObjectMeta, deadlines map[string]time.Duration, filters korpv1alpha1.FilterSpec) []metav1.ObjectMeta {
+	if _, ok := filters.GraceWindowMinutes["deployments"]; ok {
+		return objs
+	}
+
+	now := time.Now()
+	var kept []metav1.ObjectMeta
+	for _, obj := range objs {
+		if deadline, ok := deadlines[obj.Name]; ok && obj.CreationTimestamp.Add(deadline).After(now) {
+			continue
+		}
+		kept = append(kept, obj)
+	}
+	return kept
+}
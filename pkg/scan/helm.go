@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// tagHelmRelease sets the release of a finding whose resource Helm manages, and marks it abandoned
+// when the release named by its annotations has no deployed revision. The detector's reason is kept.
+func tagHelmRelease(ctx context.Context, tracker *k8sutil.AppTracker, f *korpv1alpha1.Finding, obj *unstructured.Unstructured) error {
+	f.HelmRelease = k8sutil.HelmRelease(obj)
+	if f.HelmRelease == "" {
+		return nil
+	}
+	abandoned, err := tracker.AbandonedHelmRelease(ctx, obj)
+	if err != nil || abandoned == "" {
+		return err
+	}
+	f.HelmRelease = abandoned
+	f.HelmReleaseAbandoned = true
+	return nil
+}
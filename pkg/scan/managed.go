@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// tagManagedFindings records the Helm release and the GitOps owner of the findings added to result since found
+// whose resource Helm or a GitOps controller manages, and removes those the HelmManaged and GitOpsManaged
+// filters drop, along with those below the MinSeverity and MinConfidence filters. Each resource type is listed
+// once per call; findings of types korp may not list are left untagged.
+func (s *Scanner) tagManagedFindings(
+	ctx context.Context,
+	tracker *k8sutil.AppTracker,
+	result *ScanResult,
+	found int,
+	filters korpv1alpha1.FilterSpec,
+) error {
+	objects := make(map[string]map[string]*unstructured.Unstructured)
+	kept := result.Details[found:found]
+
+	for _, f := range result.Details[found:] {
+		obj, err := s.findingObject(ctx, objects, f)
+		if err != nil {
+			return err
+		}
+		if obj != nil {
+			if err := tagHelmRelease(ctx, tracker, &f, obj); err != nil {
+				return err
+			}
+			tagGitOpsOwner(&f, obj)
+		}
+
+		switch {
+		case filters.HelmManaged == korpv1alpha1.HelmManagedExclude && f.HelmRelease != "":
+			continue
+		case filters.HelmManaged == korpv1alpha1.HelmManagedAbandonedReleases && !f.HelmReleaseAbandoned:
+			continue
+		case filters.GitOpsManaged == korpv1alpha1.GitOpsManagedExclude && f.GitOpsOwner != "":
+			continue
+		case !f.Meets(filters.MinSeverity, filters.MinConfidence):
+			continue
+		}
+		kept = append(kept, f)
+	}
+	result.Details = result.Details[:found+len(kept)]
+	return nil
+}
+
+// tagGitOpsOwner records the Argo CD Application or Flux object that deploys a finding's resource and gives
+// the finding the reason GitOpsManagedButUnused, which cleanup only labels. It applies to abandoned Helm
+// releases too, since Flux installs Helm releases and would install an uninstalled one again.
+func tagGitOpsOwner(f *korpv1alpha1.Finding, obj *unstructured.Unstructured) {
+	f.GitOpsOwner = k8sutil.GitOpsOwner(obj)
+	if f.GitOpsOwner == "" {
+		return
+	}
+	setReason(f, k8sutil.GitOpsManagedButUnusedReason)
+	f.SuggestedAction = SuggestedAction(f.ResourceType, f.Reason)
+}
+
+// findingObject returns the resource of a finding from the listing of its type and namespace, listing it
+// on first use, or nil if korp cannot list the type or the resource is gone
+func (s *Scanner) findingObject(
+	ctx context.Context,
+	objects map[string]map[string]*unstructured.Unstructured,
+	f korpv1alpha1.Finding,
+) (*unstructured.Unstructured, error) {
+	gvr, ok := k8sutil.ResourceGVRs[f.ResourceType]
+	if !ok {
+		return nil, nil
+	}
+
+	key := f.ResourceType + "/" + f.Namespace
+	byName, ok := objects[key]
+	if !ok {
+		byName = make(map[string]*unstructured.Unstructured)
+		items, err := k8sutil.ListOptional(ctx, s.dynamic, gvr, f.Namespace)
+		if err != nil && !apierrors.IsForbidden(err) {
+			return nil, err
+		}
+		for j := range items {
+			byName[items[j].GetName()] = &items[j]
+		}
+		objects[key] = byName
+	}
+	return byName[f.Name], nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// MarkFindings annotates the resource of each orphan finding with korp.io/orphaned-since and korp.io/reason.
+// DetectedAt is set from the annotation, so the first detection survives operator restarts and
+// KorpScan re-creation. Failures are collected and do not stop the remaining findings.
+func (s *Scanner) MarkFindings(ctx context.Context, findings []korpv1alpha1.Finding) error {
+	var errs []error
+	for i := range findings {
+		f := &findings[i]
+		if !korpv1alpha1.IsOrphan(*f) {
+			continue
+		}
+		since, err := k8sutil.MarkOrphaned(ctx, s.dynamic, f.ResourceType, f.Namespace, f.Name, f.Reason, f.DetectedAt.Time)
+		if err != nil {
+			// The resource may have been deleted since it was scanned
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("%s %s/%s: %w", f.ResourceType, f.Namespace, f.Name, err))
+			}
+			continue
+		}
+		f.DetectedAt = metav1.Time{Time: since}
+	}
+	return errors.Join(errs...)
+}
+
+// UnmarkFindings removes korp annotations from the resources of resolved findings that still exist,
+// so a stale marking cannot shorten cleanup age gating if the resource is flagged again later.
+// It returns the resources that were unmarked.
+func (s *Scanner) UnmarkFindings(ctx context.Context, findings []korpv1alpha1.Finding) ([]*unstructured.Unstructured, error) {
+	var unmarked []*unstructured.Unstructured
+	var errs []error
+	for _, f := range findings {
+		obj, err := k8sutil.UnmarkOrphaned(ctx, s.dynamic, f.ResourceType, f.Namespace, f.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s/%s: %w", f.ResourceType, f.Namespace, f.Name, err))
+			continue
+		}
+		if obj != nil {
+			unmarked = append(unmarked, obj)
+		}
+	}
+	return unmarked, errors.Join(errs...)
+}
+
+// UnmarkStale removes korp's markings from resources of the scanned types and namespaces that carry them
+// but are not among the findings of result, whatever scan marked them. Partial scans unmark nothing,
+// nor do types the scan could not list. It returns the resources that were unmarked.
+func (s *Scanner) UnmarkStale(ctx context.Context, result *ScanResult) ([]*unstructured.Unstructured, error) {
+	if result.Partial {
+		return nil, nil
+	}
+	current := make(map[string]bool, len(result.Details))
+	for _, f := range result.Details {
+		current[findingKey(f)] = true
+	}
+	scanned := make(map[string]bool, len(result.ScannedNamespaces))
+	for _, ns := range result.ScannedNamespaces {
+		scanned[ns] = true
+	}
+	active := make(map[string]bool, len(result.activeTypes))
+	for _, rt := range result.activeTypes {
+		active[rt] = true
+	}
+
+	var unmarked []*unstructured.Unstructured
+	var errs []error
+	for resourceType, specName := range k8sutil.SpecResourceTypes {
+		if !active[specName] || result.unseen(korpv1alpha1.Finding{ResourceType: resourceType}) {
+			continue
+		}
+		marked, err := k8sutil.ListMarked(ctx, s.dynamic, resourceType, "")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing marked %s: %w", resourceType, err))
+			continue
+		}
+		for _, obj := range marked {
+			ns := obj.GetNamespace()
+			if ns != "" && !scanned[ns] {
+				continue
+			}
+			if result.unseen(korpv1alpha1.Finding{ResourceType: resourceType, Namespace: ns}) {
+				continue
+			}
+			f := korpv1alpha1.Finding{ResourceType: resourceType, Namespace: ns, Name: obj.GetName()}
+			if current[findingKey(f)] {
+				continue
+			}
+			done, err := k8sutil.UnmarkOrphaned(ctx, s.dynamic, resourceType, ns, obj.GetName())
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s %s/%s: %w", resourceType, ns, obj.GetName(), err))
+				continue
+			}
+			if done != nil {
+				unmarked = append(unmarked, done)
+			}
+		}
+	}
+	return unmarked, errors.Join(errs...)
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"github.com/kamilbabayev/korp/pkg/policy"
+)
+
+// allowedTypes returns the resource types every KorpPolicy allows to be scanned
+func allowedTypes(types []string, policies *policy.Set) []string {
+	var allowed []string
+	for _, rt := range types {
+		if policies.AllowsType(rt) {
+			allowed = append(allowed, rt)
+		}
+	}
+	return allowed
+}
+
+// unprotectedNamespaces returns the namespaces no KorpPolicy protects
+func unprotectedNamespaces(namespaces []string, policies *policy.Set) []string {
+	var kept []string
+	for _, ns := range namespaces {
+		if policies.ProtectsNamespace(ns) == "" {
+			kept = append(kept, ns)
+		}
+	}
+	return kept
+}
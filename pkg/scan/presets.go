@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+)
+
+// FilterPresets are known-safe exclusions for resources managed by common ecosystems.
+// Each preset targets objects that look orphaned but are owned by that ecosystem's controllers.
+var FilterPresets = map[string]korpv1alpha1.FilterSpec{
+	// Helm stores release state in ownerless Secrets
+	"helm": {
+		ExcludeNamePatterns: []string{`^sh\.helm\.release\.v1\.`},
+	},
+	// Argo CD reads its configuration from well-known ConfigMaps/Secrets by name
+	"argocd": {
+		ExcludeNamespaces: []string{"argocd"},
+		ExcludeNamePatterns: []string{
+			`^argocd-(cm|rbac-cm|cmd-params-cm|ssh-known-hosts-cm|tls-certs-cm|gpg-keys-cm|notifications-cm|notifications-secret|secret|initial-admin-secret)$`,
+		},
+	},
+	// Istio distributes its root certificate to every namespace
+	"istio": {
+		ExcludeNamespaces:   []string{"istio-system"},
+		ExcludeNamePatterns: []string{`^istio-ca-root-cert$`},
+	},
+	// cert-manager keeps its webhook CA and controller state in its own namespace
+	"cert-manager": {
+		ExcludeNamespaces:   []string{"cert-manager"},
+		ExcludeNamePatterns: []string{`^cert-manager-webhook-ca$`},
+	},
+}
+
+// PresetNames returns the names of the available filter presets
+func PresetNames() []string {
+	names := make([]string, 0, len(FilterPresets))
+	for name := range FilterPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveFilters merges the named presets into the user filters
+func ResolveFilters(filters korpv1alpha1.FilterSpec, presets []string) (korpv1alpha1.FilterSpec, error) {
+	resolved := *filters.DeepCopy()
+	for _, name := range presets {
+		preset, ok := FilterPresets[strings.TrimSpace(name)]
+		if !ok {
+			return resolved, fmt.Errorf("unknown filter preset %q (available: %s)", name, strings.Join(PresetNames(), ", "))
+		}
+		resolved.ExcludeNamePatterns = append(resolved.ExcludeNamePatterns, preset.ExcludeNamePatterns...)
+		resolved.ExcludeNamespaces = append(resolved.ExcludeNamespaces, preset.ExcludeNamespaces...)
+		for k, v := range preset.ExcludeLabels {
+			if resolved.ExcludeLabels == nil {
+				resolved.ExcludeLabels = make(map[string]string)
+			}
+			resolved.ExcludeLabels[k] = v
+		}
+	}
+	return resolved, nil
+}
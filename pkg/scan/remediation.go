@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// SuggestedAction returns the remediation cleanup should apply to a finding
+func SuggestedAction(resourceType, reason string) string {
+	switch reason {
+	case k8sutil.DanglingImagePullSecretReason, k8sutil.DanglingSecretReason:
+		// Only the reference is stale; the ServiceAccount is in use
+		return korpv1alpha1.ActionPatch
+	case k8sutil.StaleCABundleReason:
+		// Deleting a webhook configuration disables its policy; leave the decision to a human
+		return korpv1alpha1.ActionLabel
+	case k8sutil.OversizedObjectReason:
+		// Size alone says nothing about use; flag the object for its owner to slim down
+		return korpv1alpha1.ActionLabel
+	case k8sutil.UnknownPrincipalsReason:
+		// The identity provider list may be stale or incomplete; a binding may still grant needed access
+		return korpv1alpha1.ActionLabel
+	case k8sutil.GitOpsManagedButUnusedReason:
+		// The GitOps controller would re-create a deleted resource; it must be removed from Git
+		return korpv1alpha1.ActionLabel
+	}
+
+	if resourceType == "StatefulSet" {
+		// Scaling to zero keeps the StatefulSet's identity and volume claims
+		return korpv1alpha1.ActionScaleToZero
+	}
+	return korpv1alpha1.ActionDelete
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"fmt"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// DefaultResourceTypes are scanned when a KorpScan does not list resource types
+var DefaultResourceTypes = []string{"configmaps", "secrets", "pvcs", "services", "deployments", "jobs", "ingresses",
+	"statefulsets", "daemonsets", "cronjobs", "replicasets", "serviceaccounts",
+	"roles", "clusterroles", "rolebindings", "clusterrolebindings",
+	"networkpolicies", "poddisruptionbudgets", "hpas", "pvs", "endpoints", "endpointslices",
+	"resourcequotas", "limitranges", "secretproviderclasses", "externalsecrets"}
+
+// UnsupportedType is a requested resource type that is skipped
+type UnsupportedType struct {
+	// Type is the resource type as written in the spec
+	Type string
+
+	// Reason explains why the type is skipped
+	Reason string
+}
+
+// String formats the type and reason for condition messages
+func (u UnsupportedType) String() string {
+	return fmt.Sprintf("%s (%s)", u.Type, u.Reason)
+}
+
+// supportedResourceTypes splits types into those korp can scan on this cluster and those it cannot.
+// A type is unsupported when korp has no detector for it or API discovery serves none of the API
+// versions korp reads it in. Discovery failures other than a missing group version leave the type
+// in place for the scan to report.
+func supportedResourceTypes(versions *k8sutil.APIVersions, types []string) ([]string, []UnsupportedType) {
+	kinds := make(map[string]string, len(k8sutil.SpecResourceTypes))
+	for kind, specName := range k8sutil.SpecResourceTypes {
+		kinds[specName] = kind
+	}
+
+	var supported []string
+	var unsupported []UnsupportedType
+	for _, rt := range types {
+		kind, ok := kinds[rt]
+		if !ok {
+			unsupported = append(unsupported, UnsupportedType{Type: rt, Reason: "unknown resource type"})
+			continue
+		}
+
+		gvr, served, err := versions.Resolve(kind)
+		if err != nil {
+			supported = append(supported, rt)
+			continue
+		}
+		if served {
+			supported = append(supported, rt)
+			continue
+		}
+
+		gv := gvr.GroupVersion().String()
+		if ok, err := versions.ServesGroupVersion(gv); err == nil && !ok {
+			unsupported = append(unsupported, UnsupportedType{Type: rt, Reason: gv + " is not served by this cluster"})
+		} else {
+			unsupported = append(unsupported, UnsupportedType{Type: rt, Reason: gvr.Resource + " is not served in " + gv})
+		}
+	}
+	return supported, unsupported
+}
+
+// apiVersion returns the API version to read resourceType in: the newest one the cluster serves,
+// or the version of k8sutil.ResourceGVRs if discovery cannot tell
+func (r *ScanResult) apiVersion(resourceType string) string {
+	return r.apiVersions.GVR(resourceType).Version
+}
+
+// Cluster describes the scanned cluster and the detectors the scan ran and skipped.
+// Types found to be unserved during the scan count as skipped.
+func (r *ScanResult) Cluster() korpv1alpha1.ClusterInfo {
+	info := korpv1alpha1.ClusterInfo{Version: r.clusterVersion, Platform: r.clusterPlatform}
+	skipped := make(map[string]bool)
+	for _, u := range append(append([]UnsupportedType{}, r.UnsupportedTypes...), r.skippedTypes...) {
+		if skipped[u.Type] {
+			continue
+		}
+		skipped[u.Type] = true
+		info.SkippedDetectors = append(info.SkippedDetectors, korpv1alpha1.SkippedDetector{ResourceType: u.Type, Reason: u.Reason})
+	}
+	for _, rt := range r.activeTypes {
+		if !skipped[rt] {
+			info.ActiveDetectors = append(info.ActiveDetectors, rt)
+		}
+	}
+	return info
+}
@@ -8,75 +8,253 @@ package scan
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
 	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/policy"
 )
 
 // newFinding creates a Finding with a formatted Description
 func newFinding(resourceType, namespace, name, reason string, detectedAt metav1.Time) korpv1alpha1.Finding {
 	return korpv1alpha1.Finding{
-		Separator:    "---",
-		Description:  fmt.Sprintf("%s %s/%s (%s)", resourceType, namespace, name, reason),
-		ResourceType: resourceType,
-		Name:         name,
-		Namespace:    namespace,
-		Reason:       reason,
-		DetectedAt:   detectedAt,
+		Separator:       "---",
+		Description:     fmt.Sprintf("%s %s/%s (%s)", resourceType, namespace, name, reason),
+		ResourceType:    resourceType,
+		Name:            name,
+		Namespace:       namespace,
+		Reason:          reason,
+		DetectedAt:      detectedAt,
+		SuggestedAction: SuggestedAction(resourceType, reason),
+		Severity:        Severity(resourceType, reason),
+		Confidence:      Confidence(resourceType, reason),
 	}
 }
 
+// objectFinding returns a new finding about obj, recording its UID
+func objectFinding(resourceType, namespace string, obj metav1.ObjectMeta, reason string, detectedAt metav1.Time) korpv1alpha1.Finding {
+	finding := newFinding(resourceType, namespace, obj.Name, reason, detectedAt)
+	finding.UID = obj.UID
+	return finding
+}
+
 // Scanner performs scans of Kubernetes resources for orphans
 type Scanner struct {
-	client *kubernetes.Clientset
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+
+	// config builds clients with a KorpScan's own rate limit; nil if the Scanner was given its clients
+	config *rest.Config
+
+	// informers answer Lists from their caches in watch mode; nil otherwise
+	informers *k8sutil.Informers
+
+	// rescan limits the scan to changed namespaces; nil for full scans
+	rescan *rescan
+
+	// chunkSize is the number of items scans list per request; 0 lists whole collections at once
+	chunkSize int64
 }
 
 // NewScanner creates a new Scanner instance
-func NewScanner(client *kubernetes.Clientset) *Scanner {
-	return &Scanner{client: client}
+func NewScanner(client kubernetes.Interface, dynamicClient dynamic.Interface) *Scanner {
+	return &Scanner{client: client, dynamic: dynamicClient, chunkSize: k8sutil.DefaultChunkSize}
+}
+
+// WithChunkSize returns a copy of s whose scans list collections in requests of at most chunkSize
+// items; 0 lists whole collections in one request
+func (s *Scanner) WithChunkSize(chunkSize int64) *Scanner {
+	scanner := *s
+	scanner.chunkSize = chunkSize
+	return &scanner
 }
 
 // Scan performs a scan based on the KorpScan specification
 func (s *Scanner) Scan(ctx context.Context, korpScan *korpv1alpha1.KorpScan) (*ScanResult, error) {
-	result := &ScanResult{}
+	return s.ScanWithProgress(ctx, korpScan, nil)
+}
+
+// ScanWithProgress performs a scan like Scan and calls progress before each namespace is scanned.
+// If ctx ends during the scan, it returns the findings so far in a result marked Partial together with the error.
+func (s *Scanner) ScanWithProgress(ctx context.Context, korpScan *korpv1alpha1.KorpScan, progress ProgressFunc) (*ScanResult, error) {
+	return s.ScanPipelined(ctx, korpScan, progress, nil)
+}
+
+// ScanPipelined performs a scan like ScanWithProgress and calls done with the findings of each namespace
+// as soon as that namespace is scanned, so cleanup can run while the rest of the cluster is scanned.
+// Findings of cluster-scoped resources and of uninstall audits are only in the returned result.
+func (s *Scanner) ScanPipelined(ctx context.Context, korpScan *korpv1alpha1.KorpScan, progress ProgressFunc, done NamespaceFunc) (*ScanResult, error) {
+	result := &ScanResult{progress: progress, namespaceDone: done}
 	now := metav1.Time{Time: time.Now()}
 
-	// Determine which resource types to scan
+	// Detectors share one List of each resource per namespace instead of listing it again each,
+	// and large collections are listed in chunks
+	snapshot := k8sutil.NewInformerSnapshotClient(s.client, s.informers, s.chunkSize)
+	scanner := *s
+	scanner.client = snapshot
+	s = &scanner
+
+	// Apply filter presets on a copy so the caller's object is unchanged
+	if len(korpScan.Spec.FilterPresets) > 0 {
+		filters, err := ResolveFilters(korpScan.Spec.Filters, korpScan.Spec.FilterPresets)
+		if err != nil {
+			return nil, err
+		}
+		korpScan = korpScan.DeepCopy()
+		korpScan.Spec.Filters = filters
+	}
+
+	// Cluster-wide KorpPolicies are evaluated before anything is reported
+	policies, err := policy.Load(ctx, s.dynamic, korpScan)
+	if err != nil {
+		return nil, err
+	}
+	result.Policies = policies
+
+	// Record the cluster version so findings from different clusters can be told apart
+	if info, err := s.client.Discovery().ServerVersion(); err == nil {
+		result.clusterVersion, result.clusterPlatform = info.GitVersion, info.Platform
+	}
+
+	// Determine which resource types to scan, in the API versions this cluster serves
+	result.apiVersions = k8sutil.NewAPIVersions(s.client.Discovery())
 	types := korpScan.Spec.ResourceTypes
 	if len(types) == 0 {
-		// Default to all resource types
-		types = []string{"configmaps", "secrets", "pvcs", "services", "deployments", "jobs", "ingresses",
-			"statefulsets", "daemonsets", "cronjobs", "replicasets", "serviceaccounts",
-			"roles", "clusterroles", "rolebindings", "clusterrolebindings",
-			"networkpolicies", "poddisruptionbudgets", "hpas", "pvs", "endpoints", "resourcequotas"}
+		// Default to all resource types; ones the cluster does not serve are skipped silently
+		types, result.skippedTypes = supportedResourceTypes(result.apiVersions, DefaultResourceTypes)
+	} else {
+		types, result.UnsupportedTypes = supportedResourceTypes(result.apiVersions, types)
 	}
+	allowed := allowedTypes(types, policies)
+	if len(allowed) < len(types) {
+		kept := make(map[string]bool, len(allowed))
+		for _, rt := range allowed {
+			kept[rt] = true
+		}
+		for _, rt := range types {
+			if !kept[rt] {
+				result.skippedTypes = append(result.skippedTypes, UnsupportedType{Type: rt, Reason: "not allowed by a KorpPolicy"})
+			}
+		}
+	}
+	types = allowed
+	result.activeTypes = types
 
 	// Get list of namespaces to scan
 	namespacesToScan, err := s.getNamespacesToScan(ctx, korpScan)
 	if err != nil {
 		return nil, err
 	}
+	namespacesToScan = unprotectedNamespaces(namespacesToScan, policies)
+	result.ScannedNamespaces = namespacesToScan
+
+	// Load known identity provider principals once per scan
+	if source := korpScan.Spec.Detection.KnownPrincipals; source != nil {
+		principals, err := k8sutil.LoadPrincipals(ctx, s.client, korpScan.Namespace, source)
+		switch {
+		case errors.Is(err, k8sutil.ErrNoPrincipals):
+			// Without any known principal every user and group binding would be reported; skip the detector
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s detection skipped: %v", k8sutil.UnknownPrincipalsReason, err))
+		case err != nil:
+			return nil, err
+		default:
+			result.principals = principals
+		}
+	}
+
+	// Index ConfigMaps and Secrets referenced by custom resources once per scan
+	if crRefs := korpScan.Spec.Detection.CustomResourceReferences; crRefs != nil && crRefs.Enabled {
+		gvrs := append([]schema.GroupVersionResource{}, k8sutil.PopularReferenceResources...)
+		for _, r := range crRefs.Resources {
+			gvrs = append(gvrs, schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource})
+		}
+		index, err := k8sutil.CustomResourceReferences(ctx, s.dynamic, gvrs)
+		if err != nil {
+			return nil, err
+		}
+		result.crReferences = index
+	}
+
+	// Index Secrets referenced by PersistentVolumes, webhooks, APIServices and CRDs once per scan
+	for _, rt := range types {
+		if rt != "secrets" || korpScan.Spec.Mode == ModeUninstallAudit {
+			continue
+		}
+		index, err := k8sutil.ClusterSecretReferences(ctx, s.client, s.dynamic)
+		if err != nil {
+			return nil, err
+		}
+		result.secretReferences = index
+	}
+
+	if korpScan.Spec.Mode == ModeUninstallAudit {
+		if err := s.scanLeftovers(ctx, namespacesToScan, korpScan, result, now); err != nil {
+			return nil, err
+		}
+		result.summarize()
+		return result, nil
+	}
+
+	// Findings of resources managed by Helm or GitOps are tagged with their manager, and filtered by the
+	// HelmManaged and GitOpsManaged modes before each namespace is reported, so pipelined cleanup never
+	// sees findings the filters drop
+	helmTracker := k8sutil.NewAppTracker(s.client, s.dynamic)
+
+	// A rescan scans only the namespaces that changed
+	scanNamespaces := namespacesToScan
+	if s.rescan != nil {
+		scanNamespaces, result.CarriedNamespaces = s.rescan.split(namespacesToScan)
+	}
 
 	// Scan each namespace for namespace-scoped resources
-	for _, ns := range namespacesToScan {
+	for i, ns := range scanNamespaces {
+		result.reportProgress(i, len(scanNamespaces), ns)
+		found := len(result.Details)
 		if err := s.scanNamespace(ctx, ns, types, korpScan, result, now); err != nil {
+			if ctx.Err() != nil {
+				return result.cutShort(scanNamespaces[i:]), err
+			}
 			return nil, err
 		}
+		if err := s.tagManagedFindings(ctx, helmTracker, result, found, korpScan.Spec.Filters); err != nil {
+			if ctx.Err() != nil {
+				return result.cutShort(scanNamespaces[i:]), err
+			}
+			return nil, err
+		}
+		snapshot.Release(ns)
+		result.reportNamespaceDone(ns, result.Details[found:])
 	}
 
 	// Scan cluster-scoped resources (only once, not per namespace)
+	found := len(result.Details)
 	if err := s.scanClusterScopedResources(ctx, types, korpScan, result, now); err != nil {
+		if ctx.Err() != nil {
+			return result.cutShort(nil), err
+		}
+		return nil, err
+	}
+	if err := s.tagManagedFindings(ctx, helmTracker, result, found, korpScan.Spec.Filters); err != nil {
+		if ctx.Err() != nil {
+			return result.cutShort(nil), err
+		}
 		return nil, err
 	}
 
-	// Update total resources count
-	result.Summary.TotalResources = len(result.Details)
+	if s.rescan != nil {
+		result.Details = append(result.Details, s.rescan.carried(result.CarriedNamespaces)...)
+	}
+	result.summarize()
 
 	return result, nil
 }
@@ -87,13 +265,36 @@ func (s *Scanner) getNamespacesToScan(ctx context.Context, korpScan *korpv1alpha
 
 	// If not scanning all namespaces, return the single target
 	if targetNs != "*" {
+		if korpScan.Spec.NamespaceOptIn {
+			ns, err := s.client.CoreV1().Namespaces().Get(ctx, targetNs, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			if ns.Annotations[korpv1alpha1.ScanOptInAnnotation] != korpv1alpha1.ScanOptInEnabled {
+				return nil, nil
+			}
+		}
 		return []string{targetNs}, nil
 	}
 
+	// A selector, an include list or both narrow "*" to the namespaces they select
+	var selector labels.Selector
+	if korpScan.Spec.NamespaceSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(korpScan.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+	}
+	includeSet := make(map[string]bool)
+	for _, ns := range korpScan.Spec.IncludeNamespaces {
+		includeSet[ns] = true
+	}
+
 	// Get all namespaces
 	nsList, err := s.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, DetectorError("namespaces", "", err)
 	}
 
 	// Build exclusion set
@@ -105,114 +306,106 @@ func (s *Scanner) getNamespacesToScan(ctx context.Context, korpScan *korpv1alpha
 	// Filter namespaces
 	var namespaces []string
 	for _, ns := range nsList.Items {
-		if !excludeSet[ns.Name] {
-			namespaces = append(namespaces, ns.Name)
+		if excludeSet[ns.Name] {
+			continue
 		}
+		if (selector != nil || len(includeSet) > 0) &&
+			!includeSet[ns.Name] && (selector == nil || !selector.Matches(labels.Set(ns.Labels))) {
+			continue
+		}
+		// With opt-in, only namespaces annotated korp.io/scan=enabled are scanned
+		if korpScan.Spec.NamespaceOptIn && ns.Annotations[korpv1alpha1.ScanOptInAnnotation] != korpv1alpha1.ScanOptInEnabled {
+			continue
+		}
+		namespaces = append(namespaces, ns.Name)
 	}
 
 	return namespaces, nil
 }
 
-// scanNamespace scans a single namespace for orphaned resources
+// scanNamespace scans the requested resource types in one namespace.
+// Types korp is forbidden to list there are recorded as blind spots and types the cluster stopped
+// serving are skipped instead of failing the scan. Other detector errors are returned typed.
 func (s *Scanner) scanNamespace(ctx context.Context, ns string, types []string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, now metav1.Time) error {
-	// Scan each requested resource type
 	for _, rt := range types {
-		switch rt {
-		case "configmaps":
-			if err := s.scanConfigMaps(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+		err := DetectorError(rt, ns, s.scanNamespaceType(ctx, ns, rt, korpScan, result, now))
+		if err = result.skipDetectorError(rt, ns, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		case "secrets":
-			if err := s.scanSecrets(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+// scanNamespaceType scans one resource type in one namespace
+func (s *Scanner) scanNamespaceType(ctx context.Context, ns, rt string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, now metav1.Time) error {
+	switch rt {
+	case "configmaps":
+		return s.scanConfigMaps(ctx, ns, korpScan, result, now)
 
-		case "pvcs":
-			if err := s.scanPVCs(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "secrets":
+		return s.scanSecrets(ctx, ns, korpScan, result, now)
 
-		case "services":
-			if err := s.scanServices(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "pvcs":
+		return s.scanPVCs(ctx, ns, korpScan, result, now)
 
-		case "deployments":
-			if err := s.scanDeployments(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "services":
+		return s.scanServices(ctx, ns, korpScan, result, now)
 
-		case "jobs":
-			if err := s.scanJobs(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "deployments":
+		return s.scanDeployments(ctx, ns, korpScan, result, now)
 
-		case "ingresses":
-			if err := s.scanIngresses(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "jobs":
+		return s.scanJobs(ctx, ns, korpScan, result, now)
 
-		case "statefulsets":
-			if err := s.scanStatefulSets(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "ingresses":
+		return s.scanIngresses(ctx, ns, korpScan, result, now)
 
-		case "daemonsets":
-			if err := s.scanDaemonSets(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "statefulsets":
+		return s.scanStatefulSets(ctx, ns, korpScan, result, now)
 
-		case "cronjobs":
-			if err := s.scanCronJobs(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "daemonsets":
+		return s.scanDaemonSets(ctx, ns, korpScan, result, now)
 
-		case "replicasets":
-			if err := s.scanReplicaSets(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "cronjobs":
+		return s.scanCronJobs(ctx, ns, korpScan, result, now)
 
-		case "serviceaccounts":
-			if err := s.scanServiceAccounts(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "replicasets":
+		return s.scanReplicaSets(ctx, ns, korpScan, result, now)
 
-		case "roles":
-			if err := s.scanRoles(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "serviceaccounts":
+		return s.scanServiceAccounts(ctx, ns, korpScan, result, now)
 
-		case "rolebindings":
-			if err := s.scanRoleBindings(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "roles":
+		return s.scanRoles(ctx, ns, korpScan, result, now)
 
-		case "networkpolicies":
-			if err := s.scanNetworkPolicies(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "rolebindings":
+		return s.scanRoleBindings(ctx, ns, korpScan, result, now)
 
-		case "poddisruptionbudgets":
-			if err := s.scanPodDisruptionBudgets(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "networkpolicies":
+		return s.scanNetworkPolicies(ctx, ns, korpScan, result, now)
 
-		case "hpas":
-			if err := s.scanHPAs(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "poddisruptionbudgets":
+		return s.scanPodDisruptionBudgets(ctx, ns, korpScan, result, now)
 
-		case "endpoints":
-			if err := s.scanEndpoints(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
+	case "hpas":
+		return s.scanHPAs(ctx, ns, korpScan, result, now)
 
-		case "resourcequotas":
-			if err := s.scanResourceQuotas(ctx, ns, korpScan, result, now); err != nil {
-				return err
-			}
-		}
+	case "endpoints":
+		return s.scanEndpoints(ctx, ns, korpScan, result, now)
+
+	case "endpointslices":
+		return s.scanEndpointSlices(ctx, ns, korpScan, result, now)
+
+	case "resourcequotas":
+		return s.scanResourceQuotas(ctx, ns, korpScan, result, now)
+	case "limitranges":
+		return s.scanLimitRanges(ctx, ns, korpScan, result, now)
+
+	case "secretproviderclasses":
+		return s.scanSecretProviderClasses(ctx, ns, korpScan, result, now)
+
+	case "externalsecrets":
+		return s.scanExternalSecrets(ctx, ns, korpScan, result, now)
 	}
 
 	return nil
@@ -225,13 +418,33 @@ func (s *Scanner) scanConfigMaps(ctx context.Context, ns string, korpScan *korpv
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedConfigMaps += len(filtered)
+	// ConfigMaps named in reference annotations or custom resources are in use
+	referenced, err := k8sutil.AnnotationReferences(ctx, s.client, ns, referenceAnnotationKeys(korpScan))
+	if err != nil {
+		return err
+	}
+	var unreferenced []metav1.ObjectMeta
+	for _, obj := range orphans {
+		if !referenced[obj.Name] && !result.crReferences.Has(ns, obj.Name) {
+			unreferenced = append(unreferenced, obj)
+		}
+	}
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("ConfigMap", ns, name, "NoOwnerReference", detectedAt))
+	filtered := applyFilters("ConfigMap", unreferenced, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("ConfigMap", ns, obj, "NoOwnerReference", detectedAt))
 	}
 
+	maxBytes := korpScan.Spec.Detection.MaxObjectSizeBytes
+	if maxBytes <= 0 {
+		return nil
+	}
+	oversized, err := k8sutil.OversizedConfigMaps(ctx, s.client, ns, maxBytes)
+	if err != nil {
+		return err
+	}
+	addOversized("ConfigMap", ns, oversized, filtered, korpScan, result, detectedAt)
 	return nil
 }
 
@@ -242,16 +455,62 @@ func (s *Scanner) scanSecrets(ctx context.Context, ns string, korpScan *korpv1al
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedSecrets += len(filtered)
+	// Secrets synced by secrets-store CSI or external-secrets are managed by their operator
+	synced, err := k8sutil.SecretsSyncedByOperators(ctx, s.dynamic, result.apiVersions, ns)
+	if err != nil {
+		return err
+	}
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Secret", ns, name, "NoOwnerReference", detectedAt))
+	// Secrets named in reference annotations or custom resources are in use
+	referenced, err := k8sutil.AnnotationReferences(ctx, s.client, ns, referenceAnnotationKeys(korpScan))
+	if err != nil {
+		return err
 	}
 
+	var unmanaged []metav1.ObjectMeta
+	for _, obj := range orphans {
+		if !synced[obj.Name] && !referenced[obj.Name] && !result.crReferences.Has(ns, obj.Name) && !result.secretReferences.Has(ns, obj.Name) {
+			unmanaged = append(unmanaged, obj)
+		}
+	}
+
+	filtered := applyFilters("Secret", unmanaged, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("Secret", ns, obj, "NoOwnerReference", detectedAt))
+	}
+
+	maxBytes := korpScan.Spec.Detection.MaxObjectSizeBytes
+	if maxBytes <= 0 {
+		return nil
+	}
+	oversized, err := k8sutil.OversizedSecrets(ctx, s.client, ns, maxBytes)
+	if err != nil {
+		return err
+	}
+	addOversized("Secret", ns, oversized, filtered, korpScan, result, detectedAt)
 	return nil
 }
 
+// addOversized reports oversized objects of a kind. Objects already reported as orphans keep that finding.
+func addOversized(kind, ns string, oversized, orphans []metav1.ObjectMeta, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) {
+	reported := make(map[string]bool, len(orphans))
+	for _, obj := range orphans {
+		reported[obj.Name] = true
+	}
+	var objs []metav1.ObjectMeta
+	for _, obj := range oversized {
+		if !reported[obj.Name] {
+			objs = append(objs, obj)
+		}
+	}
+
+	filtered := applyFilters(kind, objs, korpScan.Spec.Filters, result.Policies)
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding(kind, ns, obj, k8sutil.OversizedObjectReason, detectedAt))
+	}
+}
+
 // scanPVCs scans for orphaned PersistentVolumeClaims
 func (s *Scanner) scanPVCs(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
 	orphans, err := k8sutil.OrphanPVCs(ctx, s.client, ns)
@@ -259,11 +518,10 @@ func (s *Scanner) scanPVCs(ctx context.Context, ns string, korpScan *korpv1alpha
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedPVCs += len(filtered)
+	filtered := applyFilters("PersistentVolumeClaim", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("PersistentVolumeClaim", ns, name, "NoOwnerReference", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("PersistentVolumeClaim", ns, obj, "NoOwnerReference", detectedAt))
 	}
 
 	return nil
@@ -276,11 +534,10 @@ func (s *Scanner) scanServices(ctx context.Context, ns string, korpScan *korpv1a
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.ServicesWithoutEndpoints += len(filtered)
+	filtered := applyFilters("Service", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Service", ns, name, "NoEndpoints", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("Service", ns, obj, "NoEndpoints", detectedAt))
 	}
 
 	return nil
@@ -293,11 +550,26 @@ func (s *Scanner) scanDeployments(ctx context.Context, ns string, korpScan *korp
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedDeployments += len(filtered)
+	filtered := applyFilters("Deployment", orphans, korpScan.Spec.Filters, result.Policies)
+
+	deadlines, err := k8sutil.ProgressDeadlines(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
+	filtered = pastProgressDeadline(filtered, deadlines, korpScan.Spec.Filters)
+
+	// Paused or progressing rollouts are reported separately and kept out of cleanup by default
+	rolling, err := k8sutil.PausedOrRollingDeployments(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Deployment", ns, name, "ScaledToZero", detectedAt))
+	for _, obj := range filtered {
+		reason := "ScaledToZero"
+		if rolling[obj.Name] {
+			reason = k8sutil.PausedRolloutReason
+		}
+		result.Details = append(result.Details, objectFinding("Deployment", ns, obj, reason, detectedAt))
 	}
 
 	return nil
@@ -310,11 +582,10 @@ func (s *Scanner) scanJobs(ctx context.Context, ns string, korpScan *korpv1alpha
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedJobs += len(filtered)
+	filtered := applyFilters("Job", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Job", ns, name, "CompletedOld", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("Job", ns, obj, "CompletedOld", detectedAt))
 	}
 
 	return nil
@@ -327,11 +598,10 @@ func (s *Scanner) scanIngresses(ctx context.Context, ns string, korpScan *korpv1
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedIngresses += len(filtered)
+	filtered := applyFilters("Ingress", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Ingress", ns, name, "NoBackendService", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("Ingress", ns, obj, "NoBackendService", detectedAt))
 	}
 
 	return nil
@@ -344,11 +614,20 @@ func (s *Scanner) scanStatefulSets(ctx context.Context, ns string, korpScan *kor
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedStatefulSets += len(filtered)
+	filtered := applyFilters("StatefulSet", orphans, korpScan.Spec.Filters, result.Policies)
+
+	// Paused or progressing rollouts are reported separately and kept out of cleanup by default
+	rolling, err := k8sutil.RollingStatefulSets(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("StatefulSet", ns, name, "ScaledToZeroOrNoReadyPods", detectedAt))
+	for _, obj := range filtered {
+		reason := "ScaledToZeroOrNoReadyPods"
+		if rolling[obj.Name] {
+			reason = k8sutil.PausedRolloutReason
+		}
+		result.Details = append(result.Details, objectFinding("StatefulSet", ns, obj, reason, detectedAt))
 	}
 
 	return nil
@@ -361,11 +640,10 @@ func (s *Scanner) scanDaemonSets(ctx context.Context, ns string, korpScan *korpv
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedDaemonSets += len(filtered)
+	filtered := applyFilters("DaemonSet", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("DaemonSet", ns, name, "NoScheduledPods", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("DaemonSet", ns, obj, "NoScheduledPods", detectedAt))
 	}
 
 	return nil
@@ -378,11 +656,10 @@ func (s *Scanner) scanCronJobs(ctx context.Context, ns string, korpScan *korpv1a
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedCronJobs += len(filtered)
+	filtered := applyFilters("CronJob", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("CronJob", ns, name, "SuspendedNoRecentSuccess", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("CronJob", ns, obj, "SuspendedNoRecentSuccess", detectedAt))
 	}
 
 	return nil
@@ -395,11 +672,10 @@ func (s *Scanner) scanReplicaSets(ctx context.Context, ns string, korpScan *korp
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedReplicaSets += len(filtered)
+	filtered := applyFilters("ReplicaSet", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("ReplicaSet", ns, name, "OrphanedNoOwner", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("ReplicaSet", ns, obj, "OrphanedNoOwner", detectedAt))
 	}
 
 	return nil
@@ -412,40 +688,105 @@ func (s *Scanner) scanServiceAccounts(ctx context.Context, ns string, korpScan *
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedServiceAccounts += len(filtered)
+	filtered := applyFilters("ServiceAccount", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("ServiceAccount", ns, name, "NotUsedByAnyPod", detectedAt))
+	reported := make(map[string]bool, len(filtered))
+	for _, obj := range filtered {
+		reported[obj.Name] = true
+		result.Details = append(result.Details, objectFinding("ServiceAccount", ns, obj, "NotUsedByAnyPod", detectedAt))
+	}
+
+	// ServiceAccounts in use can still carry imagePullSecrets entries for deleted Secrets
+	dangling, err := k8sutil.ServiceAccountsWithDanglingPullSecrets(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
+
+	dangling = applyFilters("ServiceAccount", dangling, korpScan.Spec.Filters, result.Policies)
+	for _, obj := range dangling {
+		if reported[obj.Name] {
+			continue
+		}
+		reported[obj.Name] = true
+		result.Details = append(result.Details, objectFinding("ServiceAccount", ns, obj, k8sutil.DanglingImagePullSecretReason, detectedAt))
+	}
+
+	// The secrets list of ServiceAccounts keeps entries for deleted token Secrets. A ServiceAccount is
+	// reported once; the patch for either reason prunes both lists.
+	stale, err := k8sutil.ServiceAccountsWithDanglingSecrets(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
+
+	stale = applyFilters("ServiceAccount", stale, korpScan.Spec.Filters, result.Policies)
+	for _, obj := range stale {
+		if reported[obj.Name] {
+			continue
+		}
+		result.Details = append(result.Details, objectFinding("ServiceAccount", ns, obj, k8sutil.DanglingSecretReason, detectedAt))
 	}
 
 	return nil
 }
 
-// applyFilters applies exclusion filters to a list of resource names
-func (s *Scanner) applyFilters(names []string, filters korpv1alpha1.FilterSpec) []string {
-	if len(filters.ExcludeNamePatterns) == 0 {
+// referenceAnnotationKeys returns the built-in reference annotation keys plus those configured in the spec
+func referenceAnnotationKeys(korpScan *korpv1alpha1.KorpScan) []string {
+	keys := append([]string{}, k8sutil.DefaultReferenceAnnotations...)
+	return append(keys, korpScan.Spec.Detection.ReferenceAnnotations...)
+}
+
+// applyFilters applies inclusion and exclusion filters and KorpPolicy protections to the objects of one resource type
+func applyFilters(resourceType string, objs []metav1.ObjectMeta, filters korpv1alpha1.FilterSpec, policies *policy.Set) []metav1.ObjectMeta {
+	minAge := minAgeMinutes(resourceType, filters)
+	cutoff := time.Now().Add(-time.Duration(minAge) * time.Minute)
+
+	var kept []metav1.ObjectMeta
+	for _, obj := range objs {
+		if len(FilterNames([]string{obj.Name}, filters)) == 0 || !matchesLabelFilters(obj.Labels, filters) {
+			continue
+		}
+		if policies.Protects(&obj) != "" {
+			continue
+		}
+		// Skip resources created too recently to judge (e.g. a PVC still waiting for its pod)
+		if minAge > 0 && obj.CreationTimestamp.After(cutoff) {
+			continue
+		}
+		kept = append(kept, obj)
+	}
+	return kept
+}
+
+// matchesLabelFilters reports whether objLabels carry all of the filters' include labels and none of
+// their exclude labels. An exclude label with an empty value matches any value of its key.
+func matchesLabelFilters(objLabels map[string]string, filters korpv1alpha1.FilterSpec) bool {
+	if len(filters.IncludeLabels) > 0 && !labels.SelectorFromSet(filters.IncludeLabels).Matches(labels.Set(objLabels)) {
+		return false
+	}
+	for key, value := range filters.ExcludeLabels {
+		if v, ok := objLabels[key]; ok && (value == "" || v == value) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterNames returns names that match one of the filters' include name patterns, if any are set,
+// and none of their exclude name patterns. Invalid patterns are skipped.
+func FilterNames(names []string, filters korpv1alpha1.FilterSpec) []string {
+	if len(filters.IncludeNamePatterns) == 0 && len(filters.ExcludeNamePatterns) == 0 {
 		return names
 	}
 
 	var filtered []string
 	for _, name := range names {
-		excluded := false
-
-		// Check name pattern exclusions
-		for _, pattern := range filters.ExcludeNamePatterns {
-			matched, err := regexp.MatchString(pattern, name)
-			if err != nil {
-				// If regex is invalid, skip this pattern
-				continue
-			}
-			if matched {
-				excluded = true
-				break
-			}
+		// Check name pattern inclusions
+		if len(filters.IncludeNamePatterns) > 0 && !matchesAny(filters.IncludeNamePatterns, name) {
+			continue
 		}
 
-		if !excluded {
+		// Check name pattern exclusions
+		if !matchesAny(filters.ExcludeNamePatterns, name) {
 			filtered = append(filtered, name)
 		}
 	}
@@ -453,27 +794,51 @@ func (s *Scanner) applyFilters(names []string, filters korpv1alpha1.FilterSpec)
 	return filtered
 }
 
-// scanClusterScopedResources scans cluster-scoped resources (ClusterRoles, ClusterRoleBindings, PVs)
+// matchesAny reports whether name matches one of the regex patterns; invalid patterns never match
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, err := regexp.MatchString(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// scanClusterScopedResources scans the requested cluster-scoped resource types.
+// Types korp is forbidden to list are recorded as blind spots and types the cluster stopped
+// serving are skipped instead of failing the scan. Other detector errors are returned typed.
 func (s *Scanner) scanClusterScopedResources(ctx context.Context, types []string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, now metav1.Time) error {
 	for _, rt := range types {
-		switch rt {
-		case "clusterroles":
-			if err := s.scanClusterRoles(ctx, korpScan, result, now); err != nil {
-				return err
-			}
-		case "clusterrolebindings":
-			if err := s.scanClusterRoleBindings(ctx, korpScan, result, now); err != nil {
-				return err
-			}
-		case "pvs":
-			if err := s.scanPersistentVolumes(ctx, korpScan, result, now); err != nil {
-				return err
-			}
+		err := DetectorError(rt, "", s.scanClusterScopedType(ctx, rt, korpScan, result, now))
+		if err = result.skipDetectorError(rt, "", err); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// scanClusterScopedType scans one cluster-scoped resource type
+func (s *Scanner) scanClusterScopedType(ctx context.Context, rt string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, now metav1.Time) error {
+	switch rt {
+	case "clusterroles":
+		return s.scanClusterRoles(ctx, korpScan, result, now)
+	case "clusterrolebindings":
+		return s.scanClusterRoleBindings(ctx, korpScan, result, now)
+	case "pvs":
+		return s.scanPersistentVolumes(ctx, korpScan, result, now)
+	case "flowschemas":
+		return s.scanFlowSchemas(ctx, korpScan, result, now)
+	case "prioritylevelconfigurations":
+		return s.scanPriorityLevelConfigurations(ctx, korpScan, result, now)
+	case "mutatingwebhookconfigurations":
+		return s.scanMutatingWebhookConfigurations(ctx, korpScan, result, now)
+	case "validatingwebhookconfigurations":
+		return s.scanValidatingWebhookConfigurations(ctx, korpScan, result, now)
+	}
+
+	return nil
+}
+
 // scanRoles scans for orphaned Roles in a namespace
 func (s *Scanner) scanRoles(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
 	orphans, err := k8sutil.OrphanRoles(ctx, s.client, ns)
@@ -481,11 +846,10 @@ func (s *Scanner) scanRoles(ctx context.Context, ns string, korpScan *korpv1alph
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedRoles += len(filtered)
+	filtered := applyFilters("Role", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Role", ns, name, "NotReferencedByBinding", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("Role", ns, obj, "NotReferencedByBinding", detectedAt))
 	}
 
 	return nil
@@ -498,11 +862,10 @@ func (s *Scanner) scanClusterRoles(ctx context.Context, korpScan *korpv1alpha1.K
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedClusterRoles += len(filtered)
+	filtered := applyFilters("ClusterRole", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("ClusterRole", "", name, "NotReferencedByBinding", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("ClusterRole", "", obj, "NotReferencedByBinding", detectedAt))
 	}
 
 	return nil
@@ -515,11 +878,27 @@ func (s *Scanner) scanRoleBindings(ctx context.Context, ns string, korpScan *kor
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedRoleBindings += len(filtered)
+	filtered := applyFilters("RoleBinding", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("RoleBinding", ns, name, "ReferencesNonExistentRoleOrSubject", detectedAt))
+	reported := make(map[string]bool, len(filtered))
+	for _, obj := range filtered {
+		reported[obj.Name] = true
+		result.Details = append(result.Details, objectFinding("RoleBinding", ns, obj, "ReferencesNonExistentRoleOrSubject", detectedAt))
+	}
+
+	// Bindings granting access only to users/groups the identity provider no longer knows
+	if result.principals != nil {
+		unknown, err := k8sutil.RoleBindingsWithUnknownPrincipals(ctx, s.client, ns, result.principals)
+		if err != nil {
+			return err
+		}
+		unknown = applyFilters("RoleBinding", unknown, korpScan.Spec.Filters, result.Policies)
+		for _, obj := range unknown {
+			if reported[obj.Name] {
+				continue
+			}
+			result.Details = append(result.Details, objectFinding("RoleBinding", ns, obj, k8sutil.UnknownPrincipalsReason, detectedAt))
+		}
 	}
 
 	return nil
@@ -532,11 +911,27 @@ func (s *Scanner) scanClusterRoleBindings(ctx context.Context, korpScan *korpv1a
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedClusterRoleBindings += len(filtered)
+	filtered := applyFilters("ClusterRoleBinding", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("ClusterRoleBinding", "", name, "ReferencesNonExistentRoleOrSubject", detectedAt))
+	reported := make(map[string]bool, len(filtered))
+	for _, obj := range filtered {
+		reported[obj.Name] = true
+		result.Details = append(result.Details, objectFinding("ClusterRoleBinding", "", obj, "ReferencesNonExistentRoleOrSubject", detectedAt))
+	}
+
+	// Bindings granting access only to users/groups the identity provider no longer knows
+	if result.principals != nil {
+		unknown, err := k8sutil.ClusterRoleBindingsWithUnknownPrincipals(ctx, s.client, result.principals)
+		if err != nil {
+			return err
+		}
+		unknown = applyFilters("ClusterRoleBinding", unknown, korpScan.Spec.Filters, result.Policies)
+		for _, obj := range unknown {
+			if reported[obj.Name] {
+				continue
+			}
+			result.Details = append(result.Details, objectFinding("ClusterRoleBinding", "", obj, k8sutil.UnknownPrincipalsReason, detectedAt))
+		}
 	}
 
 	return nil
@@ -549,11 +944,10 @@ func (s *Scanner) scanNetworkPolicies(ctx context.Context, ns string, korpScan *
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedNetworkPolicies += len(filtered)
+	filtered := applyFilters("NetworkPolicy", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("NetworkPolicy", ns, name, "NoMatchingPods", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("NetworkPolicy", ns, obj, "NoMatchingPods", detectedAt))
 	}
 
 	return nil
@@ -561,16 +955,15 @@ func (s *Scanner) scanNetworkPolicies(ctx context.Context, ns string, korpScan *
 
 // scanPodDisruptionBudgets scans for orphaned PodDisruptionBudgets
 func (s *Scanner) scanPodDisruptionBudgets(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
-	orphans, err := k8sutil.OrphanPodDisruptionBudgets(ctx, s.client, ns)
+	orphans, err := k8sutil.OrphanPodDisruptionBudgets(ctx, s.client, ns, result.apiVersion("PodDisruptionBudget"))
 	if err != nil {
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedPodDisruptionBudgets += len(filtered)
+	filtered := applyFilters("PodDisruptionBudget", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("PodDisruptionBudget", ns, name, "NoMatchingPods", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("PodDisruptionBudget", ns, obj, "NoMatchingPods", detectedAt))
 	}
 
 	return nil
@@ -578,16 +971,15 @@ func (s *Scanner) scanPodDisruptionBudgets(ctx context.Context, ns string, korpS
 
 // scanHPAs scans for orphaned HorizontalPodAutoscalers
 func (s *Scanner) scanHPAs(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
-	orphans, err := k8sutil.OrphanHPAs(ctx, s.client, ns)
+	orphans, err := k8sutil.OrphanHPAs(ctx, s.client, ns, result.apiVersion("HorizontalPodAutoscaler"))
 	if err != nil {
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedHPAs += len(filtered)
+	filtered := applyFilters("HorizontalPodAutoscaler", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("HorizontalPodAutoscaler", ns, name, "TargetNotFound", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("HorizontalPodAutoscaler", ns, obj, "TargetNotFound", detectedAt))
 	}
 
 	return nil
@@ -595,16 +987,111 @@ func (s *Scanner) scanHPAs(ctx context.Context, ns string, korpScan *korpv1alpha
 
 // scanPersistentVolumes scans for orphaned PersistentVolumes
 func (s *Scanner) scanPersistentVolumes(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
-	orphans, err := k8sutil.OrphanPersistentVolumes(ctx, s.client)
+	days := korpScan.Spec.Detection.AvailablePVDays
+	if days <= 0 {
+		days = k8sutil.DefaultPVAvailableDays
+	}
+	orphans, err := k8sutil.OrphanPVs(ctx, s.client, time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	objs := make([]metav1.ObjectMeta, 0, len(orphans))
+	for _, pv := range orphans {
+		objs = append(objs, pv.ObjectMeta)
+	}
+	filtered := applyFilters("PersistentVolume", objs, korpScan.Spec.Filters, result.Policies)
+
+	kept := make(map[string]bool, len(filtered))
+	for _, obj := range filtered {
+		kept[obj.Name] = true
+	}
+	for _, pv := range orphans {
+		if !kept[pv.Name] {
+			continue
+		}
+		finding := objectFinding("PersistentVolume", "", pv.ObjectMeta, pv.Reason, detectedAt)
+		finding.Claim = pv.Claim
+		result.Details = append(result.Details, finding)
+	}
+
+	return nil
+}
+
+// scanFlowSchemas scans for FlowSchemas pointing at missing priority levels or deleted namespaces
+func (s *Scanner) scanFlowSchemas(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	missingLevel, err := k8sutil.FlowSchemasWithMissingPriorityLevel(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("FlowSchema", missingLevel, korpScan.Spec.Filters, result.Policies)
+
+	reported := make(map[string]bool, len(filtered))
+	for _, obj := range filtered {
+		reported[obj.Name] = true
+		result.Details = append(result.Details, objectFinding("FlowSchema", "", obj, "PriorityLevelNotFound", detectedAt))
+	}
+
+	deletedSubjects, err := k8sutil.FlowSchemasWithDeletedSubjects(ctx, s.client)
 	if err != nil {
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedPVs += len(filtered)
+	filtered = applyFilters("FlowSchema", deletedSubjects, korpScan.Spec.Filters, result.Policies)
+	for _, obj := range filtered {
+		if reported[obj.Name] {
+			continue
+		}
+		result.Details = append(result.Details, objectFinding("FlowSchema", "", obj, "SubjectsInDeletedNamespaces", detectedAt))
+	}
+
+	return nil
+}
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("PersistentVolume", "", name, "NotBound", detectedAt))
+// scanPriorityLevelConfigurations scans for PriorityLevelConfigurations not used by any FlowSchema
+func (s *Scanner) scanPriorityLevelConfigurations(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	orphans, err := k8sutil.OrphanPriorityLevelConfigurations(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("PriorityLevelConfiguration", orphans, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("PriorityLevelConfiguration", "", obj, "NotReferencedByFlowSchema", detectedAt))
+	}
+
+	return nil
+}
+
+// scanMutatingWebhookConfigurations scans for MutatingWebhookConfigurations with a stale caBundle
+func (s *Scanner) scanMutatingWebhookConfigurations(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	stale, err := k8sutil.MutatingWebhookConfigurationsWithStaleCABundle(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("MutatingWebhookConfiguration", stale, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("MutatingWebhookConfiguration", "", obj, k8sutil.StaleCABundleReason, detectedAt))
+	}
+
+	return nil
+}
+
+// scanValidatingWebhookConfigurations scans for ValidatingWebhookConfigurations with a stale caBundle
+func (s *Scanner) scanValidatingWebhookConfigurations(ctx context.Context, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	stale, err := k8sutil.ValidatingWebhookConfigurationsWithStaleCABundle(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("ValidatingWebhookConfiguration", stale, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("ValidatingWebhookConfiguration", "", obj, k8sutil.StaleCABundleReason, detectedAt))
 	}
 
 	return nil
@@ -617,28 +1104,97 @@ func (s *Scanner) scanEndpoints(ctx context.Context, ns string, korpScan *korpv1
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedEndpoints += len(filtered)
+	filtered := applyFilters("Endpoints", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("Endpoints", ns, name, "NoMatchingService", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("Endpoints", ns, obj, "NoMatchingService", detectedAt))
 	}
 
 	return nil
 }
 
-// scanResourceQuotas scans for orphaned ResourceQuotas (namespace has no pods)
+// scanEndpointSlices scans for EndpointSlices whose Service was deleted
+func (s *Scanner) scanEndpointSlices(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	orphans, err := k8sutil.OrphanEndpointSlices(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("EndpointSlice", orphans, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("EndpointSlice", ns, obj, "NoMatchingService", detectedAt))
+	}
+
+	return nil
+}
+
+// scanResourceQuotas scans for ResourceQuotas in namespaces without workloads, or that constrain nothing ever created
 func (s *Scanner) scanResourceQuotas(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
 	orphans, err := k8sutil.OrphanResourceQuotas(ctx, s.client, ns)
 	if err != nil {
 		return err
 	}
 
-	filtered := s.applyFilters(orphans, korpScan.Spec.Filters)
-	result.Summary.OrphanedResourceQuotas += len(filtered)
+	objs := make([]metav1.ObjectMeta, 0, len(orphans))
+	reasons := make(map[string]string, len(orphans))
+	for _, orphan := range orphans {
+		objs = append(objs, orphan.ObjectMeta)
+		reasons[orphan.Name] = orphan.Reason
+	}
+
+	filtered := applyFilters("ResourceQuota", objs, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("ResourceQuota", ns, obj, reasons[obj.Name], detectedAt))
+	}
+
+	return nil
+}
+
+// scanLimitRanges scans for LimitRanges in namespaces without workloads
+func (s *Scanner) scanLimitRanges(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	orphans, err := k8sutil.OrphanLimitRanges(ctx, s.client, ns)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("LimitRange", orphans, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("LimitRange", ns, obj, k8sutil.NoWorkloadsReason, detectedAt))
+	}
+
+	return nil
+}
+
+// scanSecretProviderClasses scans for SecretProviderClasses not mounted by any pod
+func (s *Scanner) scanSecretProviderClasses(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	orphans, err := k8sutil.OrphanSecretProviderClasses(ctx, s.client, s.dynamic, ns)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("SecretProviderClass", orphans, korpScan.Spec.Filters, result.Policies)
+
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("SecretProviderClass", ns, obj, "NotMountedByAnyPod", detectedAt))
+	}
+
+	return nil
+}
+
+// scanExternalSecrets scans for ExternalSecrets whose target Secret is unused
+func (s *Scanner) scanExternalSecrets(ctx context.Context, ns string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, detectedAt metav1.Time) error {
+	orphans, err := k8sutil.OrphanExternalSecrets(ctx, s.client, s.dynamic, result.apiVersions, ns)
+	if err != nil {
+		return err
+	}
+
+	filtered := applyFilters("ExternalSecret", orphans, korpScan.Spec.Filters, result.Policies)
 
-	for _, name := range filtered {
-		result.Details = append(result.Details, newFinding("ResourceQuota", ns, name, "NoPodsInNamespace", detectedAt))
+	for _, obj := range filtered {
+		result.Details = append(result.Details, objectFinding("ExternalSecret", ns, obj, "TargetSecretUnused", detectedAt))
 	}
 
 	return nil
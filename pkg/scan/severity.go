@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"fmt"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// rating is the severity and confidence of a detector's findings
+type rating struct {
+	severity   string
+	confidence string
+}
+
+var (
+	highDefinite    = rating{korpv1alpha1.SeverityHigh, korpv1alpha1.ConfidenceDefinite}
+	highHeuristic   = rating{korpv1alpha1.SeverityHigh, korpv1alpha1.ConfidenceHeuristic}
+	mediumDefinite  = rating{korpv1alpha1.SeverityMedium, korpv1alpha1.ConfidenceDefinite}
+	mediumHeuristic = rating{korpv1alpha1.SeverityMedium, korpv1alpha1.ConfidenceHeuristic}
+	lowDefinite     = rating{korpv1alpha1.SeverityLow, korpv1alpha1.ConfidenceDefinite}
+	lowHeuristic    = rating{korpv1alpha1.SeverityLow, korpv1alpha1.ConfidenceHeuristic}
+)
+
+// ratings rate findings by "<resource type>/<reason>", or by reason alone for reasons every type rates alike.
+// A finding is definite when it points at something that no longer exists or is provably unused, and
+// heuristic when korp infers that from the current state of the cluster, which may yet change.
+var ratings = map[string]rating{
+	// Bindings to missing roles or subjects grant access to whatever takes the name next
+	"ReferencesNonExistentRoleOrSubject": highDefinite,
+	k8sutil.UnknownPrincipalsReason:      highHeuristic,
+	// A webhook whose CA no longer verifies its service fails API requests it intercepts
+	k8sutil.StaleCABundleReason: highDefinite,
+	"PriorityLevelNotFound":     highDefinite,
+	// A Released volume keeps its storage, and its data, for a claim that is gone
+	"PersistentVolume/" + k8sutil.PVClaimDeletedReason:  highDefinite,
+	"PersistentVolume/" + k8sutil.PVLongAvailableReason: mediumHeuristic,
+
+	k8sutil.DanglingImagePullSecretReason:    mediumDefinite,
+	k8sutil.DanglingSecretReason:             mediumDefinite,
+	k8sutil.OversizedObjectReason:            mediumDefinite,
+	"HelmReleaseUninstalled":                 mediumDefinite,
+	"OperatorUninstalled":                    mediumDefinite,
+	"TargetNotFound":                         mediumDefinite,
+	"NoBackendService":                       mediumDefinite,
+	"Secret/NoOwnerReference":                mediumHeuristic,
+	"PersistentVolumeClaim/NoOwnerReference": mediumHeuristic,
+	"NotUsedByAnyPod":                        mediumHeuristic,
+
+	"NoMatchingService":           lowDefinite,
+	"NotReferencedByBinding":      lowDefinite,
+	"NotReferencedByFlowSchema":   lowDefinite,
+	"SubjectsInDeletedNamespaces": lowDefinite,
+	"CompletedOld":                lowDefinite,
+}
+
+// rate returns the rating of a detector's finding; findings of detectors not rated above are low and heuristic
+func rate(resourceType, reason string) rating {
+	if r, ok := ratings[resourceType+"/"+reason]; ok {
+		return r
+	}
+	if r, ok := ratings[reason]; ok {
+		return r
+	}
+	return lowHeuristic
+}
+
+// Severity returns how much a finding of a detector matters: Low, Medium or High
+func Severity(resourceType, reason string) string {
+	return rate(resourceType, reason).severity
+}
+
+// Confidence returns how sure a detector is of its finding: definite or heuristic
+func Confidence(resourceType, reason string) string {
+	return rate(resourceType, reason).confidence
+}
+
+// setReason gives a finding another reason, with the description and rating that go with it
+func setReason(f *korpv1alpha1.Finding, reason string) {
+	f.Reason = reason
+	f.Description = fmt.Sprintf("%s %s/%s (%s)", f.ResourceType, f.Namespace, f.Name, f.Reason)
+	f.Severity = Severity(f.ResourceType, reason)
+	f.Confidence = Confidence(f.ResourceType, reason)
+}
@@ -8,6 +8,8 @@ package scan
 
 import (
 	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+	"github.com/kamilbabayev/korp/pkg/policy"
 )
 
 // ScanResult holds the results of a scan operation
@@ -17,4 +19,94 @@ type ScanResult struct {
 
 	// Details contains individual findings
 	Details []korpv1alpha1.Finding
+
+	// ScannedNamespaces are the namespaces whose namespace-scoped resources were scanned
+	ScannedNamespaces []string
+
+	// CarriedNamespaces are the namespaces of ScannedNamespaces a rescan did not scan again because
+	// nothing in them changed; Details holds their findings from the previous scan
+	CarriedNamespaces []string
+
+	// BlindSpots are resource types korp was forbidden to list, with the namespaces affected
+	BlindSpots []korpv1alpha1.BlindSpot
+
+	// Partial is set when the scan was cut short; Details then holds only what was found before
+	Partial bool
+
+	// SkippedNamespaces are the namespaces a partial scan did not finish
+	SkippedNamespaces []string
+
+	// UnsupportedTypes are requested resource types that were skipped
+	UnsupportedTypes []UnsupportedType
+
+	// Warnings explain detection the scan skipped for reasons other than resource types, such as a
+	// knownPrincipals source without any principal
+	Warnings []string
+
+	// Policies are the KorpPolicies that applied to this scan; cleanup evaluates them again
+	Policies *policy.Set
+
+	// principals are the known users/groups loaded for this scan, if configured
+	principals *k8sutil.Principals
+
+	// crReferences indexes ConfigMaps and Secrets referenced by custom resources, if configured
+	crReferences k8sutil.ReferenceIndex
+
+	// secretReferences indexes Secrets referenced by cluster-scoped objects, if secrets are scanned
+	secretReferences k8sutil.ReferenceIndex
+
+	// apiVersions resolves the API versions the cluster serves, once per scan
+	apiVersions *k8sutil.APIVersions
+
+	// clusterVersion and clusterPlatform are reported by the API server, if it could be asked
+	clusterVersion  string
+	clusterPlatform string
+
+	// activeTypes are the resource types the scan runs detectors for
+	activeTypes []string
+
+	// skippedTypes are resource types skipped without being reported in UnsupportedTypes:
+	// default types the cluster does not serve and types KorpPolicies do not allow
+	skippedTypes []UnsupportedType
+
+	// progress is called before each namespace is scanned, if set
+	progress ProgressFunc
+
+	// namespaceDone is called with each namespace's findings once it is scanned, if set
+	namespaceDone NamespaceFunc
+}
+
+// cutShort marks the result as partial with the given unfinished namespaces
+func (r *ScanResult) cutShort(skipped []string) *ScanResult {
+	r.Partial = true
+	r.SkippedNamespaces = skipped
+	r.summarize()
+	return r
+}
+
+// summarize counts the findings into the summary
+func (r *ScanResult) summarize() {
+	r.Summary.TotalResources = len(r.Details)
+	r.Summary.Count(r.Details)
+}
+
+// ProgressFunc receives scan progress: namespaces finished so far, the total, and the namespace being scanned
+type ProgressFunc func(scanned, total int, current string)
+
+// reportProgress calls the progress callback if one is set
+func (r *ScanResult) reportProgress(scanned, total int, current string) {
+	if r.progress != nil {
+		r.progress(scanned, total, current)
+	}
+}
+
+// NamespaceFunc receives the findings of a namespace that has been scanned, and the KorpPolicies of the scan.
+// The findings are part of the scan result, so changes to them show in the result.
+type NamespaceFunc func(ns string, findings []korpv1alpha1.Finding, policies *policy.Set)
+
+// reportNamespaceDone calls the namespace callback if one is set
+func (r *ScanResult) reportNamespaceDone(ns string, findings []korpv1alpha1.Finding) {
+	if r.namespaceDone != nil {
+		r.namespaceDone(ns, findings, r.Policies)
+	}
 }
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"context"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// ModeUninstallAudit reports resources left behind by uninstalled Helm releases and OLM operators
+const ModeUninstallAudit = "uninstall-audit"
+
+// scanLeftovers finds resources left behind by uninstalled applications in the scanned namespaces
+func (s *Scanner) scanLeftovers(ctx context.Context, namespaces []string, korpScan *korpv1alpha1.KorpScan, result *ScanResult, now metav1.Time) error {
+	tracker := k8sutil.NewAppTracker(s.client, s.dynamic)
+
+	var leftovers []k8sutil.Leftover
+	for i, ns := range namespaces {
+		result.reportProgress(i, len(namespaces), ns)
+		found, err := tracker.LeftoverResources(ctx, ns)
+		if err != nil {
+			return err
+		}
+		leftovers = append(leftovers, found...)
+	}
+
+	// Cluster-scoped debris is only attributed when scanning the whole cluster
+	if korpScan.Spec.TargetNamespace == "*" {
+		found, err := tracker.ClusterLeftoverResources(ctx)
+		if err != nil {
+			return err
+		}
+		leftovers = append(leftovers, found...)
+	}
+
+	for _, l := range leftovers {
+		kept := applyFilters(l.Kind, []metav1.ObjectMeta{l.ObjectMeta}, korpScan.Spec.Filters, result.Policies)
+		if len(kept) == 0 {
+			continue
+		}
+		finding := newFinding(l.Kind, l.Namespace, l.Name, l.Reason, now)
+		finding.LeftBehindBy = l.App
+		result.Details = append(result.Details, finding)
+	}
+
+	return nil
+}
+
+// GroupLeftBehind counts findings per application that left them behind
+func GroupLeftBehind(findings []korpv1alpha1.Finding) []korpv1alpha1.LeftBehindGroup {
+	counts := make(map[string]int)
+	for _, f := range findings {
+		if f.LeftBehindBy != "" {
+			counts[f.LeftBehindBy]++
+		}
+	}
+
+	groups := make([]korpv1alpha1.LeftBehindGroup, 0, len(counts))
+	for app, count := range counts {
+		groups = append(groups, korpv1alpha1.LeftBehindGroup{App: app, Count: count})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].App < groups[j].App
+	})
+	return groups
+}
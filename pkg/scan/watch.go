@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Korp Authors.
+
+Licensed under the MIT License.
+*/
+
+package scan
+
+import (
+	"context"
+
+	korpv1alpha1 "github.com/kamilbabayev/korp/api/v1alpha1"
+	k8sutil "github.com/kamilbabayev/korp/pkg/k8s"
+)
+
+// rescan is the state of a scan limited to the namespaces that changed since the previous scan
+type rescan struct {
+	// changed are the namespaces to scan again; all of them when it holds "", a cluster-scoped change
+	changed map[string]bool
+
+	// previous are the findings of the previous scan
+	previous []korpv1alpha1.Finding
+}
+
+// WithInformers returns a copy of s whose scans answer Lists from the caches of informers
+func (s *Scanner) WithInformers(informers *k8sutil.Informers) *Scanner {
+	scanner := *s
+	scanner.informers = informers
+	return &scanner
+}
+
+// Rescan performs a scan like ScanPipelined that scans again only the namespaces in changed, and
+// carries over the findings previous holds for the other namespaces. Changed namespaces outside the
+// scan's scope are ignored, and cluster-scoped resources are always scanned again; a changed
+// namespace of "" rescans every namespace. previous must be all findings of the last scan.
+func (s *Scanner) Rescan(ctx context.Context, korpScan *korpv1alpha1.KorpScan, changed []string,
+	previous []korpv1alpha1.Finding, progress ProgressFunc, done NamespaceFunc) (*ScanResult, error) {
+	r := &rescan{changed: make(map[string]bool, len(changed)), previous: previous}
+	for _, ns := range changed {
+		r.changed[ns] = true
+	}
+	scanner := *s
+	scanner.rescan = r
+	return scanner.ScanPipelined(ctx, korpScan, progress, done)
+}
+
+// split divides the namespaces in scope into those to scan again and those whose findings are carried over
+func (r *rescan) split(namespaces []string) (scan, carry []string) {
+	if r.changed[""] {
+		return namespaces, nil
+	}
+	for _, ns := range namespaces {
+		if r.changed[ns] {
+			scan = append(scan, ns)
+		} else {
+			carry = append(carry, ns)
+		}
+	}
+	return scan, carry
+}
+
+// carried returns the previous findings of namespace-scoped resources in namespaces
+func (r *rescan) carried(namespaces []string) []korpv1alpha1.Finding {
+	carry := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		carry[ns] = true
+	}
+	var findings []korpv1alpha1.Finding
+	for _, f := range r.previous {
+		if f.Namespace != "" && carry[f.Namespace] {
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}